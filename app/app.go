@@ -36,7 +36,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -121,6 +120,7 @@ import (
 	"github.com/evmos/ethermint/x/evm"
 	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	evmvm "github.com/evmos/ethermint/x/evm/vm"
 	"github.com/evmos/ethermint/x/evm/vm/geth"
 	"github.com/evmos/ethermint/x/feemarket"
 	feemarketkeeper "github.com/evmos/ethermint/x/feemarket/keeper"
@@ -152,6 +152,7 @@ import (
 
 	"sidechain/x/oracle"
 	oraclekeeper "sidechain/x/oracle/keeper"
+	oracleprecompile "sidechain/x/oracle/precompile"
 	oracletypes "sidechain/x/oracle/types"
 
 	transferkeeper "github.com/cosmos/ibc-go/v6/modules/apps/transfer/keeper"
@@ -317,6 +318,9 @@ type Sidechain struct {
 	// the module manager
 	mm *module.Manager
 
+	// simulation manager
+	sm *module.SimulationManager
+
 	// the configurator
 	configurator module.Configurator
 
@@ -453,12 +457,28 @@ func NewSidechain(
 		app.GetSubspace(feemarkettypes.ModuleName),
 	)
 
+	app.OracleKeeper = oraclekeeper.NewKeeper(
+		appCodec, keys[oracletypes.StoreKey], app.GetSubspace(oracletypes.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.SlashingKeeper, &stakingKeeper, distrtypes.ModuleName,
+	)
+
+	// the oracle precompile exposes the oracle prices to the EVM. The geth EVM
+	// of ethermint does not run custom precompiles, so it is only served by an
+	// EVM constructor binding it to the context of each EVM.
+	precompiles := evmvm.PrecompiledContracts{
+		oracleprecompile.Address: oracleprecompile.NewPrecompile(app.OracleKeeper),
+	}
+
+	// devEarnTracer is shared by the EVM, which records the gas of each call
+	// frame, and dev earn, which attributes it to the registered contracts
+	devEarnTracer := devearnmodulekeeper.NewCallTreeTracer()
 	app.EvmKeeper = evmkeeper.NewKeeper(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey],
 		// same here. need to define fee address and add here.
 		authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, &stakingKeeper, app.FeeMarketKeeper,
-		nil, geth.NewEVM, tracer, app.GetSubspace(evmtypes.ModuleName),
+		precompiles, devEarnTracer.WrapEVMConstructor(geth.NewEVM), tracer, app.GetSubspace(evmtypes.ModuleName),
 	)
 
 	// Create IBC Keeper
@@ -475,7 +495,7 @@ func NewSidechain(
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(erc20types.RouterKey, erc20.NewErc20ProposalHandler(&app.Erc20Keeper)).
 		AddRoute(ibcinterchainswaptypes.RouterKey, ibcinterchainswap.NewMarketFeeUpdateProposalHandler(app.InterchainSwapKeeper)).
-		AddRoute(devearnmoduletypes.RouterKey, devearnmodule.NewDevEarnProposalHandler(&app.DevearnKeeper))
+		AddRoute(devearnmoduletypes.RouterKey, devearnmodule.NewDevEarnProposalHandler(&app.DevearnKeeper)) //nolint:staticcheck // legacy devearn proposals are kept for compatibility
 
 	govConfig := govtypes.DefaultConfig()
 	/*
@@ -509,10 +529,7 @@ func NewSidechain(
 		app.AccountKeeper, app.BankKeeper, app.EvmKeeper, app.StakingKeeper,
 	)
 
-	app.OracleKeeper = oraclekeeper.NewKeeper(
-		appCodec, keys[oracletypes.StoreKey], app.GetSubspace(oracletypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.SlashingKeeper, &stakingKeeper, distrtypes.ModuleName,
-	)
+	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
 
 	app.DevearnKeeper = *devearnmodulekeeper.NewKeeper(
 		appCodec,
@@ -525,8 +542,12 @@ func NewSidechain(
 		app.EvmKeeper,
 		app.OracleKeeper,
 		app.Erc20Keeper,
+		&stakingKeeper,
+		app.DistrKeeper,
+		epochsKeeper,
+		devEarnTracer,
 	)
-	devearnModule := devearnmodule.NewAppModule(appCodec, app.DevearnKeeper, app.AccountKeeper, app.BankKeeper, app.EvmKeeper)
+	devearnModule := devearnmodule.NewAppModule(appCodec, app.DevearnKeeper, app.AccountKeeper, app.BankKeeper, app.EvmKeeper, app.Erc20Keeper, govKeeper)
 
 	app.GovKeeper = *govKeeper.SetHooks(
 		govtypes.NewMultiGovHooks(),
@@ -539,7 +560,6 @@ func NewSidechain(
 		),
 	)
 
-	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochskeeper.NewMultiEpochHooks(
 			// insert epoch hooks receivers here
@@ -654,7 +674,7 @@ func NewSidechain(
 			app.AccountKeeper, app.StakingKeeper, app.BaseApp.DeliverTx,
 			encodingConfig.TxConfig,
 		),
-		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
+		auth.NewAppModule(appCodec, app.AccountKeeper, RandomGenesisAccounts),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
@@ -683,7 +703,7 @@ func NewSidechain(
 		feemarket.NewAppModule(app.FeeMarketKeeper, app.GetSubspace(feemarkettypes.ModuleName)),
 		// Sidechain app modules
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil),
-		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper, app.GetSubspace(erc20types.ModuleName)),
+		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper, app.BankKeeper, app.GovKeeper, app.GetSubspace(erc20types.ModuleName)),
 		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
 	)
 
@@ -804,6 +824,16 @@ func NewSidechain(
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
+	// create the simulation manager and define the order of the modules for deterministic simulations
+	//
+	// NOTE: this is not required for apps that don't use the simulator for fuzz testing
+	// transactions
+	overrideModules := map[string]module.AppModuleSimulation{
+		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AccountKeeper, RandomGenesisAccounts),
+	}
+	app.sm = module.NewSimulationManagerFromAppModules(app.mm.Modules, overrideModules)
+	app.sm.RegisterStoreDecoders()
+
 	// add test gRPC service for testing gRPC queries in isolation
 	// testdata.RegisterTestServiceServer(app.GRPCQueryRouter(), testdata.TestServiceImpl{})
 
@@ -1038,6 +1068,11 @@ func (app *Sidechain) RegisterNodeService(clientCtx client.Context) {
 	node.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
 }
 
+// SimulationManager implements the SimulationApp interface
+func (app *Sidechain) SimulationManager() *module.SimulationManager {
+	return app.sm
+}
+
 // IBC Go TestingApp functions
 
 // GetBaseApp implements the TestingApp interface.
@@ -1119,3 +1154,15 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(ibcinterchainswaptypes.ModuleName)
 	return paramsKeeper
 }
+
+// RandomGenesisAccounts returns the simulation accounts as ethereum accounts.
+// The vesting accounts of the auth simulation are not registered in the app.
+func RandomGenesisAccounts(simState *module.SimulationState) authtypes.GenesisAccounts {
+	genesisAccs := make(authtypes.GenesisAccounts, len(simState.Accounts))
+	for i, acc := range simState.Accounts {
+		ethAccount := ethermint.ProtoAccount().(*ethermint.EthAccount)
+		ethAccount.BaseAccount = authtypes.NewBaseAccountWithAddress(acc.Address)
+		genesisAccs[i] = ethAccount
+	}
+	return genesisAccs
+}
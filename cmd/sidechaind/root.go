@@ -44,6 +44,7 @@ import (
 	srvflags "github.com/evmos/ethermint/server/flags"
 
 	"sidechain/app"
+	oraclecli "sidechain/x/oracle/client/cli"
 
 	sidechainkr "sidechain/crypto/keyring"
 
@@ -130,6 +131,7 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 		rpc.StatusCommand(),
 		queryCommand(),
 		txCommand(),
+		oracleCommand(),
 		ethermintclient.KeyCommands(app.DefaultNodeHome),
 	)
 	rootCmd, err := srvflags.AddTxFlags(rootCmd)
@@ -198,6 +200,22 @@ func txCommand() *cobra.Command {
 	return cmd
 }
 
+func oracleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "oracle",
+		Short:                      "Oracle price feeder subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		oraclecli.GetCmdFeeder(),
+	)
+
+	return cmd
+}
+
 // initAppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func initAppConfig() (string, interface{}) {
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity ^0.8.0;
+
+/// @dev The oracle precompile is deployed at this address.
+address constant ORACLE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000900;
+
+/// @dev The oracle precompile exposes the exchange rates voted by the
+/// validators of the oracle module. Rates are fixed-point numbers with 18
+/// decimals and timestamps are unix times in seconds.
+IOracle constant ORACLE_CONTRACT = IOracle(ORACLE_PRECOMPILE_ADDRESS);
+
+interface IOracle {
+    /// @dev ExchangeRate is the exchange rate of a denom and the time it was
+    /// last updated at.
+    struct ExchangeRate {
+        string denom;
+        uint256 rate;
+        uint64 lastUpdated;
+    }
+
+    /**
+     * @dev Returns the exchange rate of `denom` and the time it was last
+     * updated at. Reverts if the denom has no exchange rate.
+     */
+    function getExchangeRate(string calldata denom)
+        external
+        view
+        returns (uint256 rate, uint64 lastUpdated);
+
+    /**
+     * @dev Returns the exchange rates of every denom, sorted by denom.
+     */
+    function getExchangeRates() external view returns (ExchangeRate[] memory rates);
+
+    /**
+     * @dev Returns the time-weighted average exchange rate of `denom` over the
+     * last `lookbackSeconds` seconds, or over every historic price when it is
+     * zero, and the time of the latest historic price. Reverts if the denom
+     * has no historic price within the lookback window.
+     */
+    function getTwap(string calldata denom, uint64 lookbackSeconds)
+        external
+        view
+        returns (uint256 rate, uint64 lastUpdated);
+}
@@ -0,0 +1,23 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed compiled_contracts/IOracle.json
+	iOracleJSON []byte
+
+	// IOracleContract is the interface of the oracle precompile
+	IOracleContract evmtypes.CompiledContract
+)
+
+func init() {
+	err := json.Unmarshal(iOracleJSON, &IOracleContract)
+	if err != nil {
+		panic(err)
+	}
+}
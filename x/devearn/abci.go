@@ -0,0 +1,38 @@
+package devearn
+
+import (
+	"sidechain/x/devearn/keeper"
+	"sidechain/x/devearn/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker settles the next contracts of a running reward distribution,
+// prunes the sender gas counters of the ended reward epochs, samples the TVL of
+// the next registered contracts of a sampling round and starts tracing the call
+// tree of the EVM transactions delivered in this block if dev earn attributes
+// gas to internal contract calls
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ContinueDistribution(ctx)
+
+	// the sender gas caps only apply to the running reward epoch
+	params := k.GetParams(ctx)
+	k.PruneSenderGas(ctx, k.CurrentRewardEpoch(ctx, params), keeper.SenderGasPrunesPerBlock)
+
+	if !params.EnableDevEarn {
+		return
+	}
+
+	k.SampleTvl(ctx)
+
+	if params.GasAttribution != types.GAS_ATTRIBUTION_CALL_TREE {
+		return
+	}
+
+	k.ActivateCallTreeTracer(ctx)
+}
+
+// EndBlocker stops tracing the call tree of the EVM transactions
+func EndBlocker(_ sdk.Context, k keeper.Keeper) {
+	k.DeactivateCallTreeTracer()
+}
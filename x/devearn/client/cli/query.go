@@ -31,6 +31,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams(), CmdDevEarnInfos(), CmdDevEarnInfo())
 	cmd.AddCommand(CmdListAssets())
 	cmd.AddCommand(CmdShowAssets())
+	cmd.AddCommand(CmdPendingRewards(), CmdContractPendingRewards())
+	cmd.AddCommand(CmdEpochRewards(), CmdContractRewardHistory())
+	cmd.AddCommand(CmdBlocklist())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
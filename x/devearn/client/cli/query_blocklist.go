@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"sidechain/x/devearn/types"
+)
+
+func CmdBlocklist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocklist",
+		Short: "Query the contract and deployer addresses blocked from dev earn",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryBlocklistRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Blocklist(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "blocklist")
+
+	return cmd
+}
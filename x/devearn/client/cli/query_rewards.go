@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"sidechain/x/devearn/types"
+)
+
+func CmdPendingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-rewards OWNER_ADDRESS",
+		Short: "Query the unclaimed rewards of an owner",
+		Long:  "Query the unclaimed rewards of an owner by hex or bech32 address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingRewardsRequest{
+				OwnerAddress: args[0],
+			}
+
+			res, err := queryClient.PendingRewards(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdContractPendingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-pending-rewards CONTRACT_ADDRESS",
+		Short: "Query the unclaimed rewards earned by a contract",
+		Long:  "Query the unclaimed rewards earned by a contract by contract address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryContractPendingRewardsRequest{
+				Contract: args[0],
+			}
+
+			res, err := queryClient.ContractPendingRewards(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEpochRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-rewards EPOCH",
+		Short: "Query the rewards distributed at the end of an epoch",
+		Long:  "Query the rewards distributed at the end of an epoch and the rewards earned by each contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			epoch, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid epoch number: %s", args[0])
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryEpochRewardsRequest{
+				Epoch:      epoch,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.EpochRewards(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch-rewards")
+
+	return cmd
+}
+
+func CmdContractRewardHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-history CONTRACT_ADDRESS",
+		Short: "Query the rewards earned by a contract per epoch",
+		Long:  "Query the rewards earned by a contract per epoch by contract address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryContractRewardHistoryRequest{
+				Contract:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ContractRewardHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reward-history")
+
+	return cmd
+}
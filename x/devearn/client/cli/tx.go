@@ -3,7 +3,9 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -18,6 +20,243 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 )
 
+// FlagWithdrawAddress is the withdraw address to claim the rewards for
+const FlagWithdrawAddress = "withdraw-address"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewRegisterDevEarnCmd(),
+		NewCancelDevEarnCmd(),
+		NewClaimDevEarnRewardsCmd(),
+		NewUpdateDevEarnOwnerCmd(),
+		NewSetDevEarnWithdrawAddressCmd(),
+	)
+	return cmd
+}
+
+// NewRegisterDevEarnCmd returns a CLI command handler for self-registering a
+// contract deployed by the sender
+func NewRegisterDevEarnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register CONTRACT_HEX NONCES EPOCHS [OWNER_HEX]",
+		Short: "Register a contract you deployed for dev earn rewards",
+		Long: `Register a contract you deployed for dev earn rewards.
+NONCES is a comma separated list: the nonce of your deployment tx, followed by the nonces of any factory in between.
+When the owner is omitted, rewards are sent to the deployer.`,
+		Example: fmt.Sprintf("$ %s tx %s register 0x5f6659B6F712c729c46786bA9562eC50907c67CF 1,2 10 --from=<key_or_address>", version.AppName, types.ModuleName),
+		Args:    cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+
+			var nonces []uint64
+			for _, n := range strings.Split(args[1], ",") {
+				nonce, err := strconv.ParseUint(strings.TrimSpace(n), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid nonce %s: %w", n, err)
+				}
+				nonces = append(nonces, nonce)
+			}
+
+			epochs, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			var ownerAddr string
+			if len(args) == 4 {
+				ownerAddr = args[3]
+			}
+
+			msg := types.NewMsgRegisterDevEarn(
+				common.HexToAddress(args[0]),
+				clientCtx.GetFromAddress(),
+				nonces,
+				ownerAddr,
+				uint32(epochs),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelDevEarnCmd returns a CLI command handler for cancelling a
+// self-registered contract
+func NewCancelDevEarnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel CONTRACT_HEX",
+		Short:   "Cancel the dev earn registration of a contract you deployed",
+		Long:    "Cancel the dev earn registration of a contract you deployed. The registration deposit is refunded.",
+		Example: fmt.Sprintf("$ %s tx %s cancel 0x5f6659B6F712c729c46786bA9562eC50907c67CF --from=<key_or_address>", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+
+			msg := types.NewMsgCancelDevEarn(common.HexToAddress(args[0]), clientCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClaimDevEarnRewardsCmd returns a CLI command handler for claiming the dev
+// earn rewards accrued to the sender
+func NewClaimDevEarnRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim [CONTRACT_HEX]",
+		Short:   "Claim the dev earn rewards accrued to you",
+		Long:    "Claim the dev earn rewards accrued to you, either for every contract or for the given contract only. The owner of a contract can claim the rewards accrued to its withdraw address with --withdraw-address, and they are sent to the withdraw address.",
+		Example: fmt.Sprintf("$ %s tx %s claim --from=<key_or_address>", version.AppName, types.ModuleName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var contract string
+			if len(args) == 1 {
+				if !common.IsHexAddress(args[0]) {
+					return fmt.Errorf("invalid contract address: %s", args[0])
+				}
+				contract = args[0]
+			}
+
+			withdrawAddr, err := cmd.Flags().GetString(FlagWithdrawAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimDevEarnRewards(clientCtx.GetFromAddress(), contract, withdrawAddr)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagWithdrawAddress, "", "hex withdraw address of the contract to claim the rewards for as its owner")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateDevEarnOwnerCmd returns a CLI command handler for transferring the
+// ownership of a registered contract
+func NewUpdateDevEarnOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-owner CONTRACT_HEX NEW_OWNER_HEX",
+		Short:   "Transfer the ownership of a contract registered for dev earn",
+		Long:    "Transfer the ownership of a contract registered for dev earn. Only the current owner can transfer it.",
+		Example: fmt.Sprintf("$ %s tx %s update-owner 0x5f6659B6F712c729c46786bA9562eC50907c67CF 0xDF36A6ab8Ff0d3B5B8e0d73E3a6E4F4C3d8A6C1f --from=<key_or_address>", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+
+			if !common.IsHexAddress(args[1]) {
+				return fmt.Errorf("invalid owner address: %s", args[1])
+			}
+
+			msg := types.NewMsgUpdateDevEarnOwner(common.HexToAddress(args[0]), clientCtx.GetFromAddress(), common.HexToAddress(args[1]))
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetDevEarnWithdrawAddressCmd returns a CLI command handler for setting the
+// address the rewards of a registered contract are accrued to
+func NewSetDevEarnWithdrawAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-withdraw-address CONTRACT_HEX [WITHDRAW_HEX]",
+		Short:   "Set the address the dev earn rewards of a contract are accrued to",
+		Long:    "Set the address the dev earn rewards of a contract are accrued to. The rewards are accrued to the owner again when the address is omitted.",
+		Example: fmt.Sprintf("$ %s tx %s set-withdraw-address 0x5f6659B6F712c729c46786bA9562eC50907c67CF 0xDF36A6ab8Ff0d3B5B8e0d73E3a6E4F4C3d8A6C1f --from=<key_or_address>", version.AppName, types.ModuleName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+
+			var withdrawAddr string
+			if len(args) == 2 {
+				if !common.IsHexAddress(args[1]) {
+					return fmt.Errorf("invalid withdraw address: %s", args[1])
+				}
+				withdrawAddr = args[1]
+			}
+
+			msg := types.NewMsgSetDevEarnWithdrawAddress(common.HexToAddress(args[0]), clientCtx.GetFromAddress(), withdrawAddr)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewRegisterDevEarnProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "register-dev-earn-info CONTRACT_ADDRESS  EPOCHS OWNERADDR",
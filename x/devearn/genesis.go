@@ -5,15 +5,60 @@ import (
 	"sidechain/x/devearn/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	for _, devEarnInfo := range genState.DevEarnInfos {
+		k.SetDevEarnInfo(ctx, devEarnInfo)
+	}
+
 	// Set all the assets
 	for _, elem := range genState.AssetsList {
 		k.SetAssets(ctx, elem)
 	}
 
+	for _, accrued := range genState.AccruedRewards {
+		k.SetAccruedRewards(ctx, accrued)
+	}
+
+	for _, address := range genState.Blocklist {
+		k.SetBlocked(ctx, common.HexToAddress(address))
+	}
+
+	for _, record := range genState.EpochRewards {
+		k.SetEpochRewardRecord(ctx, record)
+	}
+
+	for _, record := range genState.ContractRewards {
+		k.SetContractRewardRecord(ctx, record)
+	}
+
+	if genState.DistributionProgress != nil {
+		k.SetDistributionProgress(ctx, *genState.DistributionProgress)
+	}
+
+	for _, gasMeter := range genState.PendingGasMeters {
+		k.AddPendingGasMeter(ctx, common.HexToAddress(gasMeter.Contract), gasMeter.GasMeter)
+	}
+
+	for _, accumulator := range genState.TvlAccumulators {
+		k.SetContractTvlAccumulator(ctx, common.HexToAddress(accumulator.Contract), accumulator.Accumulator)
+	}
+
+	if genState.TotalTvlAccumulator != nil {
+		k.SetTotalTvlAccumulator(ctx, *genState.TotalTvlAccumulator)
+	}
+
+	if genState.TvlSampleCursor != "" {
+		k.SetTvlSampleCursor(ctx, common.HexToAddress(genState.TvlSampleCursor).Bytes())
+	}
+
+	for _, price := range genState.AssetPrices {
+		k.SetAssetPrice(ctx, price)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -24,6 +69,24 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 	genesis.DevEarnInfos = k.GetAllDevEarnInfos(ctx)
 	genesis.AssetsList = k.GetAllAssets(ctx)
+	genesis.AccruedRewards = k.GetAllAccruedRewards(ctx)
+	for _, address := range k.GetBlocklist(ctx) {
+		genesis.Blocklist = append(genesis.Blocklist, address.Hex())
+	}
+	genesis.EpochRewards = k.GetAllEpochRewardRecords(ctx)
+	genesis.ContractRewards = k.GetAllContractRewardRecords(ctx)
+	if progress, found := k.GetDistributionProgress(ctx); found {
+		genesis.DistributionProgress = &progress
+	}
+	genesis.PendingGasMeters = k.GetAllPendingGasMeters(ctx)
+	genesis.TvlAccumulators = k.GetAllContractTvlAccumulators(ctx)
+	if accumulator, found := k.GetTotalTvlAccumulator(ctx); found {
+		genesis.TotalTvlAccumulator = &accumulator
+	}
+	if cursor, running := k.GetTvlSampleCursor(ctx); running {
+		genesis.TvlSampleCursor = common.BytesToAddress(cursor).Hex()
+	}
+	genesis.AssetPrices = k.GetAllAssetPrices(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
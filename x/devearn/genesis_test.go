@@ -2,11 +2,14 @@ package devearn_test
 
 import (
 	"testing"
+	"time"
 
 	keepertest "sidechain/testutil/keeper"
 	"sidechain/x/devearn"
 	"sidechain/x/devearn/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -14,6 +17,9 @@ func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
 
+		DevEarnInfos: []types.DevEarnInfo{
+			types.NewDevEarn(common.HexToAddress("0x2000000000000000000000000000000000000001"), 10, 5, "0x1000000000000000000000000000000000000001"),
+		},
 		AssetsList: []types.Assets{
 			{
 				Denom: "aside",
@@ -22,6 +28,74 @@ func TestGenesis(t *testing.T) {
 				Denom: "bside",
 			},
 		},
+		AccruedRewards: []types.AccruedRewards{
+			{
+				OwnerAddress: "0x1000000000000000000000000000000000000001",
+				Contract:     "0x2000000000000000000000000000000000000001",
+				Rewards:      sdk.NewCoins(sdk.NewInt64Coin("aside", 100)),
+			},
+		},
+		EpochRewards: []types.EpochRewardRecord{
+			{
+				Epoch:    3,
+				Time:     time.Unix(1000, 0).UTC(),
+				TotalGas: 100,
+				Rewards:  sdk.NewCoins(sdk.NewInt64Coin("aside", 100)),
+			},
+		},
+		ContractRewards: []types.ContractRewardRecord{
+			{
+				Epoch:    3,
+				Contract: "0x2000000000000000000000000000000000000001",
+				Receiver: "0x1000000000000000000000000000000000000001",
+				GasUsed:  100,
+				GasShare: sdk.OneDec(),
+				TvlRatio: sdk.ZeroDec(),
+				Rewards:  sdk.NewCoins(sdk.NewInt64Coin("aside", 100)),
+			},
+		},
+		DistributionProgress: &types.DistributionProgress{
+			Epoch:       4,
+			Time:        time.Unix(2000, 0).UTC(),
+			Height:      10,
+			TotalGas:    100,
+			RewardPool:  sdk.NewCoins(sdk.NewInt64Coin("aside", 100)),
+			Cursor:      common.HexToAddress("0x2000000000000000000000000000000000000001").Bytes(),
+			Allocated:   sdk.NewDecCoins(sdk.NewInt64DecCoin("aside", 40)),
+			Distributed: sdk.NewCoins(sdk.NewInt64Coin("aside", 40)),
+			TotalTvl:    sdk.ZeroDec(),
+		},
+		PendingGasMeters: []types.PendingGasMeter{
+			{
+				Contract: "0x2000000000000000000000000000000000000001",
+				GasMeter: 50,
+			},
+		},
+		TvlAccumulators: []types.ContractTvlAccumulator{
+			{
+				Contract: "0x2000000000000000000000000000000000000001",
+				Accumulator: types.TvlAccumulator{
+					WeightedTvl: sdk.NewDec(600),
+					Duration:    60,
+					LastTvl:     sdk.NewDec(20),
+					LastTime:    time.Unix(1060, 0).UTC(),
+				},
+			},
+		},
+		TotalTvlAccumulator: &types.TvlAccumulator{
+			WeightedTvl: sdk.NewDec(1200),
+			Duration:    60,
+			LastTvl:     sdk.NewDec(40),
+			LastTime:    time.Unix(1060, 0).UTC(),
+		},
+		TvlSampleCursor: "0x2000000000000000000000000000000000000001",
+		AssetPrices: []types.AssetPrice{
+			{
+				Denom: "aside",
+				Price: sdk.NewDecWithPrec(15, 1),
+				Time:  time.Unix(1060, 0).UTC(),
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -30,6 +104,16 @@ func TestGenesis(t *testing.T) {
 	got := devearn.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
 
+	require.Equal(t, genesisState.DevEarnInfos, got.DevEarnInfos)
 	require.ElementsMatch(t, genesisState.AssetsList, got.AssetsList)
+	require.ElementsMatch(t, genesisState.AccruedRewards, got.AccruedRewards)
+	require.Equal(t, genesisState.EpochRewards, got.EpochRewards)
+	require.Equal(t, genesisState.ContractRewards, got.ContractRewards)
+	require.Equal(t, genesisState.DistributionProgress, got.DistributionProgress)
+	require.Equal(t, genesisState.PendingGasMeters, got.PendingGasMeters)
+	require.Equal(t, genesisState.TvlAccumulators, got.TvlAccumulators)
+	require.Equal(t, genesisState.TotalTvlAccumulator, got.TotalTvlAccumulator)
+	require.Equal(t, genesisState.TvlSampleCursor, got.TvlSampleCursor)
+	require.Equal(t, genesisState.AssetPrices, got.AssetPrices)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -23,10 +23,11 @@ func (k Keeper) IsAssetRegistered(
 	return store.Has(GetAssetBytes(denom))
 }
 
-// RemoveAssets removes a assets from the store
+// RemoveAssets removes a assets and its last known price from the store
 func (k Keeper) RemoveAssets(ctx sdk.Context, denom string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AssetsKey))
 	store.Delete(GetAssetBytes(denom))
+	k.DeleteAssetPrice(ctx, denom)
 }
 
 // GetAllAssets returns all assets
@@ -45,6 +46,48 @@ func (k Keeper) GetAllAssets(ctx sdk.Context) (list []types.Assets) {
 	return
 }
 
+// GetAssetPrice returns the last known oracle price of an asset
+func (k Keeper) GetAssetPrice(ctx sdk.Context, denom string) (types.AssetPrice, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAssetPrice)
+	bz := store.Get(GetAssetBytes(denom))
+	if len(bz) == 0 {
+		return types.AssetPrice{}, false
+	}
+
+	var price types.AssetPrice
+	k.cdc.MustUnmarshal(bz, &price)
+	return price, true
+}
+
+// SetAssetPrice stores the last known oracle price of an asset
+func (k Keeper) SetAssetPrice(ctx sdk.Context, price types.AssetPrice) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAssetPrice)
+	store.Set(GetAssetBytes(price.Denom), k.cdc.MustMarshal(&price))
+}
+
+// GetAllAssetPrices returns the last known oracle prices of all assets
+func (k Keeper) GetAllAssetPrices(ctx sdk.Context) []types.AssetPrice {
+	prices := []types.AssetPrice{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAssetPrice)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.AssetPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		prices = append(prices, price)
+	}
+
+	return prices
+}
+
+// DeleteAssetPrice removes the last known oracle price of an asset
+func (k Keeper) DeleteAssetPrice(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAssetPrice)
+	store.Delete(GetAssetBytes(denom))
+}
+
 // GetAssetsBytes returns the byte representation of the denom
 func GetAssetBytes(denom string) []byte {
 	return []byte(denom)
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"sidechain/x/devearn/types"
+)
+
+// IsBlocked returns true if the contract or deployer address is excluded from
+// dev earn by governance
+func (k Keeper) IsBlocked(ctx sdk.Context, address common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlocklist)
+	return store.Has(address.Bytes())
+}
+
+// SetBlocked adds an address to the blocklist
+func (k Keeper) SetBlocked(ctx sdk.Context, address common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlocklist)
+	store.Set(address.Bytes(), []byte{1})
+}
+
+// DeleteBlocked removes an address from the blocklist
+func (k Keeper) DeleteBlocked(ctx sdk.Context, address common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlocklist)
+	store.Delete(address.Bytes())
+}
+
+// GetBlocklist returns all the blocked contract and deployer addresses
+func (k Keeper) GetBlocklist(ctx sdk.Context) []common.Address {
+	var blocklist []common.Address
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlocklist)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		blocklist = append(blocklist, common.BytesToAddress(iterator.Key()))
+	}
+
+	return blocklist
+}
+
+// isDevEarnBlocked returns true if the registered contract, the deployer that
+// self-registered it or any of the factories it was created through are blocked
+func (k Keeper) isDevEarnBlocked(ctx sdk.Context, contract common.Address) bool {
+	if k.IsBlocked(ctx, contract) {
+		return true
+	}
+
+	devEarnInfo, found := k.GetDevEarnInfo(ctx, contract)
+	if !found {
+		return false
+	}
+	return k.isDeployerBlocked(ctx, devEarnInfo)
+}
+
+// isDevEarnInfoBlocked returns true if the registered contract, its deployer or
+// any of its factories are blocked
+func (k Keeper) isDevEarnInfoBlocked(ctx sdk.Context, devEarnInfo types.DevEarnInfo) bool {
+	return k.IsBlocked(ctx, common.HexToAddress(devEarnInfo.Contract)) || k.isDeployerBlocked(ctx, devEarnInfo)
+}
+
+// isDeployerBlocked returns true if the deployer that self-registered the
+// contract or any of the factories derived from the registration nonces are
+// blocked, the same way RegisterDevEarn checks them
+func (k Keeper) isDeployerBlocked(ctx sdk.Context, devEarnInfo types.DevEarnInfo) bool {
+	if devEarnInfo.DeployerAddress == "" {
+		return false
+	}
+
+	deployer, err := sdk.AccAddressFromBech32(devEarnInfo.DeployerAddress)
+	if err != nil {
+		return false
+	}
+
+	derived := common.BytesToAddress(deployer)
+	if k.IsBlocked(ctx, derived) {
+		return true
+	}
+	// the last nonce derives the contract itself
+	for i := 0; i+1 < len(devEarnInfo.Nonces); i++ {
+		derived = crypto.CreateAddress(derived, devEarnInfo.Nonces[i])
+		if k.IsBlocked(ctx, derived) {
+			return true
+		}
+	}
+	return false
+}
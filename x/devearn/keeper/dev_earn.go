@@ -62,11 +62,27 @@ func (k Keeper) ResetGasMeter(ctx sdk.Context, contract common.Address) bool {
 	return true
 }
 
-// DeletDevEarnInfo removes an DevEarnInfo
+// DeletDevEarnInfo removes an DevEarnInfo and its TVL samples
 func (k Keeper) DeleteDevEarnInfo(ctx sdk.Context, devEarnInfo types.DevEarnInfo) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDevEarn)
 	key := common.HexToAddress(devEarnInfo.Contract)
 	store.Delete(key.Bytes())
+	k.DeleteContractTvlAccumulator(ctx, key)
+}
+
+// RefundDeposit returns the registration deposit escrowed for a self-registered
+// contract to its deployer
+func (k Keeper) RefundDeposit(ctx sdk.Context, devEarnInfo types.DevEarnInfo) error {
+	if devEarnInfo.Deposit.IsZero() {
+		return nil
+	}
+
+	deployer, err := sdk.AccAddressFromBech32(devEarnInfo.DeployerAddress)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deployer, devEarnInfo.Deposit)
 }
 
 // IsDevEarnInfoRegistered - check if registered dev earn info is registered
@@ -80,7 +80,7 @@ func (suite *KeeperTestSuite) TestGetDevInfo() {
 func (suite *KeeperTestSuite) TestDeleteDevEarnInfo() {
 	suite.deployContracts()
 	// Register Incentive
-	_, err := suite.app.DevearnKeeper.RegisterDevEarnInfo(
+	_, err := suite.app.DevearnKeeper.RegisterDevEarnContract(
 		suite.ctx,
 		contract,
 		epochs,
@@ -8,181 +8,446 @@ import (
 
 	erc20types "sidechain/x/erc20/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
-// DistributeRewards transfers the allocated rewards to the participants
-//   - allocates the amount to be distributed from the inflation pool
-//   - distributes the rewards to all participants
-//   - deletes all gas meters
-//   - updates the remaining epochs of each incentive
-//   - sets the cumulative totalGas to zero
-func (k Keeper) DistributeRewards(ctx sdk.Context) error {
+// DistributeRewards starts the distribution of the rewards of an epoch
+//   - finishes the distribution of the previous epoch if it is still running
+//   - snapshots the gas used during the epoch and the reward pool
+//   - settles the contracts in store order, up to MaxDistributionsPerBlock per
+//     block. The remaining contracts are settled by ContinueDistribution in the
+//     following blocks.
+func (k Keeper) DistributeRewards(ctx sdk.Context, epochNumber int64) error {
 	logger := k.Logger(ctx)
-	devEarnGasMeters := make(map[string]uint64)
-	devEarnRewardReceivers := make(map[string]string)
+	if progress, found := k.GetDistributionProgress(ctx); found {
+		logger.Info(
+			"finishing the dev earn distribution of the previous epoch",
+			"epoch", progress.Epoch,
+		)
+		k.settleContracts(ctx, 0)
+	}
+
+	totalGas := uint64(0)
+	escrowed := sdk.Coins{}
 	k.IterateDevEarnInfos(ctx, func(devEarnInfo types.DevEarnInfo) (stop bool) {
-		devEarnGasMeters[devEarnInfo.GetContract()] = devEarnInfo.GetGasMeter()
-		devEarnRewardReceivers[devEarnInfo.GetContract()] = devEarnInfo.GetOwnerAddress()
-		devEarnInfo.Epochs--
-
-		// Update dev_earn info and reset its total gas count. Remove dev_earn info if it
-		// has no remaining epochs left.
-		if devEarnInfo.IsActive() {
-			devEarnInfo.GasMeter = 0
-			k.SetDevEarnInfo(ctx, devEarnInfo)
-		} else {
-			k.DeleteDevEarnInfo(ctx, devEarnInfo)
-			logger.Info(
-				"devEarn finalized",
-				"contract", devEarnInfo.Contract,
-			)
+		// blocked contracts don't share the rewards of the gas they used
+		if !k.isDevEarnInfoBlocked(ctx, devEarnInfo) {
+			totalGas += devEarnInfo.GasMeter
 		}
+		escrowed = escrowed.Add(devEarnInfo.Deposit...)
+		return false
+	})
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeDistributeRewards,
-				sdk.NewAttribute(types.AttributeKeyContract, devEarnInfo.Contract),
-				sdk.NewAttribute(
-					types.AttributeKeyEpochs,
-					strconv.FormatUint(uint64(devEarnInfo.Epochs), 10),
-				),
-			),
+	params := k.GetParams(ctx)
+	totalTvl := k.averageTotalTvl(ctx, params)
+
+	// the pool is kept for the next epoch if no gas was spent on dev earn
+	rewardPool := sdk.Coins{}
+	if totalGas == 0 {
+		logger.Debug(
+			"no gas spent on dev earn during epoch",
 		)
-		return false
+	} else {
+		rewardPool = k.GetRewardPool(ctx, escrowed)
+	}
+
+	if !rewardPool.IsZero() && params.TvlShare > 0 {
+		_, skipped := k.tvlAssets(ctx)
+		k.emitSkippedTvlAssets(ctx, skipped)
+	}
+
+	k.SetDistributionProgress(ctx, types.DistributionProgress{
+		Epoch:       epochNumber,
+		Time:        ctx.BlockTime(),
+		Height:      ctx.BlockHeight(),
+		TotalGas:    totalGas,
+		RewardPool:  rewardPool,
+		Allocated:   sdk.DecCoins{},
+		Distributed: sdk.Coins{},
+		TotalTvl:    totalTvl,
 	})
-	k.SendReward(ctx, devEarnGasMeters, devEarnRewardReceivers)
+	k.settleContracts(ctx, params.MaxDistributionsPerBlock)
 
 	return nil
 }
 
-func (k Keeper) SendReward(
-	ctx sdk.Context,
-	devEarnGasMeters map[string]uint64,
-	devEarnRewardReceivers map[string]string,
-) (rewards sdk.Coins, count uint64) {
-	logger := k.Logger(ctx)
-	var totalGasDec sdk.Dec = sdk.NewDec(0)
-	// Check if participants spent gas on interacting with incentive
-	for _, gasMeter := range devEarnGasMeters {
-		totalGasDec = totalGasDec.Add(sdk.NewDecFromBigInt(new(big.Int).SetUint64(gasMeter)))
+// ContinueDistribution settles the next contracts of a running distribution,
+// once per block
+func (k Keeper) ContinueDistribution(ctx sdk.Context) {
+	progress, found := k.GetDistributionProgress(ctx)
+	if !found || progress.Height == ctx.BlockHeight() {
+		return
 	}
-	if totalGasDec.Equal(sdk.NewDec(0)) {
-		logger.Debug(
-			"no gas spent on dev earn during epoch",
-		)
-		return sdk.Coins{}, 0
+
+	k.settleContracts(ctx, k.GetParams(ctx).MaxDistributionsPerBlock)
+}
+
+// settleContracts settles up to limit contracts from the cursor of the running
+// distribution and finalizes it once every contract is settled. There is no
+// limit when zero.
+func (k Keeper) settleContracts(ctx sdk.Context, limit uint64) {
+	progress, found := k.GetDistributionProgress(ctx)
+	if !found {
+		return
 	}
-	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
-	// TODO: Check base denom
-	denom := "aside"
-	// denom, err := sdk.GetBaseDenom()
-	// if err != nil {
-	// 	logger.Debug("could not get the denom of smallest unit registered", "error", err.Error())
-	// }
-	totalReward := k.bankKeeper.GetBalance(ctx, moduleAddr, denom)
-	// Get fees, tvl split
-	split := k.GetParams(ctx).TvlShare
-	for contract, gasmeter := range devEarnGasMeters {
-		cumulativeGas := sdk.NewDecFromBigInt(new(big.Int).SetUint64(gasmeter))
-		gasRatio := cumulativeGas.Quo(totalGasDec)
-		tvlRatio, tvlErr := k.TvlReward(ctx, contract)
-		if tvlErr != nil {
-			logger.Debug("could not get tvl ratio", "error", tvlErr.Error())
-		}
 
-		// split total reward using tvl_param in parameters
-		rewardTvlSplit := sdk.NewDecFromInt(totalReward.Amount).Mul(sdk.NewDecFromBigInt(new(big.Int).SetUint64(split)))
-		rewardTvlSplit = rewardTvlSplit.QuoInt(sdk.NewInt(10000))
-		rewardGasSplit := sdk.NewDecFromInt(totalReward.Amount).Sub(rewardTvlSplit)
-		reward := (gasRatio.Mul(rewardGasSplit)).Add(tvlRatio.Mul(rewardTvlSplit))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDevEarn)
+	iterator := store.Iterator(progress.Cursor, nil)
+	var devEarnInfos []types.DevEarnInfo
+	done := true
+	for ; iterator.Valid(); iterator.Next() {
+		if limit > 0 && uint64(len(devEarnInfos)) == limit {
+			progress.Cursor = append([]byte{}, iterator.Key()...)
+			done = false
+			break
+		}
+		var devEarnInfo types.DevEarnInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &devEarnInfo)
+		devEarnInfos = append(devEarnInfos, devEarnInfo)
+	}
+	iterator.Close()
 
-		if !reward.IsPositive() {
+	params := k.GetParams(ctx)
+	for _, devEarnInfo := range devEarnInfos {
+		if k.isRegisteredAfterSnapshot(progress, devEarnInfo) {
+			k.skipContract(ctx, devEarnInfo)
 			continue
 		}
+		k.settleContract(ctx, params, &progress, devEarnInfo)
+	}
+
+	if done {
+		k.finalizeDistribution(ctx, params, progress)
+		return
+	}
+	progress.Height = ctx.BlockHeight()
+	k.SetDistributionProgress(ctx, progress)
+}
+
+// isRegisteredAfterSnapshot returns true if the contract was registered while
+// the distribution was running. The distribution starts at the beginning of
+// the block, before the registrations of the block.
+func (k Keeper) isRegisteredAfterSnapshot(progress types.DistributionProgress, devEarnInfo types.DevEarnInfo) bool {
+	return !devEarnInfo.StartTime.Before(progress.Time)
+}
+
+// skipContract leaves a contract registered after the snapshot out of the
+// distribution. The gas it used so far belongs to the current epoch.
+func (k Keeper) skipContract(ctx sdk.Context, devEarnInfo types.DevEarnInfo) {
+	contract := common.HexToAddress(devEarnInfo.Contract)
+	devEarnInfo.GasMeter += k.GetPendingGasMeter(ctx, contract)
+	k.DeletePendingGasMeter(ctx, contract)
+	k.SetDevEarnInfo(ctx, devEarnInfo)
+}
+
+// settleContract accrues the rewards earned by a contract during the epoch to
+// its owner, pro rata to the gas used by and the TVL held in the contract, and
+// updates the remaining epochs of the contract. Contracts blocked before they
+// are settled earn no rewards.
+func (k Keeper) settleContract(
+	ctx sdk.Context,
+	params types.Params,
+	progress *types.DistributionProgress,
+	devEarnInfo types.DevEarnInfo,
+) {
+	logger := k.Logger(ctx)
+	contract := common.HexToAddress(devEarnInfo.Contract)
+	receiver := common.HexToAddress(devEarnInfo.RewardReceiver())
+
+	gasRatio := sdk.ZeroDec()
+	tvlRatio := sdk.ZeroDec()
+	coins := sdk.Coins{}
+	if !progress.RewardPool.IsZero() && !k.isDevEarnInfoBlocked(ctx, devEarnInfo) {
+		totalGasDec := sdk.NewDecFromBigInt(new(big.Int).SetUint64(progress.TotalGas))
+		gasRatio = sdk.NewDecFromBigInt(new(big.Int).SetUint64(devEarnInfo.GasMeter)).Quo(totalGasDec)
+
+		tvlRatio = k.epochTvlRatio(ctx, *progress, devEarnInfo.Contract)
+
+		// every denom of the pool is split pro rata
+		remaining := progress.RewardPool.Sub(progress.Distributed...)
+		for _, totalReward := range progress.RewardPool {
+			// split total reward using tvl_param in parameters
+			rewardTvlSplit := sdk.NewDecFromInt(totalReward.Amount).Mul(sdk.NewDecFromBigInt(new(big.Int).SetUint64(params.TvlShare)))
+			rewardTvlSplit = rewardTvlSplit.QuoInt(sdk.NewInt(10000))
+			rewardGasSplit := sdk.NewDecFromInt(totalReward.Amount).Sub(rewardTvlSplit)
+			reward := (gasRatio.Mul(rewardGasSplit)).Add(tvlRatio.Mul(rewardTvlSplit))
 
-		coin := sdk.Coin{Denom: denom, Amount: reward.TruncateInt()}
-		coins := sdk.Coins{}
-		coins = coins.Add(coin)
+			if !reward.IsPositive() {
+				continue
+			}
+			progress.Allocated = progress.Allocated.Add(sdk.NewDecCoinFromDec(totalReward.Denom, reward))
 
-		participant := common.HexToAddress(devEarnRewardReceivers[contract])
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(
-			ctx,
-			types.ModuleName,
-			sdk.AccAddress(participant.Bytes()),
-			coins,
+			// never pay more than what is left of the pool
+			amount := sdk.MinInt(reward.TruncateInt(), remaining.AmountOf(totalReward.Denom))
+			if amount.IsPositive() {
+				coins = coins.Add(sdk.NewCoin(totalReward.Denom, amount))
+			}
+		}
+	}
+
+	if !coins.IsZero() {
+		// rewards are accrued to the withdraw address or the owner, who claims
+		// them with MsgClaimDevEarnRewards
+		k.AccrueRewards(ctx, receiver, contract, coins)
+		progress.Distributed = progress.Distributed.Add(coins...)
+	}
+
+	if params.RewardHistoryRetention > 0 && (devEarnInfo.GasMeter > 0 || !coins.IsZero()) {
+		k.SetContractRewardRecord(ctx, types.ContractRewardRecord{
+			Epoch:    progress.Epoch,
+			Contract: contract.Hex(),
+			Receiver: receiver.Hex(),
+			GasUsed:  devEarnInfo.GasMeter,
+			GasShare: gasRatio,
+			TvlRatio: tvlRatio,
+			Rewards:  coins,
+		})
+	}
+
+	k.resetContractTvlAccumulator(ctx, contract, progress.Time)
+
+	// Update dev_earn info and reset its total gas count to the gas used since
+	// the epoch ended. Remove dev_earn info if it has no remaining epochs left.
+	devEarnInfo.Epochs--
+	// a self-destructed contract can't earn anymore, it is cancelled
+	if account := k.evmKeeper.GetAccountWithoutBalance(ctx, contract); account == nil || !account.IsContract() {
+		devEarnInfo.Epochs = 0
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCancelDevEarn,
+				sdk.NewAttribute(types.AttributeKeyContract, devEarnInfo.Contract),
+				sdk.NewAttribute(types.AttributeKeyReason, types.CancelReasonNotDeployed),
+			),
 		)
-		if err != nil {
-			logger.Debug("failed to distribute developer's rewards",
-				"address", devEarnRewardReceivers[contract],
-				"allocation", coins.String(),
-				"contract_addr", contract,
+	}
+	pendingGas := k.GetPendingGasMeter(ctx, contract)
+	k.DeletePendingGasMeter(ctx, contract)
+	if devEarnInfo.IsActive() {
+		devEarnInfo.GasMeter = pendingGas
+		k.SetDevEarnInfo(ctx, devEarnInfo)
+	} else {
+		if err := k.RefundDeposit(ctx, devEarnInfo); err != nil {
+			logger.Error(
+				"failed to refund dev earn deposit",
+				"contract", devEarnInfo.Contract,
 				"error", err.Error(),
 			)
 		}
+		k.DeleteDevEarnInfo(ctx, devEarnInfo)
+		logger.Info(
+			"devEarn finalized",
+			"contract", devEarnInfo.Contract,
+		)
 	}
-	return rewards, count
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeRewards,
+			sdk.NewAttribute(types.AttributeKeyContract, devEarnInfo.Contract),
+			sdk.NewAttribute(
+				types.AttributeKeyEpochs,
+				strconv.FormatUint(uint64(devEarnInfo.Epochs), 10),
+			),
+		),
+	)
 }
 
-// TvlReward function calculates TVL rewards using assets in whitelist
-func (k Keeper) TvlReward(ctx sdk.Context, contractAddress string) (sdk.Dec, error) {
-	assets := k.GetAllAssets(ctx)
-	totalValueLocked, tvlErr := k.TotalTvl(ctx)
-	if tvlErr != nil {
-		return sdk.NewDec(0), tvlErr
-	}
-	totalValueLockedContract := sdk.NewDec(0)
-	// What should happen if one of the values is not loaded ? return 0 or cancel the process
-	for i := 0; i < len(assets); i++ {
-		// Get exchange rate using oracle module
-		rate, err := k.oracleKeeper.GetExchangeRate(ctx, assets[i].Denom)
-		if err != nil {
-			return sdk.NewDec(0), err
+// finalizeDistribution sends the rounding dust of a distribution to the
+// community pool, records the rewards of the epoch and prunes the expired
+// records
+func (k Keeper) finalizeDistribution(ctx sdk.Context, params types.Params, progress types.DistributionProgress) {
+	// the truncated rewards add up to whole coins that are not owed to any
+	// contract. The rest of the pool is kept for the next epoch.
+	allocated, _ := progress.Allocated.TruncateDecimal()
+	remaining := progress.RewardPool.Sub(progress.Distributed...)
+	dust := sdk.Coins{}
+	for _, coin := range allocated {
+		amount := sdk.MinInt(
+			coin.Amount.Sub(progress.Distributed.AmountOf(coin.Denom)),
+			remaining.AmountOf(coin.Denom),
+		)
+		if amount.IsPositive() {
+			dust = dust.Add(sdk.NewCoin(coin.Denom, amount))
 		}
+	}
 
-		// Get mapping to erc20 token from cosmos denom
-		tokenPair, tokenPairErr := k.erc20Keeper.TokenPair(
-			ctx, &erc20types.QueryTokenPairRequest{Token: assets[i].Denom})
-		if tokenPairErr != nil {
-			return sdk.NewDec(0), tokenPairErr
+	if !dust.IsZero() && k.distrKeeper != nil {
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		if err := k.distrKeeper.FundCommunityPool(ctx, dust, moduleAddr); err != nil {
+			k.Logger(ctx).Error(
+				"failed to send dev earn dust to the community pool",
+				"error", err.Error(),
+			)
+		} else {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeFundCommunityPool,
+					sdk.NewAttribute(sdk.AttributeKeyAmount, dust.String()),
+				),
+			)
 		}
-		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	}
 
-		// Get balance from erc20 token
-		tokenBalance := k.erc20Keeper.BalanceOf(
-			ctx, erc20, tokenPair.GetTokenPair().GetERC20Contract(), common.HexToAddress(contractAddress))
+	if params.RewardHistoryRetention > 0 {
+		k.SetEpochRewardRecord(ctx, types.EpochRewardRecord{
+			Epoch:    progress.Epoch,
+			Time:     progress.Time,
+			TotalGas: progress.TotalGas,
+			Rewards:  progress.Distributed,
+		})
+	}
+	k.PruneRewardHistory(ctx, progress.Epoch)
+
+	// gas of contracts cancelled while the distribution was running
+	k.DeleteAllPendingGasMeters(ctx)
+	k.DeleteDistributionProgress(ctx)
+}
 
-		totalValueLockedContract = totalValueLockedContract.Add(sdk.NewDecFromBigInt(tokenBalance).Mul(rate))
+// GetRewardPool returns the coins held by the module account that are
+// available as rewards, i.e. excluding the escrowed registration deposits and
+// the unclaimed rewards
+func (k Keeper) GetRewardPool(ctx sdk.Context, escrowed sdk.Coins) sdk.Coins {
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	// registration deposits and accrued rewards are held in the module account
+	// but are not available for distribution
+	reserved := escrowed.Add(k.GetTotalAccruedRewards(ctx)...)
+	pool := sdk.Coins{}
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, moduleAddr) {
+		coin.Amount = coin.Amount.Sub(reserved.AmountOf(coin.Denom))
+		if coin.Amount.IsPositive() {
+			pool = pool.Add(coin)
+		}
 	}
+	return pool
+}
 
+// TvlReward function calculates TVL rewards using assets in whitelist
+func (k Keeper) TvlReward(ctx sdk.Context, contractAddress string) sdk.Dec {
+	assets, _ := k.tvlAssets(ctx)
+	totalValueLocked := totalTvl(assets)
 	if totalValueLocked.IsZero() {
-		return sdk.NewDec(0), nil
+		return sdk.NewDec(0)
 	}
-	tvlRatio := totalValueLockedContract.Quo(totalValueLocked)
 
-	return tvlRatio, nil
+	return k.contractTvl(ctx, assets, contractAddress).Quo(totalValueLocked)
 }
 
-func (k Keeper) TotalTvl(ctx sdk.Context) (sdk.Dec, error) {
-	assets := k.GetAllAssets(ctx)
-	totalValueLocked := sdk.NewDec(0)
-	// What should happen if one of the values is not loaded ? return 0 or cancel the process
-	for i := 0; i < len(assets); i++ {
-		// Get exchange rate using oracle module
-		rate, err := k.oracleKeeper.GetExchangeRate(ctx, assets[i].Denom)
+// ContractTvl returns the value of the whitelisted assets held by a contract
+func (k Keeper) ContractTvl(ctx sdk.Context, contractAddress string) sdk.Dec {
+	assets, _ := k.tvlAssets(ctx)
+	return k.contractTvl(ctx, assets, contractAddress)
+}
+
+// TotalTvl returns the value of the total supply of the whitelisted assets
+func (k Keeper) TotalTvl(ctx sdk.Context) sdk.Dec {
+	assets, _ := k.tvlAssets(ctx)
+	return totalTvl(assets)
+}
+
+// tvlAsset is a whitelisted asset that can be valued
+type tvlAsset struct {
+	denom  string
+	erc20  common.Address
+	price  sdk.Dec
+	supply *big.Int
+}
+
+// skippedTvlAsset is a whitelisted asset left out of the TVL
+type skippedTvlAsset struct {
+	denom  string
+	reason string
+}
+
+// tvlAssets returns the whitelisted assets that can be valued, and the ones
+// that are skipped. An asset without an oracle exchange rate is valued at its
+// last known price until that price is older than MaxPriceStaleness.
+func (k Keeper) tvlAssets(ctx sdk.Context) (assets []tvlAsset, skipped []skippedTvlAsset) {
+	maxStaleness := k.GetParams(ctx).MaxPriceStaleness
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	for _, asset := range k.GetAllAssets(ctx) {
+		// Get mapping to erc20 token from cosmos denom
+		tokenPair, err := k.erc20Keeper.TokenPair(
+			ctx, &erc20types.QueryTokenPairRequest{Token: asset.Denom})
 		if err != nil {
-			return sdk.NewDec(0), err
+			skipped = append(skipped, skippedTvlAsset{asset.Denom, types.SkipReasonNoTokenPair})
+			continue
 		}
-		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
-		// Get mapping to erc20 token from cosmos denom
-		tokenPair, tokenPairErr := k.erc20Keeper.TokenPair(
-			ctx, &erc20types.QueryTokenPairRequest{Token: assets[i].Denom})
-		if tokenPairErr != nil {
-			return sdk.NewDec(0), tokenPairErr
+
+		// Get exchange rate using oracle module, or fall back to the last
+		// known price
+		price, err := k.oracleKeeper.GetExchangeRate(ctx, asset.Denom)
+		if err == nil {
+			k.SetAssetPrice(ctx, types.AssetPrice{Denom: asset.Denom, Price: price, Time: ctx.BlockTime()})
+		} else {
+			lastPrice, found := k.GetAssetPrice(ctx, asset.Denom)
+			if !found {
+				skipped = append(skipped, skippedTvlAsset{asset.Denom, types.SkipReasonNoPrice})
+				continue
+			}
+			if ctx.BlockTime().Sub(lastPrice.Time) > maxStaleness {
+				skipped = append(skipped, skippedTvlAsset{asset.Denom, types.SkipReasonStalePrice})
+				continue
+			}
+			price = lastPrice.Price
+		}
+
+		erc20Address := tokenPair.GetTokenPair().GetERC20Contract()
+		supply := k.erc20Keeper.TotalSupply(ctx, erc20, erc20Address)
+		if supply == nil {
+			skipped = append(skipped, skippedTvlAsset{asset.Denom, types.SkipReasonNoSupply})
+			continue
+		}
+
+		assets = append(assets, tvlAsset{
+			denom:  asset.Denom,
+			erc20:  erc20Address,
+			price:  price,
+			supply: supply,
+		})
+	}
+	return assets, skipped
+}
+
+// contractTvl returns the value of the given assets held by a contract
+func (k Keeper) contractTvl(ctx sdk.Context, assets []tvlAsset, contractAddress string) sdk.Dec {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	totalValueLockedContract := sdk.NewDec(0)
+	for _, asset := range assets {
+		// Get balance from erc20 token
+		tokenBalance := k.erc20Keeper.BalanceOf(ctx, erc20, asset.erc20, common.HexToAddress(contractAddress))
+		if tokenBalance == nil {
+			k.Logger(ctx).Debug(
+				"could not get contract balance",
+				"contract", contractAddress,
+				"denom", asset.denom,
+			)
+			continue
 		}
-		total := k.erc20Keeper.TotalSupply(ctx, erc20, tokenPair.TokenPair.GetERC20Contract())
-		totalValueLocked = totalValueLocked.Add(sdk.NewDecFromBigInt(total).Mul(rate))
+		totalValueLockedContract = totalValueLockedContract.Add(sdk.NewDecFromBigInt(tokenBalance).Mul(asset.price))
+	}
+	return totalValueLockedContract
+}
+
+// totalTvl returns the value of the total supply of the given assets
+func totalTvl(assets []tvlAsset) sdk.Dec {
+	totalValueLocked := sdk.NewDec(0)
+	for _, asset := range assets {
+		totalValueLocked = totalValueLocked.Add(sdk.NewDecFromBigInt(asset.supply).Mul(asset.price))
+	}
+	return totalValueLocked
+}
+
+// emitSkippedTvlAssets reports the whitelisted assets left out of the TVL
+func (k Keeper) emitSkippedTvlAssets(ctx sdk.Context, skipped []skippedTvlAsset) {
+	for _, asset := range skipped {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSkipTvlAsset,
+				sdk.NewAttribute(types.AttributeKeyAsset, asset.denom),
+				sdk.NewAttribute(types.AttributeKeyReason, asset.reason),
+			),
+		)
 	}
-	return totalValueLocked, nil
 }
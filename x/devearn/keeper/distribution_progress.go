@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"sidechain/x/devearn/types"
+)
+
+// GetDistributionProgress returns the reward distribution that is being
+// settled, if any
+func (k Keeper) GetDistributionProgress(ctx sdk.Context) (types.DistributionProgress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixDistributionProgress)
+	if len(bz) == 0 {
+		return types.DistributionProgress{}, false
+	}
+
+	var progress types.DistributionProgress
+	k.cdc.MustUnmarshal(bz, &progress)
+	return progress, true
+}
+
+// SetDistributionProgress stores the reward distribution that is being settled
+func (k Keeper) SetDistributionProgress(ctx sdk.Context, progress types.DistributionProgress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixDistributionProgress, k.cdc.MustMarshal(&progress))
+}
+
+// DeleteDistributionProgress removes the reward distribution once settled
+func (k Keeper) DeleteDistributionProgress(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPrefixDistributionProgress)
+}
+
+// isPendingSettlement returns true if the rewards of the contract for the
+// previous epoch are not settled yet. The gas it uses in the meantime belongs
+// to the current epoch.
+func (k Keeper) isPendingSettlement(ctx sdk.Context, contract common.Address) bool {
+	progress, found := k.GetDistributionProgress(ctx)
+	if !found {
+		return false
+	}
+	return bytes.Compare(contract.Bytes(), progress.Cursor) >= 0
+}
+
+// GetPendingGasMeter returns the gas used by a contract since the epoch ended
+// and before its rewards were settled
+func (k Keeper) GetPendingGasMeter(ctx sdk.Context, contract common.Address) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingGasMeter)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// AddPendingGasMeter adds gas used by a contract whose rewards are not settled
+// yet
+func (k Keeper) AddPendingGasMeter(ctx sdk.Context, contract common.Address, gasUsed uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingGasMeter)
+	gasMeter := k.GetPendingGasMeter(ctx, contract) + gasUsed
+	store.Set(contract.Bytes(), sdk.Uint64ToBigEndian(gasMeter))
+}
+
+// GetAllPendingGasMeters returns the gas used by the contracts whose rewards
+// are not settled yet
+func (k Keeper) GetAllPendingGasMeters(ctx sdk.Context) []types.PendingGasMeter {
+	gasMeters := []types.PendingGasMeter{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingGasMeter)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		gasMeters = append(gasMeters, types.PendingGasMeter{
+			Contract: common.BytesToAddress(iterator.Key()).Hex(),
+			GasMeter: sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return gasMeters
+}
+
+// DeletePendingGasMeter removes the pending gas of a contract
+func (k Keeper) DeletePendingGasMeter(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingGasMeter)
+	store.Delete(contract.Bytes())
+}
+
+// DeleteAllPendingGasMeters removes the pending gas of every contract
+func (k Keeper) DeleteAllPendingGasMeters(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingGasMeter)
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
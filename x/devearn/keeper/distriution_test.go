@@ -1,14 +1,22 @@
 package keeper_test
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
+	utiltx "sidechain/testutil/tx"
+	"sidechain/x/devearn/keeper"
 	"sidechain/x/devearn/types"
+	"sort"
+	"time"
 
+	epochstypes "sidechain/x/epochs/types"
 	erc20types "sidechain/x/erc20/types"
 	oracletypes "sidechain/x/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 // Distribute incentives on basis of gas used only
@@ -72,7 +80,7 @@ func (suite *KeeperTestSuite) TestDistributeIncentivesGas() {
 			suite.Require().NoError(err)
 
 			// create incentive
-			_, err = suite.app.DevearnKeeper.RegisterDevEarnInfo(
+			_, err = suite.app.DevearnKeeper.RegisterDevEarnContract(
 				suite.ctx,
 				contract,
 				tc.epochs,
@@ -92,14 +100,14 @@ func (suite *KeeperTestSuite) TestDistributeIncentivesGas() {
 			suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, types.NewDevEarn(contract2, gasUsed, tc.epochs, ownerPriv2.PubKey().Address().String()))
 
 			suite.Commit()
-			err = suite.app.DevearnKeeper.DistributeRewards(suite.ctx)
+			err = suite.app.DevearnKeeper.DistributeRewards(suite.ctx, 1)
 
 			if tc.expPass {
 				suite.Require().NoError(err, tc.name)
 				params := suite.app.DevearnKeeper.GetParams(suite.ctx)
 				// distributes the rewards to all participants
 				sdkParticipant := sdk.AccAddress(ownerPriv1.PubKey().Address().Bytes())
-				balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdkParticipant, tc.denom)
+				balance := sdk.NewCoin(tc.denom, suite.pendingRewards(sdkParticipant).AmountOf(tc.denom))
 				gasRatio := sdk.NewDec(int64(gasUsed)).QuoInt64(int64(totalGasUsed))
 				tvlAllocation := sdk.NewDec(tc.mintAmount).Mul(sdk.NewDecFromBigInt(new(big.Int).SetUint64(params.TvlShare)))
 				tvlAllocation = tvlAllocation.Quo(sdk.NewDec(10000))
@@ -191,7 +199,7 @@ func (suite *KeeperTestSuite) TestDistributeIncentivesTVLandGas() {
 			suite.Require().NoError(err)
 
 			// Register first contract
-			_, err = suite.app.DevearnKeeper.RegisterDevEarnInfo(
+			_, err = suite.app.DevearnKeeper.RegisterDevEarnContract(
 				suite.ctx,
 				contract,
 				tc.epochs,
@@ -200,7 +208,7 @@ func (suite *KeeperTestSuite) TestDistributeIncentivesTVLandGas() {
 			suite.Require().NoError(err)
 
 			// Register second contract
-			_, err = suite.app.DevearnKeeper.RegisterDevEarnInfo(
+			_, err = suite.app.DevearnKeeper.RegisterDevEarnContract(
 				suite.ctx,
 				contract2,
 				tc.epochs,
@@ -255,14 +263,14 @@ func (suite *KeeperTestSuite) TestDistributeIncentivesTVLandGas() {
 			suite.app.DevearnKeeper.AddAssetToWhitelist(suite.ctx, "coin2")
 
 			suite.Commit()
-			err = suite.app.DevearnKeeper.DistributeRewards(suite.ctx)
+			err = suite.app.DevearnKeeper.DistributeRewards(suite.ctx, 1)
 
 			if tc.expPass {
 				suite.Require().NoError(err, tc.name)
 				params := suite.app.DevearnKeeper.GetParams(suite.ctx)
 				// distributes the rewards to all participants
 				sdkParticipant := sdk.AccAddress(ownerPriv1.PubKey().Address().Bytes())
-				balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdkParticipant, tc.denom)
+				balance := sdk.NewCoin(tc.denom, suite.pendingRewards(sdkParticipant).AmountOf(tc.denom))
 				gasRatio := sdk.NewDec(int64(gasUsed)).QuoInt64(int64(totalGasUsed))
 
 				// exchange rate * total supply
@@ -292,3 +300,492 @@ func (suite *KeeperTestSuite) TestDistributeIncentivesTVLandGas() {
 		})
 	}
 }
+
+// Distribute every denom held in the reward pool pro rata
+func (suite *KeeperTestSuite) TestDistributeRewardsMultiDenom() {
+	const gasUsed uint64 = 500
+
+	suite.SetupTest()
+	suite.deployContracts()
+
+	pool := sdk.NewCoins(
+		sdk.NewInt64Coin(denomMint, 1000000),
+		sdk.NewInt64Coin("acoin", 2000000),
+	)
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, pool)
+	suite.Require().NoError(err)
+
+	suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, types.NewDevEarn(contract, gasUsed, epochs, ownerPriv1.PubKey().Address().String()))
+	suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, types.NewDevEarn(contract2, gasUsed*3, epochs, ownerPriv2.PubKey().Address().String()))
+	suite.Commit()
+
+	err = suite.app.DevearnKeeper.DistributeRewards(suite.ctx, 1)
+	suite.Require().NoError(err)
+
+	params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+	gasShare := sdk.NewDec(10000 - int64(params.TvlShare)).QuoInt64(10000)
+	balance := suite.pendingRewards(sdk.AccAddress(ownerPriv1.PubKey().Address().Bytes()))
+	for _, coin := range pool {
+		expReward := sdk.NewDecFromInt(coin.Amount).Mul(gasShare).QuoInt64(4)
+		suite.Require().Equal(expReward.TruncateInt(), balance.AmountOf(coin.Denom), coin.Denom)
+	}
+
+	// the distribution is recorded per epoch and per contract
+	epochRecord, found := suite.app.DevearnKeeper.GetEpochRewardRecord(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(gasUsed*4, epochRecord.TotalGas)
+	record, found := suite.app.DevearnKeeper.GetContractRewardRecord(suite.ctx, 1, contract)
+	suite.Require().True(found)
+	suite.Require().Equal(gasUsed, record.GasUsed)
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 2), record.GasShare)
+	suite.Require().Equal(balance, record.Rewards)
+}
+
+// Fund the reward pool from inflation and the EVM tx fees independently
+func (suite *KeeperTestSuite) TestMintInflationRewards() {
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.Params)
+		expMint  bool
+	}{
+		{
+			"bond denom by default",
+			func(_ *types.Params) {},
+			true,
+		},
+		{
+			"reward denom from params",
+			func(params *types.Params) {
+				params.RewardDenoms = []string{denomMint}
+			},
+			true,
+		},
+		{
+			"inflation disabled",
+			func(params *types.Params) {
+				params.DevEarnInflation_APR = sdk.ZeroDec()
+			},
+			false,
+		},
+		{
+			"unknown reward epoch",
+			func(params *types.Params) {
+				params.RewardEpochIdentifier = "fortnight"
+			},
+			false,
+		},
+		{
+			"custom reward epoch",
+			func(params *types.Params) {
+				suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, epochstypes.EpochInfo{
+					Identifier: "fortnight",
+					Duration:   14 * 24 * time.Hour,
+				})
+				params.RewardEpochIdentifier = "fortnight"
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+			tc.malleate(&params)
+			err := suite.app.DevearnKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			suite.app.DevearnKeeper.MintInflationRewards(suite.ctx, params)
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, denomMint)
+			if tc.expMint {
+				suite.Require().True(balance.IsPositive())
+			} else {
+				suite.Require().True(balance.IsZero())
+			}
+		})
+	}
+}
+
+// Prorate the annual inflation APR to the duration of the reward epoch
+func (suite *KeeperTestSuite) TestMintInflationRewardsProrated() {
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	suite.SetupTest()
+	suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, epochstypes.EpochInfo{
+		Identifier: "hour",
+		Duration:   time.Hour,
+	})
+
+	params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+	params.RewardEpochIdentifier = "hour"
+	params.RewardDenoms = []string{denomMint}
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, denomMint)
+
+	suite.app.DevearnKeeper.MintInflationRewards(suite.ctx, params)
+
+	expMint := sdk.NewDecFromInt(supply.Amount).Mul(params.DevEarnInflation_APR).QuoInt64(365 * 24)
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, denomMint)
+	suite.Require().Equal(expMint.TruncateInt(), balance.Amount)
+}
+
+// Settle the contracts over several blocks and send the dust to the community pool
+func (suite *KeeperTestSuite) TestDistributeRewardsAcrossBlocks() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+	params.TvlShare = 0
+	params.MaxDistributionsPerBlock = 1
+	suite.app.DevearnKeeper.SetParams(suite.ctx, params)
+
+	pool := sdk.NewCoins(sdk.NewInt64Coin(denomMint, 1001))
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, pool)
+	suite.Require().NoError(err)
+
+	contract3 := utiltx.GenerateAddress()
+	contracts := []common.Address{contract, contract2, contract3}
+	sort.Slice(contracts, func(i, j int) bool {
+		return bytes.Compare(contracts[i].Bytes(), contracts[j].Bytes()) < 0
+	})
+	gasUsed := []uint64{100, 100, 200}
+	expRewards := []int64{250, 250, 500}
+	for i, c := range contracts {
+		suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, types.NewDevEarn(c, gasUsed[i], epochs, ownerPriv1.PubKey().Address().String()))
+	}
+	suite.Commit()
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+
+	err = suite.app.DevearnKeeper.DistributeRewards(suite.ctx, 1)
+	suite.Require().NoError(err)
+
+	for i, c := range contracts {
+		if i > 0 {
+			// the same block doesn't settle more contracts
+			suite.app.DevearnKeeper.ContinueDistribution(suite.ctx)
+			_, found := suite.app.DevearnKeeper.GetContractRewardRecord(suite.ctx, 1, c)
+			suite.Require().False(found)
+
+			suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+			suite.app.DevearnKeeper.ContinueDistribution(suite.ctx)
+		}
+
+		// contracts are settled in store order
+		record, found := suite.app.DevearnKeeper.GetContractRewardRecord(suite.ctx, 1, c)
+		suite.Require().True(found)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomMint, expRewards[i])), record.Rewards)
+		if i+1 < len(contracts) {
+			_, found = suite.app.DevearnKeeper.GetContractRewardRecord(suite.ctx, 1, contracts[i+1])
+			suite.Require().False(found)
+		}
+	}
+
+	_, found := suite.app.DevearnKeeper.GetDistributionProgress(suite.ctx)
+	suite.Require().False(found)
+	epochRecord, found := suite.app.DevearnKeeper.GetEpochRewardRecord(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomMint, 1000)), epochRecord.Rewards)
+
+	// the rounding dust is sent to the community pool
+	dust := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).Sub(communityPool)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(denomMint, 1)), dust)
+}
+
+// Leave the contracts registered while a distribution is running out of it
+func (suite *KeeperTestSuite) TestDistributeRewardsRegisteredDuringDistribution() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+	params.TvlShare = 0
+	params.MaxDistributionsPerBlock = 1
+	suite.app.DevearnKeeper.SetParams(suite.ctx, params)
+
+	pool := sdk.NewCoins(sdk.NewInt64Coin(denomMint, 1000))
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, pool)
+	suite.Require().NoError(err)
+
+	suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, types.NewDevEarn(contract, 100, epochs, ownerPriv1.PubKey().Address().String()))
+	suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, types.NewDevEarn(contract2, 100, epochs, ownerPriv1.PubKey().Address().String()))
+
+	err = suite.app.DevearnKeeper.DistributeRewards(suite.ctx, 1)
+	suite.Require().NoError(err)
+
+	// a contract is registered after the cursor in the next block and uses gas
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	registered := common.HexToAddress("0xffffffffffffffffffffffffffffffffffffffff")
+	devEarnInfo := types.NewDevEarn(registered, 0, epochs, ownerPriv1.PubKey().Address().String())
+	devEarnInfo.StartTime = suite.ctx.BlockTime()
+	suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, devEarnInfo)
+	suite.app.DevearnKeeper.AddPendingGasMeter(suite.ctx, registered, 50)
+
+	for i := 0; i < 3; i++ {
+		suite.app.DevearnKeeper.ContinueDistribution(suite.ctx)
+		suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	}
+	_, found := suite.app.DevearnKeeper.GetDistributionProgress(suite.ctx)
+	suite.Require().False(found)
+
+	// the contract keeps its epochs and the gas it used counts for the next
+	// distribution
+	devEarnInfo, found = suite.app.DevearnKeeper.GetDevEarnInfo(suite.ctx, registered)
+	suite.Require().True(found)
+	suite.Require().Equal(epochs, devEarnInfo.Epochs)
+	suite.Require().Equal(uint64(50), devEarnInfo.GasMeter)
+	_, found = suite.app.DevearnKeeper.GetContractRewardRecord(suite.ctx, 1, registered)
+	suite.Require().False(found)
+
+	record, found := suite.app.DevearnKeeper.GetEpochRewardRecord(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(pool, record.Rewards)
+}
+
+// Skip the contracts blocked before they are settled, including the ones
+// created through a blocked factory
+func (suite *KeeperTestSuite) TestDistributeRewardsBlocked() {
+	deployer := utiltx.GenerateAddress()
+	factory := crypto.CreateAddress(deployer, 3)
+
+	testCases := []struct {
+		name  string
+		block func() common.Address
+	}{
+		{"blocked contract", func() common.Address { return contract2 }},
+		{"blocked deployer", func() common.Address { return deployer }},
+		{"blocked factory", func() common.Address { return factory }},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			suite.deployContracts()
+
+			params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+			params.TvlShare = 0
+			suite.app.DevearnKeeper.SetParams(suite.ctx, params)
+
+			pool := sdk.NewCoins(sdk.NewInt64Coin(denomMint, 1000))
+			err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, pool)
+			suite.Require().NoError(err)
+
+			suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, types.NewDevEarn(contract, 100, epochs, ownerPriv1.PubKey().Address().String()))
+			devEarnInfo := types.NewDevEarn(contract2, 100, epochs, ownerPriv2.PubKey().Address().String())
+			devEarnInfo.DeployerAddress = sdk.AccAddress(deployer.Bytes()).String()
+			devEarnInfo.Nonces = []uint64{3, 0}
+			suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, devEarnInfo)
+
+			// the contract is blocked after it used gas during the epoch
+			suite.app.DevearnKeeper.SetBlocked(suite.ctx, tc.block())
+
+			err = suite.app.DevearnKeeper.DistributeRewards(suite.ctx, 1)
+			suite.Require().NoError(err)
+
+			record, found := suite.app.DevearnKeeper.GetContractRewardRecord(suite.ctx, 1, contract2)
+			suite.Require().True(found)
+			suite.Require().True(suite.pendingRewards(sdk.AccAddress(ownerPriv2.PubKey().Address())).IsZero())
+			suite.Require().True(suite.pendingRewards(sdk.AccAddress(ownerPriv2.PubKey().Address())).IsZero())
+
+			// the gas of the blocked contract doesn't dilute the other rewards
+			record, found = suite.app.DevearnKeeper.GetContractRewardRecord(suite.ctx, 1, contract)
+			suite.Require().True(found)
+			suite.Require().Equal(pool, record.Rewards)
+		})
+	}
+}
+
+// Cancel the contracts that are no longer deployed when they are settled
+func (suite *KeeperTestSuite) TestDistributeRewardsNotDeployed() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(denomMint, 100))
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, deposit)
+	suite.Require().NoError(err)
+
+	deployer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	destructed := utiltx.GenerateAddress()
+	devEarnInfo := types.NewDevEarn(destructed, 100, epochs, ownerPriv1.PubKey().Address().String())
+	devEarnInfo.DeployerAddress = deployer.String()
+	devEarnInfo.Deposit = deposit
+	suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, devEarnInfo)
+	suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, types.NewDevEarn(contract, 100, epochs, ownerPriv1.PubKey().Address().String()))
+
+	err = suite.app.DevearnKeeper.DistributeRewards(suite.ctx, 1)
+	suite.Require().NoError(err)
+
+	suite.Require().False(suite.app.DevearnKeeper.IsDevEarnInfoRegistered(suite.ctx, destructed))
+	suite.Require().Equal(deposit, suite.app.BankKeeper.GetAllBalances(suite.ctx, deployer))
+	suite.Require().True(suite.app.DevearnKeeper.IsDevEarnInfoRegistered(suite.ctx, contract))
+
+	msg, broken := keeper.ModuleBalanceInvariant(suite.app.DevearnKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
+
+// Compute the TVL reward share from the time-weighted averages of the samples
+func (suite *KeeperTestSuite) TestDistributeRewardsTimeWeightedTvl() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+	params.TvlShare = 10000
+	params.TvlSampleInterval = 10
+	suite.app.DevearnKeeper.SetParams(suite.ctx, params)
+
+	pool := sdk.NewCoins(sdk.NewInt64Coin(denomMint, 1000))
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, pool)
+	suite.Require().NoError(err)
+
+	suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, types.NewDevEarn(contract, 100, epochs, ownerPriv1.PubKey().Address().String()))
+	suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, types.NewDevEarn(contract2, 100, epochs, ownerPriv2.PubKey().Address().String()))
+
+	// the total TVL is 1000 for the whole epoch. The first contract holds 250
+	// on average, the second one is filled right before the epoch ends.
+	end := suite.ctx.BlockTime()
+	start := end.Add(-100 * time.Second)
+	total := types.NewTvlAccumulator(sdk.NewDec(1000), start)
+	suite.app.DevearnKeeper.SetTotalTvlAccumulator(suite.ctx, total)
+	tvl1 := types.NewTvlAccumulator(sdk.NewDec(500), start)
+	tvl1.Sample(sdk.ZeroDec(), start.Add(50*time.Second))
+	suite.app.DevearnKeeper.SetContractTvlAccumulator(suite.ctx, contract, tvl1)
+	tvl2 := types.NewTvlAccumulator(sdk.ZeroDec(), start)
+	tvl2.Sample(sdk.NewDec(1000), end.Add(-time.Second))
+	suite.app.DevearnKeeper.SetContractTvlAccumulator(suite.ctx, contract2, tvl2)
+
+	err = suite.app.DevearnKeeper.DistributeRewards(suite.ctx, 1)
+	suite.Require().NoError(err)
+
+	record, found := suite.app.DevearnKeeper.GetContractRewardRecord(suite.ctx, 1, contract)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 2), record.TvlRatio)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomMint, 250)), record.Rewards)
+
+	record, found = suite.app.DevearnKeeper.GetContractRewardRecord(suite.ctx, 1, contract2)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDecWithPrec(1, 2), record.TvlRatio)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomMint, 10)), record.Rewards)
+
+	// the samples of the epoch are cleared
+	tvl2, found = suite.app.DevearnKeeper.GetContractTvlAccumulator(suite.ctx, contract2)
+	suite.Require().True(found)
+	suite.Require().Zero(tvl2.Duration)
+	suite.Require().Equal(sdk.NewDec(1000), tvl2.LastTvl)
+}
+
+// Sample the contracts over several blocks, each at the time of its block
+func (suite *KeeperTestSuite) TestSampleTvlAcrossBlocks() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+	params.TvlSampleInterval = 10
+	params.MaxDistributionsPerBlock = 1
+	suite.app.DevearnKeeper.SetParams(suite.ctx, params)
+
+	contract3 := utiltx.GenerateAddress()
+	contracts := []common.Address{contract, contract2, contract3}
+	sort.Slice(contracts, func(i, j int) bool {
+		return bytes.Compare(contracts[i].Bytes(), contracts[j].Bytes()) < 0
+	})
+	for _, c := range contracts {
+		suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, types.NewDevEarn(c, 0, epochs, ownerPriv1.PubKey().Address().String()))
+	}
+	suite.Commit()
+
+	// no round starts outside of the interval
+	ctx := suite.ctx.WithBlockHeight(9)
+	suite.app.DevearnKeeper.SampleTvl(ctx)
+	_, found := suite.app.DevearnKeeper.GetTotalTvlAccumulator(ctx)
+	suite.Require().False(found)
+
+	start := ctx.BlockTime()
+	for i := range contracts {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(start.Add(time.Duration(i+1) * 6 * time.Second))
+		suite.app.DevearnKeeper.SampleTvl(ctx)
+
+		for j, c2 := range contracts {
+			accumulator, found := suite.app.DevearnKeeper.GetContractTvlAccumulator(ctx, c2)
+			suite.Require().Equal(j <= i, found, c2.Hex())
+			if found {
+				suite.Require().Equal(start.Add(time.Duration(j+1)*6*time.Second), accumulator.LastTime)
+			}
+		}
+		_, running := suite.app.DevearnKeeper.GetTvlSampleCursor(ctx)
+		suite.Require().Equal(i < len(contracts)-1, running)
+	}
+
+	// the total TVL is sampled once per round
+	total, found := suite.app.DevearnKeeper.GetTotalTvlAccumulator(ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(start.Add(6*time.Second), total.LastTime)
+
+	// no round starts before the next interval
+	ctx = ctx.WithBlockHeight(13).WithBlockTime(start.Add(time.Minute))
+	suite.app.DevearnKeeper.SampleTvl(ctx)
+	accumulator, found := suite.app.DevearnKeeper.GetContractTvlAccumulator(ctx, contracts[0])
+	suite.Require().True(found)
+	suite.Require().Equal(start.Add(6*time.Second), accumulator.LastTime)
+
+	// nothing is sampled while a distribution is being settled
+	suite.app.DevearnKeeper.SetDistributionProgress(ctx, types.DistributionProgress{Epoch: 1})
+	ctx = ctx.WithBlockHeight(20)
+	suite.app.DevearnKeeper.SampleTvl(ctx)
+	_, running := suite.app.DevearnKeeper.GetTvlSampleCursor(ctx)
+	suite.Require().False(running)
+	total, _ = suite.app.DevearnKeeper.GetTotalTvlAccumulator(ctx)
+	suite.Require().Equal(start.Add(6*time.Second), total.LastTime)
+}
+
+// Skip the whitelisted assets that can't be valued instead of zeroing the TVL
+func (suite *KeeperTestSuite) TestTvlMissingAssets() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	// contract2 holds all the supply of the token deployed at contract
+	suite.MintERC20Token(contract, suite.address, contract2, big.NewInt(100))
+	pair := erc20types.NewTokenPair(contract, "coin", true, erc20types.OWNER_MODULE)
+	suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+	suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+	suite.app.Erc20Keeper.SetERC20Map(suite.ctx, pair.GetERC20Contract(), pair.GetID())
+	pair = erc20types.NewTokenPair(contract2, "coin2", true, erc20types.OWNER_MODULE)
+	suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+	suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+	suite.app.Erc20Keeper.SetERC20Map(suite.ctx, pair.GetERC20Contract(), pair.GetID())
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, "coin", sdk.NewDec(2))
+
+	// coin2 has no price and coin3 no token pair
+	suite.app.DevearnKeeper.AddAssetToWhitelist(suite.ctx, "coin")
+	suite.app.DevearnKeeper.AddAssetToWhitelist(suite.ctx, "coin2")
+	suite.app.DevearnKeeper.SetAssets(suite.ctx, types.Assets{Denom: "coin3"})
+	suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, types.NewDevEarn(contract2, 0, epochs, ownerPriv1.PubKey().Address().String()))
+	suite.Commit()
+
+	suite.Require().Equal(sdk.NewDec(200), suite.app.DevearnKeeper.TotalTvl(suite.ctx))
+	suite.Require().Equal(sdk.OneDec(), suite.app.DevearnKeeper.TvlReward(suite.ctx, contract2.Hex()))
+
+	// a sampling round starts every TvlSampleInterval blocks
+	interval := int64(suite.app.DevearnKeeper.GetParams(suite.ctx).TvlSampleInterval)
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager()).WithBlockHeight(interval)
+	suite.app.DevearnKeeper.SampleTvl(ctx)
+	skipped := map[string]string{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeSkipTvlAsset {
+			continue
+		}
+		skipped[string(event.Attributes[0].Value)] = string(event.Attributes[1].Value)
+	}
+	suite.Require().Equal(map[string]string{
+		"coin2": types.SkipReasonNoPrice,
+		"coin3": types.SkipReasonNoTokenPair,
+	}, skipped)
+
+	// the last known price is used until it's stale
+	suite.app.OracleKeeper.DeleteExchangeRate(suite.ctx, "coin")
+	suite.Require().Equal(sdk.NewDec(200), suite.app.DevearnKeeper.TotalTvl(suite.ctx))
+	suite.Require().Equal(sdk.OneDec(), suite.app.DevearnKeeper.TvlReward(suite.ctx, contract2.Hex()))
+
+	params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(params.MaxPriceStaleness + time.Second))
+	suite.Require().True(suite.app.DevearnKeeper.TotalTvl(suite.ctx).IsZero())
+	suite.Require().True(suite.app.DevearnKeeper.TvlReward(suite.ctx, contract2.Hex()).IsZero())
+}
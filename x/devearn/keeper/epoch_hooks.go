@@ -1,8 +1,9 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"sidechain/x/devearn/types"
 	epochstypes "sidechain/x/epochs/types"
@@ -12,7 +13,7 @@ import (
 func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
 
 // AfterEpochEnd distributes the contract incentives at the end of each epoch
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) {
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	params := k.GetParams(ctx)
 
 	// check if epochIdentifier signal equals the identifier in the params
@@ -24,53 +25,59 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64)
 	if !params.EnableDevEarn {
 		return
 	}
-	//get total supply
-	totalDenomSupply, _, err := k.bankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{
-		Key:        nil,
-		Offset:     0,
-		Limit:      100,
-		CountTotal: false,
-		Reverse:    false,
-	})
-	if err != nil {
-		ctx.Logger().Error("get total supply err happen, err :", err)
-		return
+
+	// fund the reward pool with this epoch's inflation. Fee funded rewards
+	// are added to the pool as the EVM transactions are processed.
+	k.MintInflationRewards(ctx, params)
+
+	//send token to contract owner
+	if err := k.DistributeRewards(ctx, epochNumber); err != nil {
+		panic(err)
 	}
-	// TODO: Check how base denom works/registers
-	// denom, err := sdk.GetBaseDenom()
-	// if err != nil {
-	// 	ctx.Logger().Error("get base denom err happen, err :", err)
-	// 	return
-	// }
-	denom := "aside"
-
-	totalSupply := totalDenomSupply.AmountOf(denom)
-	periodProvision := sdk.NewDecFromBigInt(totalSupply.BigInt()).Mul(params.DevEarnInflation_APR)
-	var epochProvision sdk.Dec
-	switch params.RewardEpochIdentifier {
-	case epochstypes.WeekEpochID:
-		epochsPerPeriod := sdk.NewDec(365).Quo(sdk.NewDec(7))
-		epochProvision = periodProvision.Quo(epochsPerPeriod)
-	case epochstypes.DayEpochID:
-		epochsPerPeriod := sdk.NewDec(365).Quo(sdk.NewDec(1))
-		epochProvision = periodProvision.Quo(epochsPerPeriod)
-	default:
-		ctx.Logger().Error("RewardEpochIdentifier should be day or week")
+}
+
+// daysInYear is the number of days the inflation APR is prorated over
+const daysInYear = 365
+
+// MintInflationRewards mints the dev earn inflation of an epoch for each
+// reward denom into the module account. The annual inflation APR is prorated
+// to the duration of the reward epoch. No coins are minted if the inflation
+// APR is zero.
+func (k Keeper) MintInflationRewards(ctx sdk.Context, params types.Params) {
+	if !params.DevEarnInflation_APR.IsPositive() {
 		return
 	}
-	if !epochProvision.IsPositive() {
+
+	epochInfo, found := k.epochsKeeper.GetEpochInfo(ctx, params.RewardEpochIdentifier)
+	if !found || epochInfo.Duration <= 0 {
 		k.Logger(ctx).Error(
-			"SKIPPING INFLATION: negative epoch mint provision",
-			"value", epochProvision.String(),
+			"SKIPPING INFLATION: unknown reward epoch",
+			"identifier", params.RewardEpochIdentifier,
 		)
 		return
 	}
-	//mint token
-	mintedCoin := sdk.Coin{
-		Denom:  denom,
-		Amount: epochProvision.TruncateInt(),
+	epochsPerPeriod := sdk.NewDec(int64(daysInYear * 24 * time.Hour)).QuoInt64(int64(epochInfo.Duration))
+
+	coins := sdk.Coins{}
+	for _, denom := range k.RewardDenoms(ctx, params) {
+		totalSupply := k.bankKeeper.GetSupply(ctx, denom)
+		periodProvision := sdk.NewDecFromInt(totalSupply.Amount).Mul(params.DevEarnInflation_APR)
+		epochProvision := periodProvision.Quo(epochsPerPeriod)
+		if !epochProvision.IsPositive() {
+			k.Logger(ctx).Error(
+				"SKIPPING INFLATION: negative epoch mint provision",
+				"denom", denom,
+				"value", epochProvision.String(),
+			)
+			continue
+		}
+		coins = coins.Add(sdk.NewCoin(denom, epochProvision.TruncateInt()))
 	}
-	coins := sdk.Coins{mintedCoin}
+
+	if coins.IsZero() {
+		return
+	}
+
 	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
 		k.Logger(ctx).Error(
 			"SKIPPING INFLATION: mint coin err",
@@ -79,10 +86,22 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64)
 		return
 	}
 
-	//send token to contract owner
-	if err := k.DistributeRewards(ctx); err != nil {
-		panic(err)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundRewardPool,
+			sdk.NewAttribute(types.AttributeKeySource, types.RewardSourceInflation),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	)
+}
+
+// RewardDenoms returns the denoms minted as inflation rewards, defaulting to
+// the staking bond denom
+func (k Keeper) RewardDenoms(ctx sdk.Context, params types.Params) []string {
+	if len(params.RewardDenoms) > 0 {
+		return params.RewardDenoms
 	}
+	return []string{k.stakingKeeper.BondDenom(ctx)}
 }
 
 // ___________________________________________________________________________________________________
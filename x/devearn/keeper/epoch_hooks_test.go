@@ -39,7 +39,7 @@ func (suite *KeeperTestSuite) TestEpochIdentifierAfterEpochEnd() {
 			_, err := suite.DeployContract("COIN TOKEN", "COIN", erc20Decimals)
 			suite.Require().NoError(err)
 			suite.Commit()
-			_, err = suite.app.DevearnKeeper.RegisterDevEarnInfo(
+			_, err = suite.app.DevearnKeeper.RegisterDevEarnContract(
 				suite.ctx,
 				contract,
 				tc.epochs,
@@ -88,7 +88,7 @@ func (suite *KeeperTestSuite) TestEpochIdentifierAfterEpochEnd() {
 			}
 			totalSupply := totalDenomSupply.AmountOf(tc.denom)
 
-			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(ownerPriv1.PubKey().Address()), tc.denom)
+			balance := sdk.NewCoin(tc.denom, suite.pendingRewards(sdk.AccAddress(ownerPriv1.PubKey().Address())).AmountOf(tc.denom))
 			if tc.epochIdentifier == params.RewardEpochIdentifier {
 				totalRewards := sdk.NewDecFromInt(totalSupply).Quo(sdk.NewDec(365)).Mul(sdk.NewDec(7)).Mul(params.DevEarnInflation_APR)
 				expectedRewards := totalRewards.Mul((sdk.NewDecFromBigInt(new(big.Int).SetUint64(params.TvlShare)))).Quo(sdk.NewDec(10000))
@@ -1,8 +1,13 @@
 package keeper
 
 import (
+	"bytes"
+	"math/big"
+	"sort"
+
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -20,20 +25,44 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 
 // PostTxProcessing implements EvmHooks.PostTxProcessing. After each successful
 // interaction with an incentivized contract, the participants's GasUsed is
-// added to its gasMeter.
+// added to its gasMeter, subject to the anti wash trading rules of the params.
 func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	// check if the Incentives are globally enabled
 	params := k.GetParams(ctx)
 	if !params.EnableDevEarn {
 		return nil
 	}
-	contract := msg.To()
-	// If theres no dev earn registered for the contract, do nothing
-	if contract == nil || !k.IsDevEarnInfoRegistered(ctx, *contract) {
+
+	// add the fee share of the tx to the reward pool before the fee collector is
+	// emptied by the distribution module at the beginning of the next block
+	k.collectFeeShare(ctx, params.FeeShare, msg, receipt.GasUsed)
+
+	var callTree map[common.Address]uint64
+	if k.callTreeTracer != nil {
+		// always pop the frames so they don't leak into the next tx of the sender
+		callTree = k.callTreeTracer.Pop(msg.From())
+	}
+
+	if !isCreditableTx(params, msg, receipt) {
 		return nil
 	}
 
-	k.addGasToDevEarn(ctx, *contract, receipt.GasUsed)
+	var credited bool
+	if params.GasAttribution == types.GAS_ATTRIBUTION_CALL_TREE && len(callTree) > 0 {
+		credited = k.addCallTreeGasToDevEarn(ctx, params, msg.From(), callTree, receipt.GasUsed)
+	} else {
+		contract := msg.To()
+		// If theres no dev earn registered for the contract or it is blocked, do
+		// nothing
+		if contract != nil && k.IsDevEarnInfoRegistered(ctx, *contract) && !k.isDevEarnBlocked(ctx, *contract) {
+			k.addGasToDevEarn(ctx, params, *contract, msg.From(), receipt.GasUsed)
+			credited = true
+		}
+	}
+
+	if !credited {
+		return nil
+	}
 
 	defer func() {
 		telemetry.IncrCounter(
@@ -52,12 +81,136 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *eth
 	return nil
 }
 
-// addGasToIncentive adds gasUsed to an incentive's cumulated totalGas
+// isCreditableTx returns false if the gas of the transaction must not be
+// credited to any contract, either because it failed or because it paid a gas
+// price below the minimum
+func isCreditableTx(params types.Params, msg core.Message, receipt *ethtypes.Receipt) bool {
+	if params.SuccessfulTxsOnly && receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return false
+	}
+
+	if params.MinGasPrice.IsPositive() {
+		// the message gas price is the effective gas price of dynamic fee txs
+		if msg.GasPrice() == nil || msg.GasPrice().Cmp(params.MinGasPrice.BigInt()) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// collectFeeShare transfers the given share of the fees paid for the gas used
+// by a transaction from the fee collector to the dev earn reward pool
+func (k Keeper) collectFeeShare(ctx sdk.Context, feeShare sdk.Dec, msg core.Message, gasUsed uint64) {
+	if !feeShare.IsPositive() || msg.GasPrice() == nil || msg.GasPrice().Sign() <= 0 {
+		return
+	}
+
+	fees := new(big.Int).Mul(msg.GasPrice(), new(big.Int).SetUint64(gasUsed))
+	amount := sdk.NewDecFromBigInt(fees).Mul(feeShare).TruncateInt()
+	if !amount.IsPositive() {
+		return
+	}
+
+	coins := sdk.Coins{sdk.NewCoin(k.evmKeeper.GetParams(ctx).EvmDenom, amount)}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, coins); err != nil {
+		k.Logger(ctx).Error(
+			"failed to collect dev earn fee share",
+			"amount", coins.String(),
+			"error", err.Error(),
+		)
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundRewardPool,
+			sdk.NewAttribute(types.AttributeKeySource, types.RewardSourceFees),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	)
+}
+
+// addCallTreeGasToDevEarn splits the gas used by a transaction between the
+// registered contracts of its call tree, pro rata to the gas used by each
+// contract's own frames. The share of blocked contracts is not credited to
+// anyone. It returns false if no registered contract was credited.
+func (k Keeper) addCallTreeGasToDevEarn(
+	ctx sdk.Context,
+	params types.Params,
+	sender common.Address,
+	callTree map[common.Address]uint64,
+	gasUsed uint64,
+) bool {
+	var (
+		contracts []common.Address
+		totalGas  = new(big.Int)
+	)
+	for contract, gas := range callTree {
+		totalGas.Add(totalGas, new(big.Int).SetUint64(gas))
+		if k.IsDevEarnInfoRegistered(ctx, contract) && !k.isDevEarnBlocked(ctx, contract) {
+			contracts = append(contracts, contract)
+		}
+	}
+
+	if len(contracts) == 0 || totalGas.Sign() == 0 {
+		return false
+	}
+
+	// iterate in a deterministic order as the call tree is a map
+	sort.Slice(contracts, func(i, j int) bool {
+		return bytes.Compare(contracts[i].Bytes(), contracts[j].Bytes()) < 0
+	})
+
+	for _, contract := range contracts {
+		share := new(big.Int).SetUint64(callTree[contract])
+		share.Mul(share, new(big.Int).SetUint64(gasUsed))
+		share.Quo(share, totalGas)
+		if share.Sign() == 0 {
+			continue
+		}
+		k.addGasToDevEarn(ctx, params, contract, sender, share.Uint64())
+	}
+
+	return true
+}
+
+// ActivateCallTreeTracer starts tracing the call tree of the EVM transactions
+// delivered at the current height
+func (k Keeper) ActivateCallTreeTracer(ctx sdk.Context) {
+	if k.callTreeTracer == nil {
+		return
+	}
+	k.callTreeTracer.Activate(ctx.BlockHeight())
+}
+
+// DeactivateCallTreeTracer stops tracing the call tree of the EVM transactions
+func (k Keeper) DeactivateCallTreeTracer() {
+	if k.callTreeTracer == nil {
+		return
+	}
+	k.callTreeTracer.Deactivate()
+}
+
+// addGasToIncentive adds gasUsed to an incentive's cumulated totalGas, up to
+// the gas the sender can still credit to the contract during the epoch
 func (k Keeper) addGasToDevEarn(
 	ctx sdk.Context,
-	contract common.Address,
+	params types.Params,
+	contract, sender common.Address,
 	gasUsed uint64,
 ) {
+	gasUsed = k.capSenderGas(ctx, params, contract, sender, gasUsed)
+	if gasUsed == 0 {
+		return
+	}
+
+	// gas used before the contract is settled belongs to the next epoch
+	if k.isPendingSettlement(ctx, contract) {
+		k.AddPendingGasMeter(ctx, contract, gasUsed)
+		return
+	}
+
 	// NOTE: existence of contract incentive is already checked
 	incentive, _ := k.GetDevEarnInfo(ctx, contract)
 	incentive.GasMeter += gasUsed
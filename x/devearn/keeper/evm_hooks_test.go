@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethermint "github.com/evmos/ethermint/types"
 )
@@ -87,6 +88,14 @@ func (suite *KeeperTestSuite) TestEvmHooksStoreTxGasUsed() {
 			},
 			true,
 		},
+		{
+			"tx with blocked contract",
+			func(contractAddr common.Address) {
+				suite.app.DevearnKeeper.SetBlocked(suite.ctx, contractAddr)
+				_ = suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(1000))
+			},
+			false,
+		},
 		{
 			"tx with non-incentivized contract",
 			func(_ common.Address) {
@@ -106,7 +115,7 @@ func (suite *KeeperTestSuite) TestEvmHooksStoreTxGasUsed() {
 			suite.Commit()
 
 			// Register devearn
-			_, err = suite.app.DevearnKeeper.RegisterDevEarnInfo(
+			_, err = suite.app.DevearnKeeper.RegisterDevEarnContract(
 				suite.ctx,
 				contractAddr,
 				epochs,
@@ -135,3 +144,153 @@ func (suite *KeeperTestSuite) TestEvmHooksStoreTxGasUsed() {
 	}
 	suite.mintFeeCollector = false
 }
+
+func (suite *KeeperTestSuite) TestEvmHooksCollectFeeShare() {
+	testCases := []struct {
+		name     string
+		feeShare sdk.Dec
+	}{
+		{
+			"fee funded rewards disabled",
+			sdk.ZeroDec(),
+		},
+		{
+			"half of the fees",
+			sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			"all the fees",
+			sdk.OneDec(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			suite.ensureHooksSet()
+			contractAddr, err := suite.DeployContract("testcoin", "COIN", erc20Decimals)
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			_, err = suite.app.DevearnKeeper.RegisterDevEarnContract(suite.ctx, contractAddr, epochs, "")
+			suite.Require().NoError(err)
+
+			params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+			params.FeeShare = tc.feeShare
+			err = suite.app.DevearnKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			coins := sdk.NewCoins(sdk.NewCoin("aside", sdk.NewInt(30000000)))
+			err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sdk.AccAddress(suite.address.Bytes()), coins)
+			suite.Require().NoError(err)
+
+			moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+			evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+			poolBefore := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, evmDenom)
+			baseFee := suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx)
+
+			suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(1000))
+
+			devEarnInfo, found := suite.app.DevearnKeeper.GetDevEarnInfo(suite.ctx, contractAddr)
+			suite.Require().True(found)
+			fees := sdk.NewIntFromBigInt(baseFee).MulRaw(int64(devEarnInfo.GasMeter))
+			expPool := poolBefore.Amount.Add(sdk.NewDecFromInt(fees).Mul(tc.feeShare).TruncateInt())
+
+			poolAfter := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, evmDenom)
+			suite.Require().Equal(expPool, poolAfter.Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEvmHooksAntiWashTrading() {
+	sender := utiltx.GenerateAddress()
+	sender2 := utiltx.GenerateAddress()
+	gasPrice := big.NewInt(1000)
+
+	testCases := []struct {
+		name       string
+		malleate   func(params *types.Params)
+		senders    []common.Address
+		status     uint64
+		expGasUsed uint64
+	}{
+		{
+			"failed receipt - credited",
+			func(params *types.Params) {
+				params.SuccessfulTxsOnly = false
+			},
+			[]common.Address{sender},
+			ethtypes.ReceiptStatusFailed,
+			60000,
+		},
+		{
+			"failed receipt - successful txs only",
+			func(params *types.Params) {
+				params.SuccessfulTxsOnly = true
+			},
+			[]common.Address{sender},
+			ethtypes.ReceiptStatusFailed,
+			0,
+		},
+		{
+			"gas price below minimum",
+			func(params *types.Params) {
+				params.MinGasPrice = sdk.NewInt(1001)
+			},
+			[]common.Address{sender},
+			ethtypes.ReceiptStatusSuccessful,
+			0,
+		},
+		{
+			"gas price equal to minimum",
+			func(params *types.Params) {
+				params.MinGasPrice = sdk.NewInt(1000)
+			},
+			[]common.Address{sender},
+			ethtypes.ReceiptStatusSuccessful,
+			60000,
+		},
+		{
+			"sender gas capped",
+			func(params *types.Params) {
+				params.MaxSenderGasPerEpoch = 100000
+			},
+			[]common.Address{sender, sender, sender},
+			ethtypes.ReceiptStatusSuccessful,
+			100000,
+		},
+		{
+			"cap applies per sender",
+			func(params *types.Params) {
+				params.MaxSenderGasPerEpoch = 100000
+			},
+			[]common.Address{sender, sender, sender2, sender2},
+			ethtypes.ReceiptStatusSuccessful,
+			200000,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			suite.deployContracts()
+
+			_, err := suite.app.DevearnKeeper.RegisterDevEarnContract(suite.ctx, contract, epochs, "")
+			suite.Require().NoError(err)
+
+			params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+			tc.malleate(&params)
+			err = suite.app.DevearnKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			for _, from := range tc.senders {
+				msg := ethtypes.NewMessage(from, &contract, 0, nil, 100000, gasPrice, gasPrice, gasPrice, nil, nil, false)
+				receipt := &ethtypes.Receipt{Status: tc.status, GasUsed: 60000}
+				err = suite.app.DevearnKeeper.PostTxProcessing(suite.ctx, msg, receipt)
+				suite.Require().NoError(err)
+			}
+
+			devEarnInfo, found := suite.app.DevearnKeeper.GetDevEarnInfo(suite.ctx, contract)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expGasUsed, devEarnInfo.GasMeter)
+		})
+	}
+}
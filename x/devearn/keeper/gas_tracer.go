@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	evm "github.com/evmos/ethermint/x/evm/vm"
+)
+
+// CallTreeTracer collects the gas used by every call frame of the EVM
+// transactions executed while a block is being delivered. It is shared between
+// the EVM keeper, which installs it through WrapEVMConstructor, and the dev
+// earn keeper, which reads the collected gas in PostTxProcessing.
+//
+// Frames are only recorded for EVM instances created at the height that is
+// currently being delivered, so queries and simulations, which run against the
+// last committed height, never contribute to the collected gas.
+type CallTreeTracer struct {
+	mu     sync.Mutex
+	height int64
+	// frames holds the gas used per contract address for the last transaction
+	// of each origin
+	frames map[common.Address]map[common.Address]uint64
+}
+
+// NewCallTreeTracer returns an inactive CallTreeTracer
+func NewCallTreeTracer() *CallTreeTracer {
+	return &CallTreeTracer{
+		frames: make(map[common.Address]map[common.Address]uint64),
+	}
+}
+
+// Activate starts recording the call frames of the transactions delivered at
+// the given height.
+func (t *CallTreeTracer) Activate(height int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.height = height
+	t.frames = make(map[common.Address]map[common.Address]uint64)
+}
+
+// Deactivate stops recording and drops any frame that was not consumed
+func (t *CallTreeTracer) Deactivate() {
+	t.Activate(0)
+}
+
+// isActive returns true if EVMs created for the given height must be traced
+func (t *CallTreeTracer) isActive(height *big.Int) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.height != 0 && height != nil && height.IsInt64() && height.Int64() == t.height
+}
+
+// set stores the gas used per contract by the last transaction of origin
+func (t *CallTreeTracer) set(origin common.Address, gasUsed map[common.Address]uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.height == 0 {
+		return
+	}
+	t.frames[origin] = gasUsed
+}
+
+// Pop returns and removes the gas used per contract by the last transaction
+// sent by origin. It returns nil if the transaction was not traced.
+func (t *CallTreeTracer) Pop(origin common.Address) map[common.Address]uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	gasUsed := t.frames[origin]
+	delete(t.frames, origin)
+	return gasUsed
+}
+
+// WrapEVMConstructor returns an EVM constructor that installs a frame tracer on
+// the EVMs created while the tracer is active, alongside any tracer that is
+// already configured on the node.
+func (t *CallTreeTracer) WrapEVMConstructor(next evm.Constructor) evm.Constructor {
+	return func(
+		blockCtx vm.BlockContext,
+		txCtx vm.TxContext,
+		stateDB vm.StateDB,
+		chainConfig *params.ChainConfig,
+		config vm.Config,
+		customPrecompiles evm.PrecompiledContracts,
+	) evm.EVM {
+		if t.isActive(blockCtx.BlockNumber) {
+			var tracer vm.EVMLogger = newFrameTracer(t, txCtx.Origin)
+			if config.Debug && config.Tracer != nil {
+				tracer = teeLogger{config.Tracer, tracer}
+			}
+			config.Debug = true
+			config.Tracer = tracer
+		}
+
+		return next(blockCtx, txCtx, stateDB, chainConfig, config, customPrecompiles)
+	}
+}
+
+// frame is a call frame on the stack of a frameTracer
+type frame struct {
+	address  common.Address
+	childGas uint64
+}
+
+// frameTracer is a vm.EVMLogger that accumulates the gas used by each call
+// frame, excluding the gas used by its sub-calls, per code address.
+type frameTracer struct {
+	collector *CallTreeTracer
+	origin    common.Address
+	stack     []frame
+	gasUsed   map[common.Address]uint64
+}
+
+var _ vm.EVMLogger = &frameTracer{}
+
+func newFrameTracer(collector *CallTreeTracer, origin common.Address) *frameTracer {
+	return &frameTracer{
+		collector: collector,
+		origin:    origin,
+		gasUsed:   make(map[common.Address]uint64),
+	}
+}
+
+func (ft *frameTracer) enter(address common.Address) {
+	ft.stack = append(ft.stack, frame{address: address})
+}
+
+func (ft *frameTracer) exit(gasUsed uint64) {
+	if len(ft.stack) == 0 {
+		return
+	}
+
+	current := ft.stack[len(ft.stack)-1]
+	ft.stack = ft.stack[:len(ft.stack)-1]
+
+	if gasUsed > current.childGas {
+		ft.gasUsed[current.address] += gasUsed - current.childGas
+	}
+	if len(ft.stack) > 0 {
+		ft.stack[len(ft.stack)-1].childGas += gasUsed
+	}
+}
+
+// CaptureTxStart implements vm.EVMLogger
+func (ft *frameTracer) CaptureTxStart(_ uint64) {}
+
+// CaptureTxEnd implements vm.EVMLogger
+func (ft *frameTracer) CaptureTxEnd(_ uint64) {}
+
+// CaptureStart implements vm.EVMLogger
+func (ft *frameTracer) CaptureStart(_ *vm.EVM, _ common.Address, to common.Address, _ bool, _ []byte, _ uint64, _ *big.Int) {
+	ft.stack = ft.stack[:0]
+	ft.gasUsed = make(map[common.Address]uint64)
+	ft.enter(to)
+}
+
+// CaptureEnd implements vm.EVMLogger
+func (ft *frameTracer) CaptureEnd(_ []byte, gasUsed uint64, _ time.Duration, _ error) {
+	ft.exit(gasUsed)
+	ft.collector.set(ft.origin, ft.gasUsed)
+}
+
+// CaptureEnter implements vm.EVMLogger
+func (ft *frameTracer) CaptureEnter(_ vm.OpCode, _ common.Address, to common.Address, _ []byte, _ uint64, _ *big.Int) {
+	ft.enter(to)
+}
+
+// CaptureExit implements vm.EVMLogger
+func (ft *frameTracer) CaptureExit(_ []byte, gasUsed uint64, _ error) {
+	ft.exit(gasUsed)
+}
+
+// CaptureState implements vm.EVMLogger
+func (ft *frameTracer) CaptureState(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ []byte, _ int, _ error) {
+}
+
+// CaptureFault implements vm.EVMLogger
+func (ft *frameTracer) CaptureFault(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ int, _ error) {
+}
+
+// teeLogger forwards every event to both loggers
+type teeLogger [2]vm.EVMLogger
+
+var _ vm.EVMLogger = teeLogger{}
+
+func (tl teeLogger) CaptureTxStart(gasLimit uint64) {
+	tl[0].CaptureTxStart(gasLimit)
+	tl[1].CaptureTxStart(gasLimit)
+}
+
+func (tl teeLogger) CaptureTxEnd(restGas uint64) {
+	tl[0].CaptureTxEnd(restGas)
+	tl[1].CaptureTxEnd(restGas)
+}
+
+func (tl teeLogger) CaptureStart(env *vm.EVM, from, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	tl[0].CaptureStart(env, from, to, create, input, gas, value)
+	tl[1].CaptureStart(env, from, to, create, input, gas, value)
+}
+
+func (tl teeLogger) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) {
+	tl[0].CaptureEnd(output, gasUsed, t, err)
+	tl[1].CaptureEnd(output, gasUsed, t, err)
+}
+
+func (tl teeLogger) CaptureEnter(typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	tl[0].CaptureEnter(typ, from, to, input, gas, value)
+	tl[1].CaptureEnter(typ, from, to, input, gas, value)
+}
+
+func (tl teeLogger) CaptureExit(output []byte, gasUsed uint64, err error) {
+	tl[0].CaptureExit(output, gasUsed, err)
+	tl[1].CaptureExit(output, gasUsed, err)
+}
+
+func (tl teeLogger) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	tl[0].CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+	tl[1].CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+}
+
+func (tl teeLogger) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	tl[0].CaptureFault(pc, op, gas, cost, scope, depth, err)
+	tl[1].CaptureFault(pc, op, gas, cost, scope, depth, err)
+}
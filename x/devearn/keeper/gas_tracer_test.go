@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrameTracerGasUsed(t *testing.T) {
+	var (
+		origin     = common.HexToAddress("0x1")
+		router     = common.HexToAddress("0xa")
+		pool       = common.HexToAddress("0xb")
+		token      = common.HexToAddress("0xc")
+		collector  = NewCallTreeTracer()
+		blockLower = big.NewInt(9)
+		block      = big.NewInt(10)
+	)
+
+	collector.Activate(block.Int64())
+	require.False(t, collector.isActive(blockLower))
+	require.True(t, collector.isActive(block))
+
+	// router -> pool -> token, router -> token
+	ft := newFrameTracer(collector, origin)
+	ft.CaptureStart(nil, origin, router, false, nil, 0, nil)
+	ft.CaptureEnter(vm.CALL, router, pool, nil, 0, nil)
+	ft.CaptureEnter(vm.CALL, pool, token, nil, 0, nil)
+	ft.CaptureExit(nil, 1000, nil)
+	ft.CaptureExit(nil, 3000, nil)
+	ft.CaptureEnter(vm.STATICCALL, router, token, nil, 0, nil)
+	ft.CaptureExit(nil, 500, nil)
+	ft.CaptureEnd(nil, 5000, 0, nil)
+
+	gasUsed := collector.Pop(origin)
+	require.Equal(t, map[common.Address]uint64{
+		router: 1500,
+		pool:   2000,
+		token:  1500,
+	}, gasUsed)
+
+	// frames are consumed once
+	require.Nil(t, collector.Pop(origin))
+
+	// nothing is recorded once deactivated
+	collector.Deactivate()
+	require.False(t, collector.isActive(block))
+	ft.CaptureStart(nil, origin, router, false, nil, 0, nil)
+	ft.CaptureEnd(nil, 5000, 0, nil)
+	require.Nil(t, collector.Pop(origin))
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sidechain/x/devearn/types"
+)
+
+// RegisterInvariants registers the devearn module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the devearn module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ModuleBalanceInvariant(k)(ctx)
+	}
+}
+
+// ModuleBalanceInvariant checks that the module account holds the accrued
+// rewards and the escrowed registration deposits. The remainder of the balance
+// is the reward pool of the next distributions.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		accrued := k.GetTotalAccruedRewards(ctx)
+		escrowed := sdk.Coins{}
+		k.IterateDevEarnInfos(ctx, func(devEarnInfo types.DevEarnInfo) (stop bool) {
+			escrowed = escrowed.Add(devEarnInfo.Deposit...)
+			return false
+		})
+
+		expected := accrued.Add(escrowed...)
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		balance := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+		broken := !balance.IsAllGTE(expected)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-balance",
+			fmt.Sprintf(
+				"\tmodule balance: %s\n\taccrued rewards: %s\n\tescrowed deposits: %s\n",
+				balance, accrued, escrowed,
+			),
+		), broken
+	}
+}
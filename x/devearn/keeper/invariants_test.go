@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"sidechain/x/devearn/keeper"
+	"sidechain/x/devearn/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	testCases := []struct {
+		name      string
+		malleate  func()
+		invariant func(keeper.Keeper) sdk.Invariant
+		expBroken bool
+	}{
+		{
+			"module balance - rewards and deposits are funded",
+			func() {
+				rewards := sdk.NewCoins(sdk.NewInt64Coin(denomMint, 100))
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, rewards.Add(rewards...))
+				suite.Require().NoError(err)
+				suite.app.DevearnKeeper.AccrueRewards(suite.ctx, common.BytesToAddress(ownerPriv1.PubKey().Address()), contract, rewards)
+				// the remainder is the reward pool
+			},
+			keeper.ModuleBalanceInvariant,
+			false,
+		},
+		{
+			"module balance - unfunded rewards",
+			func() {
+				rewards := sdk.NewCoins(sdk.NewInt64Coin(denomMint, 100))
+				suite.app.DevearnKeeper.AccrueRewards(suite.ctx, common.BytesToAddress(ownerPriv1.PubKey().Address()), contract, rewards)
+			},
+			keeper.ModuleBalanceInvariant,
+			true,
+		},
+		{
+			"module balance - unfunded deposit",
+			func() {
+				devEarnInfo := types.NewDevEarn(contract, 0, epochs, ownerPriv1.PubKey().Address().String())
+				devEarnInfo.Deposit = sdk.NewCoins(sdk.NewInt64Coin(denomMint, 100))
+				suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, devEarnInfo)
+			},
+			keeper.ModuleBalanceInvariant,
+			true,
+		},
+		{
+			"all invariants - empty state",
+			func() {},
+			keeper.AllInvariants,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			suite.deployContracts()
+
+			tc.malleate()
+
+			msg, broken := tc.invariant(suite.app.DevearnKeeper)(suite.ctx)
+			suite.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
@@ -24,6 +24,13 @@ type (
 		evmKeeper     types.EvmKeeper
 		oracleKeeper  types.OracleKeeper
 		erc20Keeper   types.Erc20Keeper
+		stakingKeeper types.StakingKeeper
+		distrKeeper   types.DistributionKeeper
+		epochsKeeper  types.EpochsKeeper
+
+		// callTreeTracer collects the per-contract gas of delivered EVM txs. It
+		// is nil when the EVM constructor was not wrapped.
+		callTreeTracer *CallTreeTracer
 	}
 )
 
@@ -38,6 +45,10 @@ func NewKeeper(
 	evmKeeper types.EvmKeeper,
 	oracleKeeper types.OracleKeeper,
 	erc20Keeper types.Erc20Keeper,
+	stakingKeeper types.StakingKeeper,
+	distrKeeper types.DistributionKeeper,
+	epochsKeeper types.EpochsKeeper,
+	callTreeTracer *CallTreeTracer,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -55,6 +66,11 @@ func NewKeeper(
 		evmKeeper:     evmKeeper,
 		oracleKeeper:  oracleKeeper,
 		erc20Keeper:   erc20Keeper,
+		stakingKeeper: stakingKeeper,
+		distrKeeper:   distrKeeper,
+		epochsKeeper:  epochsKeeper,
+
+		callTreeTracer: callTreeTracer,
 	}
 }
 
@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"sidechain/x/devearn/types"
 )
 
@@ -25,9 +29,394 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ValidateRewardEpoch(ctx, req.Params.RewardEpochIdentifier); err != nil {
+		return nil, err
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RegisterDevEarn registers a contract for dev earn rewards on behalf of its
+// deployer. The deployer proves the deployment by providing the nonces that
+// derive the contract address from its own address.
+func (k *Keeper) RegisterDevEarn(goCtx context.Context, msg *types.MsgRegisterDevEarn) (*types.MsgRegisterDevEarnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	deployer := sdk.MustAccAddressFromBech32(msg.DeployerAddress)
+	deployerAccount := k.evmKeeper.GetAccountWithoutBalance(ctx, common.BytesToAddress(deployer))
+	if deployerAccount == nil {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrNotFound,
+			"deployer account not found %s", msg.DeployerAddress,
+		)
+	}
+	if deployerAccount.IsContract() {
+		return nil, errorsmod.Wrapf(
+			types.ErrNotDeployer,
+			"deployer cannot be a contract %s", msg.DeployerAddress,
+		)
+	}
+
+	// the contract can be deployed directly by the EOA or through one or more
+	// factories, in which case every nonce after the first one is the nonce of
+	// the factory that created the next contract in the chain. Neither the
+	// deployer nor any of the factories can be blocked.
+	contract := common.HexToAddress(msg.Contract)
+	derived := common.BytesToAddress(deployer)
+	for _, nonce := range msg.Nonces {
+		if k.IsBlocked(ctx, derived) {
+			return nil, errorsmod.Wrapf(
+				types.ErrBlocked,
+				"deployer is blocked: %s", derived,
+			)
+		}
+		derived = crypto.CreateAddress(derived, nonce)
+	}
+	if derived != contract {
+		return nil, errorsmod.Wrapf(
+			types.ErrNotDeployer,
+			"not contract deployer or wrong nonces: expected %s instead of %s", derived, contract,
+		)
+	}
+
+	ownerAddr := msg.OwnerAddress
+	if ownerAddr == "" {
+		ownerAddr = common.BytesToAddress(deployer).Hex()
+	}
+
+	devEarnInfo, err := k.RegisterDevEarnContract(ctx, contract, msg.Epochs, ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := k.GetParams(ctx).RegistrationDeposit
+	if !deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, deployer, types.ModuleName, deposit); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to escrow registration deposit")
+		}
+	}
+
+	devEarnInfo.DeployerAddress = msg.DeployerAddress
+	devEarnInfo.Nonces = msg.Nonces
+	devEarnInfo.Deposit = deposit
+	k.SetDevEarnInfo(ctx, *devEarnInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterDevEarn,
+			sdk.NewAttribute(types.AttributeKeyContract, devEarnInfo.Contract),
+			sdk.NewAttribute(types.AttributeKeyDeployer, msg.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyOwner, devEarnInfo.OwnerAddress),
+			sdk.NewAttribute(
+				types.AttributeKeyEpochs,
+				strconv.FormatUint(uint64(devEarnInfo.Epochs), 10),
+			),
+		),
+	)
+
+	return &types.MsgRegisterDevEarnResponse{}, nil
+}
+
+// CancelDevEarn cancels a self-registered contract and refunds the deposit to
+// its deployer.
+func (k *Keeper) CancelDevEarn(goCtx context.Context, msg *types.MsgCancelDevEarn) (*types.MsgCancelDevEarnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract := common.HexToAddress(msg.Contract)
+	devEarnInfo, found := k.GetDevEarnInfo(ctx, contract)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrNotFound,
+			"contract is not registered %s", msg.Contract,
+		)
+	}
+
+	if devEarnInfo.DeployerAddress != msg.DeployerAddress {
+		return nil, errorsmod.Wrapf(
+			types.ErrNotDeployer,
+			"%s is not the deployer of %s", msg.DeployerAddress, msg.Contract,
+		)
+	}
+
+	if err := k.CancelDevEarnContract(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelDevEarn,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+			sdk.NewAttribute(types.AttributeKeyDeployer, msg.DeployerAddress),
+		),
+	)
+
+	return &types.MsgCancelDevEarnResponse{}, nil
+}
+
+// ClaimDevEarnRewards transfers the rewards accrued to the signer, optionally
+// restricted to a single contract. When a withdraw address is given, the
+// signer must own the contract and the rewards the contract accrued to the
+// withdraw address are transferred to it, so that withdraw addresses without a
+// signing key can still collect.
+func (k *Keeper) ClaimDevEarnRewards(goCtx context.Context, msg *types.MsgClaimDevEarnRewards) (*types.MsgClaimDevEarnRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner := sdk.MustAccAddressFromBech32(msg.OwnerAddress)
+	receiver := common.BytesToAddress(owner)
+
+	var contract *common.Address
+	if msg.Contract != "" {
+		contractAddr := common.HexToAddress(msg.Contract)
+		contract = &contractAddr
+	}
+
+	if msg.WithdrawAddress != "" {
+		if _, err := k.getOwnedDevEarnInfo(ctx, msg.Contract, msg.OwnerAddress); err != nil {
+			return nil, err
+		}
+		receiver = common.HexToAddress(msg.WithdrawAddress)
+	}
+
+	claimed, err := k.ClaimRewards(ctx, receiver, contract, sdk.AccAddress(receiver.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimDevEarnRewardsResponse{Amount: claimed}, nil
+}
+
+// UpdateDevEarnOwner transfers the ownership of a registered contract. Only the
+// current owner can transfer it. The withdraw address set by the previous owner
+// is cleared, so the rewards are accrued to the new owner.
+func (k *Keeper) UpdateDevEarnOwner(goCtx context.Context, msg *types.MsgUpdateDevEarnOwner) (*types.MsgUpdateDevEarnOwnerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	devEarnInfo, err := k.getOwnedDevEarnInfo(ctx, msg.Contract, msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	devEarnInfo.OwnerAddress = common.HexToAddress(msg.NewOwnerAddress).Hex()
+	devEarnInfo.WithdrawAddress = ""
+	k.SetDevEarnInfo(ctx, devEarnInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateDevEarnOwner,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.OwnerAddress),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, devEarnInfo.OwnerAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdraw, devEarnInfo.RewardReceiver()),
+		),
+	)
+
+	return &types.MsgUpdateDevEarnOwnerResponse{}, nil
+}
+
+// SetDevEarnWithdrawAddress sets the address the rewards of a registered
+// contract are accrued to. Only the current owner can set it.
+func (k *Keeper) SetDevEarnWithdrawAddress(goCtx context.Context, msg *types.MsgSetDevEarnWithdrawAddress) (*types.MsgSetDevEarnWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	devEarnInfo, err := k.getOwnedDevEarnInfo(ctx, msg.Contract, msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	devEarnInfo.WithdrawAddress = ""
+	if msg.WithdrawAddress != "" {
+		devEarnInfo.WithdrawAddress = common.HexToAddress(msg.WithdrawAddress).Hex()
+	}
+	k.SetDevEarnInfo(ctx, devEarnInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.OwnerAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdraw, devEarnInfo.RewardReceiver()),
+		),
+	)
+
+	return &types.MsgSetDevEarnWithdrawAddressResponse{}, nil
+}
+
+// AddToBlocklist blocks contract and deployer addresses from dev earn. Blocked
+// contracts can't be registered, the gas they use is no longer credited and
+// they earn no rewards in the distributions settling them after they're
+// blocked.
+func (k *Keeper) AddToBlocklist(goCtx context.Context, msg *types.MsgAddToBlocklist) (*types.MsgAddToBlocklistResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, addr := range msg.Addresses {
+		address := common.HexToAddress(addr)
+		if k.IsBlocked(ctx, address) {
+			return nil, errorsmod.Wrapf(
+				errortypes.ErrInvalidRequest,
+				"address is already blocked %s", addr,
+			)
+		}
+
+		k.SetBlocked(ctx, address)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAddToBlocklist,
+				sdk.NewAttribute(types.AttributeKeyAddress, address.Hex()),
+			),
+		)
+	}
+
+	return &types.MsgAddToBlocklistResponse{}, nil
+}
+
+// RemoveFromBlocklist unblocks contract and deployer addresses
+func (k *Keeper) RemoveFromBlocklist(goCtx context.Context, msg *types.MsgRemoveFromBlocklist) (*types.MsgRemoveFromBlocklistResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, addr := range msg.Addresses {
+		address := common.HexToAddress(addr)
+		if !k.IsBlocked(ctx, address) {
+			return nil, errorsmod.Wrapf(
+				errortypes.ErrNotFound,
+				"address is not blocked %s", addr,
+			)
+		}
+
+		k.DeleteBlocked(ctx, address)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRemoveFromBlocklist,
+				sdk.NewAttribute(types.AttributeKeyAddress, address.Hex()),
+			),
+		)
+	}
+
+	return &types.MsgRemoveFromBlocklistResponse{}, nil
+}
+
+// RegisterDevEarnInfo registers a contract for dev earn rewards on behalf of
+// governance
+func (k *Keeper) RegisterDevEarnInfo(goCtx context.Context, msg *types.MsgRegisterDevEarnInfo) (*types.MsgRegisterDevEarnInfoResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	devEarnInfo, err := k.RegisterDevEarnContract(ctx, common.HexToAddress(msg.Contract), msg.Epochs, msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterDevEarn,
+			sdk.NewAttribute(types.AttributeKeyContract, devEarnInfo.Contract),
+			sdk.NewAttribute(
+				types.AttributeKeyEpochs,
+				strconv.FormatUint(uint64(devEarnInfo.Epochs), 10),
+			),
+		),
+	)
+
+	return &types.MsgRegisterDevEarnInfoResponse{}, nil
+}
+
+// CancelDevEarnInfo cancels the dev earn registration of a contract on behalf
+// of governance
+func (k *Keeper) CancelDevEarnInfo(goCtx context.Context, msg *types.MsgCancelDevEarnInfo) (*types.MsgCancelDevEarnInfoResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.CancelDevEarnContract(ctx, common.HexToAddress(msg.Contract)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelDevEarn,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+		),
+	)
+
+	return &types.MsgCancelDevEarnInfoResponse{}, nil
+}
+
+// AddAsset adds an asset to the TVL whitelist on behalf of governance
+func (k *Keeper) AddAsset(goCtx context.Context, msg *types.MsgAddAsset) (*types.MsgAddAssetResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	assets, err := k.AddAssetToWhitelist(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddAssetToWhitelist,
+			sdk.NewAttribute(types.AttributeKeyAsset, assets.Denom),
+		),
+	)
+
+	return &types.MsgAddAssetResponse{}, nil
+}
+
+// RemoveAsset removes an asset from the TVL whitelist on behalf of governance
+func (k *Keeper) RemoveAsset(goCtx context.Context, msg *types.MsgRemoveAsset) (*types.MsgRemoveAssetResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.RemoveAssetFromWhitelist(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveAssetFromWhitelist,
+			sdk.NewAttribute(types.AttributeKeyAsset, msg.Denom),
+		),
+	)
+
+	return &types.MsgRemoveAssetResponse{}, nil
+}
+
+// getOwnedDevEarnInfo returns the dev earn info of a contract if it's owned by
+// the given bech32 address
+func (k Keeper) getOwnedDevEarnInfo(ctx sdk.Context, contract, owner string) (types.DevEarnInfo, error) {
+	devEarnInfo, found := k.GetDevEarnInfo(ctx, common.HexToAddress(contract))
+	if !found {
+		return types.DevEarnInfo{}, errorsmod.Wrapf(
+			errortypes.ErrNotFound,
+			"contract is not registered %s", contract,
+		)
+	}
+
+	ownerAddr := sdk.MustAccAddressFromBech32(owner)
+	if !common.IsHexAddress(devEarnInfo.OwnerAddress) ||
+		common.HexToAddress(devEarnInfo.OwnerAddress) != common.BytesToAddress(ownerAddr) {
+		return types.DevEarnInfo{}, errorsmod.Wrapf(
+			types.ErrNotOwner,
+			"%s is not the owner of %s", owner, contract,
+		)
+	}
+
+	return devEarnInfo, nil
+}
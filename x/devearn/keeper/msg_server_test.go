@@ -2,15 +2,717 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
 	keepertest "sidechain/testutil/keeper"
+	utiltx "sidechain/testutil/tx"
 	"sidechain/x/devearn/keeper"
 	"sidechain/x/devearn/types"
+	epochstypes "sidechain/x/epochs/types"
 )
 
 func setupMsgServer(t testing.TB) (types.MsgServer, context.Context) {
 	k, ctx := keepertest.DevearnKeeper(t)
 	return keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
 }
+
+func (suite *KeeperTestSuite) TestClaimDevEarnRewards() {
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denomMint, 1000))
+	owner := sdk.AccAddress(ownerPriv1.PubKey().Address())
+
+	testCases := []struct {
+		name       string
+		malleate   func() *types.MsgClaimDevEarnRewards
+		expClaimed sdk.Coins
+		expPass    bool
+	}{
+		{
+			"no rewards",
+			func() *types.MsgClaimDevEarnRewards {
+				return types.NewMsgClaimDevEarnRewards(owner, "", "")
+			},
+			nil,
+			false,
+		},
+		{
+			"no rewards for contract",
+			func() *types.MsgClaimDevEarnRewards {
+				suite.accrueRewards(owner, contract, rewards)
+				return types.NewMsgClaimDevEarnRewards(owner, contract2.Hex(), "")
+			},
+			nil,
+			false,
+		},
+		{
+			"ok - every contract",
+			func() *types.MsgClaimDevEarnRewards {
+				suite.accrueRewards(owner, contract, rewards)
+				suite.accrueRewards(owner, contract2, rewards)
+				return types.NewMsgClaimDevEarnRewards(owner, "", "")
+			},
+			rewards.Add(rewards...),
+			true,
+		},
+		{
+			"ok - single contract",
+			func() *types.MsgClaimDevEarnRewards {
+				suite.accrueRewards(owner, contract, rewards)
+				suite.accrueRewards(owner, contract2, rewards)
+				return types.NewMsgClaimDevEarnRewards(owner, contract2.Hex(), "")
+			},
+			rewards,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.deployContracts()
+
+			msg := tc.malleate()
+			pendingBefore := suite.pendingRewards(owner)
+
+			res, err := suite.app.DevearnKeeper.ClaimDevEarnRewards(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(tc.expClaimed, res.Amount)
+
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, owner, denomMint)
+				suite.Require().Equal(tc.expClaimed.AmountOf(denomMint), balance.Amount)
+				suite.Require().Equal(pendingBefore.Sub(tc.expClaimed...).String(), suite.pendingRewards(owner).String())
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Equal(pendingBefore, suite.pendingRewards(owner))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestClaimDevEarnRewardsForWithdrawAddress() {
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denomMint, 1000))
+	owner := sdk.AccAddress(ownerPriv1.PubKey().Address())
+	other := sdk.AccAddress(ownerPriv2.PubKey().Address())
+
+	testCases := []struct {
+		name     string
+		malleate func(withdrawAddr common.Address) *types.MsgClaimDevEarnRewards
+		expPass  bool
+	}{
+		{
+			"not the owner",
+			func(withdrawAddr common.Address) *types.MsgClaimDevEarnRewards {
+				return types.NewMsgClaimDevEarnRewards(other, contract.Hex(), withdrawAddr.Hex())
+			},
+			false,
+		},
+		{
+			"no rewards accrued to the withdraw address",
+			func(withdrawAddr common.Address) *types.MsgClaimDevEarnRewards {
+				return types.NewMsgClaimDevEarnRewards(owner, contract.Hex(), utiltx.GenerateAddress().Hex())
+			},
+			false,
+		},
+		{
+			"ok - owner claims for the contract withdraw address",
+			func(withdrawAddr common.Address) *types.MsgClaimDevEarnRewards {
+				return types.NewMsgClaimDevEarnRewards(owner, contract.Hex(), withdrawAddr.Hex())
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.deployContracts()
+
+			// the rewards of the contract are withdrawn to another contract,
+			// which has no key to sign the claim
+			withdrawAddr := contract2
+			_, err := suite.app.DevearnKeeper.RegisterDevEarnContract(suite.ctx, contract, epochs, common.BytesToAddress(owner).Hex())
+			suite.Require().NoError(err)
+			_, err = suite.app.DevearnKeeper.SetDevEarnWithdrawAddress(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgSetDevEarnWithdrawAddress(contract, owner, withdrawAddr.Hex()),
+			)
+			suite.Require().NoError(err)
+			suite.accrueRewards(sdk.AccAddress(withdrawAddr.Bytes()), contract, rewards)
+
+			msg := tc.malleate(withdrawAddr)
+			suite.Require().NoError(msg.ValidateBasic())
+
+			res, err := suite.app.DevearnKeeper.ClaimDevEarnRewards(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(rewards, res.Amount)
+
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(withdrawAddr.Bytes()), denomMint)
+				suite.Require().Equal(rewards.AmountOf(denomMint), balance.Amount)
+				suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, owner, denomMint).IsZero())
+				suite.Require().True(suite.pendingRewards(sdk.AccAddress(withdrawAddr.Bytes())).IsZero())
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Equal(rewards, suite.pendingRewards(sdk.AccAddress(withdrawAddr.Bytes())))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateDevEarnOwner() {
+	owner := sdk.AccAddress(ownerPriv1.PubKey().Address())
+	newOwner := common.BytesToAddress(ownerPriv2.PubKey().Address())
+
+	testCases := []struct {
+		name     string
+		malleate func() *types.MsgUpdateDevEarnOwner
+		expPass  bool
+	}{
+		{
+			"not registered",
+			func() *types.MsgUpdateDevEarnOwner {
+				return types.NewMsgUpdateDevEarnOwner(contract, owner, newOwner)
+			},
+			false,
+		},
+		{
+			"not the owner",
+			func() *types.MsgUpdateDevEarnOwner {
+				_, err := suite.app.DevearnKeeper.RegisterDevEarnContract(suite.ctx, contract, epochs, common.BytesToAddress(owner).Hex())
+				suite.Require().NoError(err)
+				return types.NewMsgUpdateDevEarnOwner(contract, sdk.AccAddress(newOwner.Bytes()), newOwner)
+			},
+			false,
+		},
+		{
+			"ok",
+			func() *types.MsgUpdateDevEarnOwner {
+				_, err := suite.app.DevearnKeeper.RegisterDevEarnContract(suite.ctx, contract, epochs, common.BytesToAddress(owner).Hex())
+				suite.Require().NoError(err)
+				return types.NewMsgUpdateDevEarnOwner(contract, owner, newOwner)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.deployContracts()
+
+			msg := tc.malleate()
+
+			_, err := suite.app.DevearnKeeper.UpdateDevEarnOwner(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				devEarnInfo, found := suite.app.DevearnKeeper.GetDevEarnInfo(suite.ctx, contract)
+				suite.Require().True(found)
+				suite.Require().Equal(newOwner.Hex(), devEarnInfo.OwnerAddress)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
+
+// Accrue the rewards to the new owner rather than to the withdraw address set by
+// the previous owner
+func (suite *KeeperTestSuite) TestUpdateDevEarnOwnerClearsWithdrawAddress() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	owner := sdk.AccAddress(ownerPriv1.PubKey().Address())
+	newOwner := common.BytesToAddress(ownerPriv2.PubKey().Address())
+	treasury := utiltx.GenerateAddress()
+
+	_, err := suite.app.DevearnKeeper.RegisterDevEarnContract(suite.ctx, contract, epochs, common.BytesToAddress(owner).Hex())
+	suite.Require().NoError(err)
+	_, err = suite.app.DevearnKeeper.SetDevEarnWithdrawAddress(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgSetDevEarnWithdrawAddress(contract, owner, treasury.Hex()),
+	)
+	suite.Require().NoError(err)
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.app.DevearnKeeper.UpdateDevEarnOwner(sdk.WrapSDKContext(ctx), types.NewMsgUpdateDevEarnOwner(contract, owner, newOwner))
+	suite.Require().NoError(err)
+
+	devEarnInfo, found := suite.app.DevearnKeeper.GetDevEarnInfo(suite.ctx, contract)
+	suite.Require().True(found)
+	suite.Require().Empty(devEarnInfo.WithdrawAddress)
+
+	var withdraw string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeUpdateDevEarnOwner {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyWithdraw {
+				withdraw = string(attr.Value)
+			}
+		}
+	}
+	suite.Require().Equal(newOwner.Hex(), withdraw)
+
+	// the rewards of the next distribution are accrued to the new owner
+	err = suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denomMint, 1000)))
+	suite.Require().NoError(err)
+	devEarnInfo.GasMeter = 500
+	suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, devEarnInfo)
+
+	// the epoch ends in a later block than the registration
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	err = suite.app.DevearnKeeper.DistributeRewards(suite.ctx, 1)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.pendingRewards(sdk.AccAddress(newOwner.Bytes())).IsAllPositive())
+	suite.Require().True(suite.pendingRewards(sdk.AccAddress(treasury.Bytes())).IsZero())
+	suite.Require().True(suite.pendingRewards(owner).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestSetDevEarnWithdrawAddress() {
+	const gasUsed uint64 = 500
+	owner := sdk.AccAddress(ownerPriv1.PubKey().Address())
+	treasury := common.BytesToAddress(ownerPriv2.PubKey().Address())
+
+	testCases := []struct {
+		name        string
+		malleate    func() *types.MsgSetDevEarnWithdrawAddress
+		expReceiver common.Address
+		expPass     bool
+	}{
+		{
+			"not the owner",
+			func() *types.MsgSetDevEarnWithdrawAddress {
+				return types.NewMsgSetDevEarnWithdrawAddress(contract, sdk.AccAddress(treasury.Bytes()), treasury.Hex())
+			},
+			common.Address{},
+			false,
+		},
+		{
+			"ok - rewards accrued to the withdraw address",
+			func() *types.MsgSetDevEarnWithdrawAddress {
+				return types.NewMsgSetDevEarnWithdrawAddress(contract, owner, treasury.Hex())
+			},
+			treasury,
+			true,
+		},
+		{
+			"ok - reset to the owner",
+			func() *types.MsgSetDevEarnWithdrawAddress {
+				_, err := suite.app.DevearnKeeper.SetDevEarnWithdrawAddress(
+					sdk.WrapSDKContext(suite.ctx),
+					types.NewMsgSetDevEarnWithdrawAddress(contract, owner, treasury.Hex()),
+				)
+				suite.Require().NoError(err)
+				return types.NewMsgSetDevEarnWithdrawAddress(contract, owner, "")
+			},
+			common.BytesToAddress(owner),
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.deployContracts()
+
+			_, err := suite.app.DevearnKeeper.RegisterDevEarnContract(suite.ctx, contract, epochs, common.BytesToAddress(owner).Hex())
+			suite.Require().NoError(err)
+
+			msg := tc.malleate()
+
+			_, err = suite.app.DevearnKeeper.SetDevEarnWithdrawAddress(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err, tc.name)
+				return
+			}
+			suite.Require().NoError(err, tc.name)
+
+			// the rewards of the next distribution are accrued to the receiver
+			err = suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denomMint, 1000)))
+			suite.Require().NoError(err)
+			devEarnInfo, _ := suite.app.DevearnKeeper.GetDevEarnInfo(suite.ctx, contract)
+			devEarnInfo.GasMeter = gasUsed
+			suite.app.DevearnKeeper.SetDevEarnInfo(suite.ctx, devEarnInfo)
+
+			// the epoch ends in a later block than the registration
+			suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+			err = suite.app.DevearnKeeper.DistributeRewards(suite.ctx, 1)
+			suite.Require().NoError(err)
+
+			suite.Require().True(suite.pendingRewards(sdk.AccAddress(tc.expReceiver.Bytes())).IsAllPositive())
+			if tc.expReceiver != common.BytesToAddress(owner) {
+				suite.Require().True(suite.pendingRewards(owner).IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.MsgUpdateParams)
+		expPass  bool
+	}{
+		{
+			"invalid authority",
+			func(msg *types.MsgUpdateParams) {
+				msg.Authority = suite.address.String()
+			},
+			false,
+		},
+		{
+			"unknown reward epoch",
+			func(msg *types.MsgUpdateParams) {
+				msg.Params.RewardEpochIdentifier = "fortnight"
+			},
+			false,
+		},
+		{
+			"ok - custom reward epoch",
+			func(msg *types.MsgUpdateParams) {
+				suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, epochstypes.EpochInfo{
+					Identifier: "fortnight",
+					Duration:   14 * 24 * time.Hour,
+				})
+				msg.Params.RewardEpochIdentifier = "fortnight"
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			msg := &types.MsgUpdateParams{
+				Authority: authority,
+				Params:    suite.app.DevearnKeeper.GetParams(suite.ctx),
+			}
+			tc.malleate(msg)
+
+			_, err := suite.app.DevearnKeeper.UpdateParams(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(msg.Params, suite.app.DevearnKeeper.GetParams(suite.ctx))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAddToBlocklist() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.MsgAddToBlocklist)
+		expPass  bool
+	}{
+		{
+			"invalid authority",
+			func(msg *types.MsgAddToBlocklist) {
+				msg.Authority = suite.address.String()
+			},
+			false,
+		},
+		{
+			"already blocked",
+			func(msg *types.MsgAddToBlocklist) {
+				suite.app.DevearnKeeper.SetBlocked(suite.ctx, contract)
+			},
+			false,
+		},
+		{
+			"ok",
+			func(_ *types.MsgAddToBlocklist) {},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			msg := &types.MsgAddToBlocklist{
+				Authority: authority,
+				Addresses: []string{contract.Hex(), suite.address.Hex()},
+			}
+			tc.malleate(msg)
+
+			_, err := suite.app.DevearnKeeper.AddToBlocklist(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(suite.app.DevearnKeeper.IsBlocked(suite.ctx, contract))
+				suite.Require().True(suite.app.DevearnKeeper.IsBlocked(suite.ctx, suite.address))
+				suite.Require().Len(suite.app.DevearnKeeper.GetBlocklist(suite.ctx), 2)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveFromBlocklist() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.MsgRemoveFromBlocklist)
+		expPass  bool
+	}{
+		{
+			"invalid authority",
+			func(msg *types.MsgRemoveFromBlocklist) {
+				msg.Authority = suite.address.String()
+			},
+			false,
+		},
+		{
+			"not blocked",
+			func(msg *types.MsgRemoveFromBlocklist) {
+				msg.Addresses = append(msg.Addresses, contract2.Hex())
+			},
+			false,
+		},
+		{
+			"ok",
+			func(_ *types.MsgRemoveFromBlocklist) {},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			suite.app.DevearnKeeper.SetBlocked(suite.ctx, contract)
+
+			msg := &types.MsgRemoveFromBlocklist{
+				Authority: authority,
+				Addresses: []string{contract.Hex()},
+			}
+			tc.malleate(msg)
+
+			_, err := suite.app.DevearnKeeper.RemoveFromBlocklist(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.app.DevearnKeeper.IsBlocked(suite.ctx, contract))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRegisterDevEarnInfo() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.MsgRegisterDevEarnInfo)
+		expPass  bool
+	}{
+		{
+			"invalid authority",
+			func(msg *types.MsgRegisterDevEarnInfo) {
+				msg.Authority = suite.address.String()
+			},
+			false,
+		},
+		{
+			"already registered",
+			func(msg *types.MsgRegisterDevEarnInfo) {
+				_, err := suite.app.DevearnKeeper.RegisterDevEarnContract(suite.ctx, contract, epochs, suite.address.Hex())
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"ok",
+			func(_ *types.MsgRegisterDevEarnInfo) {},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			suite.deployContracts()
+
+			msg := &types.MsgRegisterDevEarnInfo{
+				Authority:    authority,
+				Contract:     contract.Hex(),
+				OwnerAddress: suite.address.Hex(),
+				Epochs:       epochs,
+			}
+			tc.malleate(msg)
+
+			msgServer := keeper.NewMsgServerImpl(suite.app.DevearnKeeper)
+			_, err := msgServer.RegisterDevEarnInfo(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				info, found := suite.app.DevearnKeeper.GetDevEarnInfo(suite.ctx, contract)
+				suite.Require().True(found)
+				suite.Require().Equal(suite.address.Hex(), info.OwnerAddress)
+				suite.Require().Equal(epochs, info.Epochs)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgCancelDevEarnInfo() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.MsgCancelDevEarnInfo)
+		expPass  bool
+	}{
+		{
+			"invalid authority",
+			func(msg *types.MsgCancelDevEarnInfo) {
+				msg.Authority = suite.address.String()
+			},
+			false,
+		},
+		{
+			"not registered",
+			func(msg *types.MsgCancelDevEarnInfo) {
+				msg.Contract = contract2.Hex()
+			},
+			false,
+		},
+		{
+			"ok",
+			func(_ *types.MsgCancelDevEarnInfo) {},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			suite.deployContracts()
+
+			_, err := suite.app.DevearnKeeper.RegisterDevEarnContract(suite.ctx, contract, epochs, suite.address.Hex())
+			suite.Require().NoError(err)
+
+			msg := &types.MsgCancelDevEarnInfo{
+				Authority: authority,
+				Contract:  contract.Hex(),
+			}
+			tc.malleate(msg)
+
+			msgServer := keeper.NewMsgServerImpl(suite.app.DevearnKeeper)
+			_, err = msgServer.CancelDevEarnInfo(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.app.DevearnKeeper.IsDevEarnInfoRegistered(suite.ctx, contract))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgAddAsset() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.MsgAddAsset)
+	}{
+		{
+			"invalid authority",
+			func(msg *types.MsgAddAsset) {
+				msg.Authority = suite.address.String()
+			},
+		},
+		{
+			"already whitelisted",
+			func(msg *types.MsgAddAsset) {
+				suite.app.DevearnKeeper.SetAssets(suite.ctx, types.Assets{Denom: msg.Denom})
+			},
+		},
+		{
+			"no token pair",
+			func(_ *types.MsgAddAsset) {},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			msg := &types.MsgAddAsset{
+				Authority: authority,
+				Denom:     "uatom",
+			}
+			tc.malleate(msg)
+
+			msgServer := keeper.NewMsgServerImpl(suite.app.DevearnKeeper)
+			_, err := msgServer.AddAsset(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().Error(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRemoveAsset() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.MsgRemoveAsset)
+		expPass  bool
+	}{
+		{
+			"invalid authority",
+			func(msg *types.MsgRemoveAsset) {
+				msg.Authority = suite.address.String()
+			},
+			false,
+		},
+		{
+			"not whitelisted",
+			func(msg *types.MsgRemoveAsset) {
+				msg.Denom = "uosmo"
+			},
+			false,
+		},
+		{
+			"ok",
+			func(_ *types.MsgRemoveAsset) {},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			suite.app.DevearnKeeper.SetAssets(suite.ctx, types.Assets{Denom: "uatom"})
+
+			msg := &types.MsgRemoveAsset{
+				Authority: authority,
+				Denom:     "uatom",
+			}
+			tc.malleate(msg)
+
+			msgServer := keeper.NewMsgServerImpl(suite.app.DevearnKeeper)
+			_, err := msgServer.RemoveAsset(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.app.DevearnKeeper.IsAssetRegistered(suite.ctx, "uatom"))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// accrueRewards funds the module account and accrues the rewards to the owner
+func (suite *KeeperTestSuite) accrueRewards(owner sdk.AccAddress, contract common.Address, rewards sdk.Coins) {
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, rewards)
+	suite.Require().NoError(err)
+	suite.app.DevearnKeeper.AccrueRewards(suite.ctx, common.BytesToAddress(owner), contract, rewards)
+}
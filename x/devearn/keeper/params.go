@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"sidechain/x/devearn/types"
 )
@@ -30,3 +31,12 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 
 	return nil
 }
+
+// ValidateRewardEpoch checks that the reward epoch identifier matches an epoch
+// of the epochs module
+func (k Keeper) ValidateRewardEpoch(ctx sdk.Context, identifier string) error {
+	if _, found := k.epochsKeeper.GetEpochInfo(ctx, identifier); !found {
+		return errorsmod.Wrapf(types.ErrUnknownEpoch, "epoch '%s' does not exist", identifier)
+	}
+	return nil
+}
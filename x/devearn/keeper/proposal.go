@@ -10,7 +10,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
-func (k Keeper) RegisterDevEarnInfo(
+// RegisterDevEarnContract registers a contract for dev earn rewards
+func (k Keeper) RegisterDevEarnContract(
 	ctx sdk.Context,
 	contract common.Address,
 	epochs uint32,
@@ -34,6 +35,14 @@ func (k Keeper) RegisterDevEarnInfo(
 		)
 	}
 
+	// Check if the contract is blocked by governance
+	if k.IsBlocked(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrBlocked,
+			"contract is blocked: %s", contract,
+		)
+	}
+
 	// Check if the incentive is already registered
 	if k.IsDevEarnInfoRegistered(ctx, contract) {
 		return nil, errorsmod.Wrapf(
@@ -50,8 +59,9 @@ func (k Keeper) RegisterDevEarnInfo(
 	return &devEarnInfo, nil
 }
 
-// RegisterIncentive deletes the incentive for a contract
-func (k Keeper) CancelDevEarnInfo(
+// CancelDevEarnContract deletes the dev earn registration of a contract and
+// refunds its deposit
+func (k Keeper) CancelDevEarnContract(
 	ctx sdk.Context,
 	contract common.Address,
 ) error {
@@ -72,6 +82,10 @@ func (k Keeper) CancelDevEarnInfo(
 		)
 	}
 
+	if err := k.RefundDeposit(ctx, devEarnInfo); err != nil {
+		return err
+	}
+
 	k.DeleteDevEarnInfo(ctx, devEarnInfo)
 	return nil
 }
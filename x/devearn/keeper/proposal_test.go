@@ -6,6 +6,7 @@ import (
 	"sidechain/x/devearn/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 func (suite KeeperTestSuite) TestRegisterIncentive() { //nolint:govet // we can copy locks here because it is a test
@@ -30,6 +31,13 @@ func (suite KeeperTestSuite) TestRegisterIncentive() { //nolint:govet // we can
 			},
 			false,
 		},
+		{
+			"contract is blocked",
+			func() {
+				suite.app.DevearnKeeper.SetBlocked(suite.ctx, contract)
+			},
+			false,
+		},
 		{
 			"inventive already registered",
 			func() {
@@ -60,7 +68,7 @@ func (suite KeeperTestSuite) TestRegisterIncentive() { //nolint:govet // we can
 
 			tc.malleate()
 
-			in, err := suite.app.DevearnKeeper.RegisterDevEarnInfo(
+			in, err := suite.app.DevearnKeeper.RegisterDevEarnContract(
 				suite.ctx,
 				contract,
 				epochs,
@@ -110,7 +118,7 @@ func (suite KeeperTestSuite) TestCancelIncentive() { //nolint:govet // we can co
 		{
 			"ok",
 			func() {
-				_, err := suite.app.DevearnKeeper.RegisterDevEarnInfo(
+				_, err := suite.app.DevearnKeeper.RegisterDevEarnContract(
 					suite.ctx,
 					contract,
 					epochs,
@@ -129,7 +137,7 @@ func (suite KeeperTestSuite) TestCancelIncentive() { //nolint:govet // we can co
 
 			tc.malleate()
 
-			err := suite.app.DevearnKeeper.CancelDevEarnInfo(suite.ctx, contract)
+			err := suite.app.DevearnKeeper.CancelDevEarnContract(suite.ctx, contract)
 			suite.Commit()
 
 			_, ok := suite.app.DevearnKeeper.GetDevEarnInfo(suite.ctx, contract)
@@ -146,3 +154,179 @@ func (suite KeeperTestSuite) TestCancelIncentive() { //nolint:govet // we can co
 }
 
 // TODO: Add asset add and remove tests
+
+func (suite *KeeperTestSuite) TestRegisterDevEarn() {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(denomMint, 1000))
+
+	testCases := []struct {
+		name     string
+		malleate func() *types.MsgRegisterDevEarn
+		expPass  bool
+	}{
+		{
+			"dev earn disabled",
+			func() *types.MsgRegisterDevEarn {
+				params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+				params.EnableDevEarn = false
+				suite.app.DevearnKeeper.SetParams(suite.ctx, params) //nolint:errcheck
+				return types.NewMsgRegisterDevEarn(contract, suite.deployer(), []uint64{0}, "", epochs)
+			},
+			false,
+		},
+		{
+			"wrong nonce",
+			func() *types.MsgRegisterDevEarn {
+				return types.NewMsgRegisterDevEarn(contract, suite.deployer(), []uint64{5}, "", epochs)
+			},
+			false,
+		},
+		{
+			"not the deployer",
+			func() *types.MsgRegisterDevEarn {
+				other := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+				return types.NewMsgRegisterDevEarn(contract, other, []uint64{0}, "", epochs)
+			},
+			false,
+		},
+		{
+			"blocked contract",
+			func() *types.MsgRegisterDevEarn {
+				suite.app.DevearnKeeper.SetBlocked(suite.ctx, contract)
+				return types.NewMsgRegisterDevEarn(contract, suite.deployer(), []uint64{0}, "", epochs)
+			},
+			false,
+		},
+		{
+			"blocked deployer",
+			func() *types.MsgRegisterDevEarn {
+				suite.app.DevearnKeeper.SetBlocked(suite.ctx, suite.address)
+				return types.NewMsgRegisterDevEarn(contract, suite.deployer(), []uint64{0}, "", epochs)
+			},
+			false,
+		},
+		{
+			"already registered",
+			func() *types.MsgRegisterDevEarn {
+				_, err := suite.app.DevearnKeeper.RegisterDevEarnContract(suite.ctx, contract, epochs, suite.address.Hex())
+				suite.Require().NoError(err)
+				return types.NewMsgRegisterDevEarn(contract, suite.deployer(), []uint64{0}, "", epochs)
+			},
+			false,
+		},
+		{
+			"ok - owner defaults to deployer",
+			func() *types.MsgRegisterDevEarn {
+				return types.NewMsgRegisterDevEarn(contract, suite.deployer(), []uint64{0}, "", epochs)
+			},
+			true,
+		},
+		{
+			"ok - with deposit",
+			func() *types.MsgRegisterDevEarn {
+				params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+				params.RegistrationDeposit = deposit
+				suite.app.DevearnKeeper.SetParams(suite.ctx, params) //nolint:errcheck
+				return types.NewMsgRegisterDevEarn(contract2, suite.deployer(), []uint64{1}, "", epochs)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.deployContracts()
+
+			msg := tc.malleate()
+			moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+			balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, denomMint)
+
+			_, err := suite.app.DevearnKeeper.RegisterDevEarn(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+
+				info, found := suite.app.DevearnKeeper.GetDevEarnInfo(suite.ctx, common.HexToAddress(msg.Contract))
+				suite.Require().True(found)
+				suite.Require().Equal(msg.DeployerAddress, info.DeployerAddress)
+				suite.Require().Equal(msg.Nonces, info.Nonces)
+				suite.Require().Equal(suite.address.Hex(), info.OwnerAddress)
+
+				params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+				suite.Require().Equal(params.RegistrationDeposit, info.Deposit)
+				balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, denomMint)
+				suite.Require().Equal(
+					balanceBefore.Amount.Add(params.RegistrationDeposit.AmountOf(denomMint)),
+					balanceAfter.Amount,
+				)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelDevEarn() {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(denomMint, 1000))
+
+	testCases := []struct {
+		name     string
+		malleate func() *types.MsgCancelDevEarn
+		expPass  bool
+	}{
+		{
+			"not registered",
+			func() *types.MsgCancelDevEarn {
+				return types.NewMsgCancelDevEarn(contract, suite.deployer())
+			},
+			false,
+		},
+		{
+			"registered through governance",
+			func() *types.MsgCancelDevEarn {
+				_, err := suite.app.DevearnKeeper.RegisterDevEarnContract(suite.ctx, contract, epochs, suite.address.Hex())
+				suite.Require().NoError(err)
+				return types.NewMsgCancelDevEarn(contract, suite.deployer())
+			},
+			false,
+		},
+		{
+			"ok - deposit refunded",
+			func() *types.MsgCancelDevEarn {
+				params := suite.app.DevearnKeeper.GetParams(suite.ctx)
+				params.RegistrationDeposit = deposit
+				suite.app.DevearnKeeper.SetParams(suite.ctx, params) //nolint:errcheck
+				_, err := suite.app.DevearnKeeper.RegisterDevEarn(
+					sdk.WrapSDKContext(suite.ctx),
+					types.NewMsgRegisterDevEarn(contract, suite.deployer(), []uint64{0}, "", epochs),
+				)
+				suite.Require().NoError(err)
+				return types.NewMsgCancelDevEarn(contract, suite.deployer())
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.deployContracts()
+
+			msg := tc.malleate()
+			balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.deployer(), denomMint)
+
+			_, err := suite.app.DevearnKeeper.CancelDevEarn(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().False(suite.app.DevearnKeeper.IsDevEarnInfoRegistered(suite.ctx, contract))
+
+				balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, suite.deployer(), denomMint)
+				suite.Require().Equal(balanceBefore.Amount.Add(deposit.AmountOf(denomMint)), balanceAfter.Amount)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
+
+// deployer returns the account that deployed the test contracts
+func (suite *KeeperTestSuite) deployer() sdk.AccAddress {
+	return sdk.AccAddress(suite.address.Bytes())
+}
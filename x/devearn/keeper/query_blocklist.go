@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"sidechain/x/devearn/types"
+)
+
+func (k Keeper) Blocklist(goCtx context.Context, req *types.QueryBlocklistRequest) (*types.QueryBlocklistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlocklist)
+	var addresses []string
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(key, _ []byte) error {
+			addresses = append(addresses, common.BytesToAddress(key).Hex())
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryBlocklistResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
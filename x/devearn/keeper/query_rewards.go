@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"sidechain/x/devearn/types"
+)
+
+func (k Keeper) PendingRewards(goCtx context.Context, req *types.QueryPendingRewardsRequest) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.OwnerAddress) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"owner address is empty",
+		)
+	}
+
+	// owners are registered with hex addresses but also accept bech32
+	var owner common.Address
+	if common.IsHexAddress(req.OwnerAddress) {
+		owner = common.HexToAddress(req.OwnerAddress)
+	} else {
+		accAddr, err := sdk.AccAddressFromBech32(req.OwnerAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				errortypes.ErrInvalidAddress, "address '%s' is not a valid hex or bech32 address",
+				req.OwnerAddress,
+			)
+		}
+		owner = common.BytesToAddress(accAddr)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rewards := k.GetOwnerAccruedRewards(ctx, owner)
+	return &types.QueryPendingRewardsResponse{Rewards: rewards, Total: totalRewards(rewards)}, nil
+}
+
+func (k Keeper) ContractPendingRewards(goCtx context.Context, req *types.QueryContractPendingRewardsRequest) (*types.QueryContractPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.Contract) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"contract address is empty",
+		)
+	}
+	if !common.IsHexAddress(req.Contract) {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidAddress, "address '%s' is not a valid ethereum hex address",
+			req.Contract,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rewards := k.GetContractAccruedRewards(ctx, common.HexToAddress(req.Contract))
+	return &types.QueryContractPendingRewardsResponse{Rewards: rewards, Total: totalRewards(rewards)}, nil
+}
+
+func (k Keeper) EpochRewards(goCtx context.Context, req *types.QueryEpochRewardsRequest) (*types.QueryEpochRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	record, found := k.GetEpochRewardRecord(ctx, req.Epoch)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"no reward record for epoch %d", req.Epoch,
+		)
+	}
+
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefixEpochContractRewards, types.GetEpochRewardsKey(req.Epoch)...),
+	)
+	var contracts []types.ContractRewardRecord
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(_, value []byte) error {
+			var contract types.ContractRewardRecord
+			if err := contract.Unmarshal(value); err != nil {
+				return err
+			}
+
+			contracts = append(contracts, contract)
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryEpochRewardsResponse{Record: record, Contracts: contracts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ContractRewardHistory(goCtx context.Context, req *types.QueryContractRewardHistoryRequest) (*types.QueryContractRewardHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.Contract) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"contract address is empty",
+		)
+	}
+	if !common.IsHexAddress(req.Contract) {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidAddress, "address '%s' is not a valid ethereum hex address",
+			req.Contract,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contract := common.HexToAddress(req.Contract)
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefixContractRewardHistory, contract.Bytes()...),
+	)
+	var records []types.ContractRewardRecord
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(key, _ []byte) error {
+			epoch := int64(sdk.BigEndianToUint64(key))
+			if record, found := k.GetContractRewardRecord(ctx, epoch, contract); found {
+				records = append(records, record)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryContractRewardHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	testkeeper "sidechain/testutil/keeper"
+	"sidechain/x/devearn/types"
+)
+
+func TestPendingRewardsQuery(t *testing.T) {
+	keeper, ctx := testkeeper.DevearnKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	owner2 := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	contractA := common.HexToAddress("0x2000000000000000000000000000000000000001")
+	contractB := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("aside", 100))
+
+	keeper.AccrueRewards(ctx, owner, contractA, rewards)
+	keeper.AccrueRewards(ctx, owner, contractB, rewards)
+	keeper.AccrueRewards(ctx, owner2, contractA, rewards)
+
+	for _, address := range []string{owner.Hex(), sdk.AccAddress(owner.Bytes()).String()} {
+		response, err := keeper.PendingRewards(wctx, &types.QueryPendingRewardsRequest{OwnerAddress: address})
+		require.NoError(t, err)
+		require.Len(t, response.Rewards, 2)
+		require.Equal(t, rewards.Add(rewards...), response.Total)
+	}
+
+	response, err := keeper.ContractPendingRewards(wctx, &types.QueryContractPendingRewardsRequest{Contract: contractA.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []types.AccruedRewards{
+		{OwnerAddress: owner.Hex(), Contract: contractA.Hex(), Rewards: rewards},
+		{OwnerAddress: owner2.Hex(), Contract: contractA.Hex(), Rewards: rewards},
+	}, response.Rewards)
+	require.Equal(t, rewards.Add(rewards...), response.Total)
+
+	_, err = keeper.PendingRewards(wctx, &types.QueryPendingRewardsRequest{OwnerAddress: "invalid"})
+	require.Error(t, err)
+	_, err = keeper.ContractPendingRewards(wctx, &types.QueryContractPendingRewardsRequest{Contract: "invalid"})
+	require.Error(t, err)
+}
+
+func TestRewardHistoryQuery(t *testing.T) {
+	keeper, ctx := testkeeper.DevearnKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	params := keeper.GetParams(ctx)
+	params.RewardHistoryRetention = 2
+	keeper.SetParams(ctx, params)
+
+	contractA := common.HexToAddress("0x2000000000000000000000000000000000000001")
+	contractB := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("aside", 100))
+
+	for epoch := int64(1); epoch <= 3; epoch++ {
+		records := []types.ContractRewardRecord{
+			{Epoch: epoch, Contract: contractA.Hex(), GasUsed: 100, GasShare: sdk.NewDecWithPrec(25, 2), TvlRatio: sdk.ZeroDec(), Rewards: rewards},
+			{Epoch: epoch, Contract: contractB.Hex(), GasUsed: 300, GasShare: sdk.NewDecWithPrec(75, 2), TvlRatio: sdk.ZeroDec(), Rewards: rewards},
+		}
+		for _, record := range records {
+			keeper.SetContractRewardRecord(ctx, record)
+		}
+		keeper.SetEpochRewardRecord(ctx, types.EpochRewardRecord{Epoch: epoch, TotalGas: 400, Rewards: rewards.Add(rewards...)})
+		keeper.PruneRewardHistory(ctx, epoch)
+	}
+
+	// the first epoch is pruned
+	_, err := keeper.EpochRewards(wctx, &types.QueryEpochRewardsRequest{Epoch: 1})
+	require.Error(t, err)
+
+	response, err := keeper.EpochRewards(wctx, &types.QueryEpochRewardsRequest{
+		Epoch:      3,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(400), response.Record.TotalGas)
+	require.Equal(t, rewards.Add(rewards...), response.Record.Rewards)
+	require.Len(t, response.Contracts, 1)
+	require.Equal(t, contractA.Hex(), response.Contracts[0].Contract)
+	require.Equal(t, uint64(2), response.Pagination.Total)
+
+	history, err := keeper.ContractRewardHistory(wctx, &types.QueryContractRewardHistoryRequest{Contract: contractB.Hex()})
+	require.NoError(t, err)
+	require.Len(t, history.Records, 2)
+	require.Equal(t, int64(2), history.Records[0].Epoch)
+	require.Equal(t, int64(3), history.Records[1].Epoch)
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), history.Records[1].GasShare)
+
+	_, err = keeper.ContractRewardHistory(wctx, &types.QueryContractRewardHistoryRequest{Contract: "invalid"})
+	require.Error(t, err)
+}
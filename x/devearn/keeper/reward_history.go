@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"sidechain/x/devearn/types"
+)
+
+// GetEpochRewardRecord returns the reward record of an epoch
+func (k Keeper) GetEpochRewardRecord(ctx sdk.Context, epochNumber int64) (types.EpochRewardRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochRewards)
+	bz := store.Get(types.GetEpochRewardsKey(epochNumber))
+	if len(bz) == 0 {
+		return types.EpochRewardRecord{}, false
+	}
+
+	var record types.EpochRewardRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetEpochRewardRecord stores the reward record of an epoch
+func (k Keeper) SetEpochRewardRecord(ctx sdk.Context, record types.EpochRewardRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochRewards)
+	store.Set(types.GetEpochRewardsKey(record.Epoch), k.cdc.MustMarshal(&record))
+}
+
+// GetAllEpochRewardRecords returns the reward records of all the retained
+// epochs
+func (k Keeper) GetAllEpochRewardRecords(ctx sdk.Context) []types.EpochRewardRecord {
+	records := []types.EpochRewardRecord{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixEpochRewards)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.EpochRewardRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetContractRewardRecord returns the rewards earned by a contract in an epoch
+func (k Keeper) GetContractRewardRecord(
+	ctx sdk.Context,
+	epochNumber int64,
+	contract common.Address,
+) (types.ContractRewardRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochContractRewards)
+	bz := store.Get(types.GetEpochContractRewardsKey(epochNumber, contract))
+	if len(bz) == 0 {
+		return types.ContractRewardRecord{}, false
+	}
+
+	var record types.ContractRewardRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetContractRewardRecord stores the rewards earned by a contract in an epoch
+// and indexes them by contract
+func (k Keeper) SetContractRewardRecord(ctx sdk.Context, record types.ContractRewardRecord) {
+	contract := common.HexToAddress(record.Contract)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochContractRewards)
+	store.Set(types.GetEpochContractRewardsKey(record.Epoch, contract), k.cdc.MustMarshal(&record))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractRewardHistory)
+	indexStore.Set(types.GetContractRewardHistoryKey(contract, record.Epoch), []byte{1})
+}
+
+// GetAllContractRewardRecords returns the rewards earned by every contract in
+// all the retained epochs
+func (k Keeper) GetAllContractRewardRecords(ctx sdk.Context) []types.ContractRewardRecord {
+	records := []types.ContractRewardRecord{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixEpochContractRewards)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ContractRewardRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// DeleteEpochRewards removes the reward record of an epoch together with the
+// rewards earned by each contract
+func (k Keeper) DeleteEpochRewards(ctx sdk.Context, epochNumber int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochContractRewards)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractRewardHistory)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetEpochRewardsKey(epochNumber))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		contract := common.BytesToAddress(key[8:])
+		store.Delete(key)
+		indexStore.Delete(types.GetContractRewardHistoryKey(contract, epochNumber))
+	}
+
+	epochStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochRewards)
+	epochStore.Delete(types.GetEpochRewardsKey(epochNumber))
+}
+
+// PruneRewardHistory removes the reward records of the epochs that are older
+// than the reward history retention
+func (k Keeper) PruneRewardHistory(ctx sdk.Context, epochNumber int64) {
+	retention := k.GetParams(ctx).RewardHistoryRetention
+	// records of the last `retention` epochs, including the current one, are kept
+	oldest := epochNumber - int64(retention) + 1
+	if retention == 0 {
+		oldest = epochNumber + 1
+	}
+	if oldest <= 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochRewards)
+	iterator := store.Iterator(nil, types.GetEpochRewardsKey(oldest))
+	var epochs []int64
+	for ; iterator.Valid(); iterator.Next() {
+		epochs = append(epochs, int64(sdk.BigEndianToUint64(iterator.Key())))
+	}
+	iterator.Close()
+
+	for _, epoch := range epochs {
+		k.DeleteEpochRewards(ctx, epoch)
+	}
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"sidechain/x/devearn/types"
+)
+
+// GetAccruedRewards returns the unclaimed rewards accrued to an owner by a
+// contract
+func (k Keeper) GetAccruedRewards(
+	ctx sdk.Context,
+	owner, contract common.Address,
+) (types.AccruedRewards, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccruedRewards)
+	bz := store.Get(types.GetAccruedRewardsKey(owner, contract))
+	if len(bz) == 0 {
+		return types.AccruedRewards{}, false
+	}
+
+	var accrued types.AccruedRewards
+	k.cdc.MustUnmarshal(bz, &accrued)
+	return accrued, true
+}
+
+// SetAccruedRewards stores the unclaimed rewards of an owner and indexes them
+// by contract. The entry is removed once the rewards are empty.
+func (k Keeper) SetAccruedRewards(ctx sdk.Context, accrued types.AccruedRewards) {
+	owner := common.HexToAddress(accrued.OwnerAddress)
+	contract := common.HexToAddress(accrued.Contract)
+	if accrued.Rewards.IsZero() {
+		k.DeleteAccruedRewards(ctx, owner, contract)
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccruedRewards)
+	store.Set(types.GetAccruedRewardsKey(owner, contract), k.cdc.MustMarshal(&accrued))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractAccruedRewards)
+	indexStore.Set(types.GetContractAccruedRewardsKey(contract, owner), []byte{1})
+}
+
+// DeleteAccruedRewards removes the unclaimed rewards of an owner and its index
+func (k Keeper) DeleteAccruedRewards(ctx sdk.Context, owner, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccruedRewards)
+	store.Delete(types.GetAccruedRewardsKey(owner, contract))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractAccruedRewards)
+	indexStore.Delete(types.GetContractAccruedRewardsKey(contract, owner))
+}
+
+// AccrueRewards adds the rewards earned by a contract to the unclaimed rewards
+// of its owner
+func (k Keeper) AccrueRewards(ctx sdk.Context, owner, contract common.Address, rewards sdk.Coins) {
+	accrued, found := k.GetAccruedRewards(ctx, owner, contract)
+	if !found {
+		accrued = types.AccruedRewards{
+			OwnerAddress: owner.Hex(),
+			Contract:     contract.Hex(),
+		}
+	}
+	accrued.Rewards = accrued.Rewards.Add(rewards...)
+	k.SetAccruedRewards(ctx, accrued)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAccrueRewards,
+			sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.Hex()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+		),
+	)
+}
+
+// GetOwnerAccruedRewards returns the unclaimed rewards of an owner per contract
+func (k Keeper) GetOwnerAccruedRewards(ctx sdk.Context, owner common.Address) []types.AccruedRewards {
+	accrued := []types.AccruedRewards{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccruedRewards)
+	iterator := sdk.KVStorePrefixIterator(store, owner.Bytes())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rewards types.AccruedRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+		accrued = append(accrued, rewards)
+	}
+
+	return accrued
+}
+
+// GetContractAccruedRewards returns the unclaimed rewards earned by a contract
+// per owner
+func (k Keeper) GetContractAccruedRewards(ctx sdk.Context, contract common.Address) []types.AccruedRewards {
+	accrued := []types.AccruedRewards{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractAccruedRewards)
+	iterator := sdk.KVStorePrefixIterator(store, contract.Bytes())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		owner := common.BytesToAddress(iterator.Key()[common.AddressLength:])
+		if rewards, found := k.GetAccruedRewards(ctx, owner, contract); found {
+			accrued = append(accrued, rewards)
+		}
+	}
+
+	return accrued
+}
+
+// GetAllAccruedRewards returns all the unclaimed rewards
+func (k Keeper) GetAllAccruedRewards(ctx sdk.Context) []types.AccruedRewards {
+	accrued := []types.AccruedRewards{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixAccruedRewards)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rewards types.AccruedRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+		accrued = append(accrued, rewards)
+	}
+
+	return accrued
+}
+
+// GetTotalAccruedRewards returns the sum of all the unclaimed rewards, which
+// are held in the module account until they are claimed
+func (k Keeper) GetTotalAccruedRewards(ctx sdk.Context) sdk.Coins {
+	return totalRewards(k.GetAllAccruedRewards(ctx))
+}
+
+// ClaimRewards transfers the unclaimed rewards of an owner to the recipient.
+// Only the rewards earned by the given contract are claimed if it's not nil.
+func (k Keeper) ClaimRewards(
+	ctx sdk.Context,
+	owner common.Address,
+	contract *common.Address,
+	recipient sdk.AccAddress,
+) (sdk.Coins, error) {
+	var accrued []types.AccruedRewards
+	if contract != nil {
+		if rewards, found := k.GetAccruedRewards(ctx, owner, *contract); found {
+			accrued = append(accrued, rewards)
+		}
+	} else {
+		accrued = k.GetOwnerAccruedRewards(ctx, owner)
+	}
+
+	claimed := totalRewards(accrued)
+	if claimed.IsZero() {
+		return nil, types.ErrNoRewards
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, claimed); err != nil {
+		return nil, err
+	}
+
+	for _, rewards := range accrued {
+		k.DeleteAccruedRewards(ctx, owner, common.HexToAddress(rewards.Contract))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimRewards,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.Hex()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claimed.String()),
+		),
+	)
+
+	return claimed, nil
+}
+
+// totalRewards sums up the given unclaimed rewards
+func totalRewards(accrued []types.AccruedRewards) sdk.Coins {
+	total := sdk.Coins{}
+	for _, rewards := range accrued {
+		total = total.Add(rewards.Rewards...)
+	}
+	return total
+}
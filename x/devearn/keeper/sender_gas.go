@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"sidechain/x/devearn/types"
+)
+
+// GetSenderGas returns the gas a sender credited to a contract during an epoch
+func (k Keeper) GetSenderGas(ctx sdk.Context, epoch int64, contract, sender common.Address) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSenderGas)
+	bz := store.Get(types.GetSenderGasKey(epoch, contract, sender))
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetSenderGas stores the gas a sender credited to a contract during an epoch
+func (k Keeper) SetSenderGas(ctx sdk.Context, epoch int64, contract, sender common.Address, gas uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSenderGas)
+	store.Set(types.GetSenderGasKey(epoch, contract, sender), sdk.Uint64ToBigEndian(gas))
+}
+
+// SenderGasPrunesPerBlock is the maximum number of sender gas counters pruned
+// in a block
+const SenderGasPrunesPerBlock = 1000
+
+// PruneSenderGas removes up to limit sender gas counters of the epochs before
+// the given one. It runs every block, so the counters of an ended epoch are
+// removed over the following blocks rather than all at once.
+func (k Keeper) PruneSenderGas(ctx sdk.Context, epoch int64, limit uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSenderGas)
+	iterator := store.Iterator(nil, types.GetSenderGasEpochKey(epoch))
+	var keys [][]byte
+	for ; iterator.Valid() && uint64(len(keys)) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// CurrentRewardEpoch returns the number of the running reward epoch, which is
+// the epoch the gas used by the transactions is distributed at the end of
+func (k Keeper) CurrentRewardEpoch(ctx sdk.Context, params types.Params) int64 {
+	epochInfo, found := k.epochsKeeper.GetEpochInfo(ctx, params.RewardEpochIdentifier)
+	if !found {
+		return 0
+	}
+	return epochInfo.CurrentEpoch
+}
+
+// capSenderGas returns the part of the gas used by a sender that can still be
+// credited to the contract under the MaxSenderGasPerEpoch cap, and counts it
+// against the sender's allowance of the running epoch
+func (k Keeper) capSenderGas(
+	ctx sdk.Context,
+	params types.Params,
+	contract, sender common.Address,
+	gasUsed uint64,
+) uint64 {
+	if params.MaxSenderGasPerEpoch == 0 {
+		return gasUsed
+	}
+
+	epoch := k.CurrentRewardEpoch(ctx, params)
+	credited := k.GetSenderGas(ctx, epoch, contract, sender)
+	if credited >= params.MaxSenderGasPerEpoch {
+		return 0
+	}
+
+	if allowance := params.MaxSenderGasPerEpoch - credited; gasUsed > allowance {
+		gasUsed = allowance
+	}
+	k.SetSenderGas(ctx, epoch, contract, sender, credited+gasUsed)
+
+	return gasUsed
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "sidechain/testutil/keeper"
+	utiltx "sidechain/testutil/tx"
+)
+
+func TestPruneSenderGas(t *testing.T) {
+	k, ctx := testkeeper.DevearnKeeper(t)
+	contract := utiltx.GenerateAddress()
+	senders := []common.Address{utiltx.GenerateAddress(), utiltx.GenerateAddress()}
+
+	for epoch := int64(1); epoch <= 3; epoch++ {
+		for _, sender := range senders {
+			k.SetSenderGas(ctx, epoch, contract, sender, uint64(epoch*100))
+		}
+	}
+
+	// the counters of the ended epochs are pruned a page at a time
+	k.PruneSenderGas(ctx, 3, 3)
+
+	require.Zero(t, k.GetSenderGas(ctx, 1, contract, senders[0]))
+	require.Zero(t, k.GetSenderGas(ctx, 1, contract, senders[1]))
+	pruned := 0
+	for _, sender := range senders {
+		if k.GetSenderGas(ctx, 2, contract, sender) == 0 {
+			pruned++
+		}
+	}
+	require.Equal(t, 1, pruned)
+
+	k.PruneSenderGas(ctx, 3, 3)
+
+	for _, sender := range senders {
+		require.Zero(t, k.GetSenderGas(ctx, 2, contract, sender))
+		require.Equal(t, uint64(300), k.GetSenderGas(ctx, 3, contract, sender))
+	}
+}
@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"sidechain/x/devearn/types"
+)
+
+// SampleTvl samples the TVL held in the registered contracts. A sampling round
+// starts every TvlSampleInterval blocks by sampling the total TVL, then samples
+// the contracts in store order, up to MaxDistributionsPerBlock per block. The
+// remaining contracts are sampled in the following blocks, and no round starts
+// before the previous one is done. Every sample is taken at the time of the
+// block sampling it, so it's weighted by the time actually elapsed since the
+// previous sample of the same accumulator.
+//
+// Nothing is sampled while a reward distribution is being settled, as the
+// samples of the settled contracts already belong to the next epoch.
+func (k Keeper) SampleTvl(ctx sdk.Context) {
+	if _, found := k.GetDistributionProgress(ctx); found {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	cursor, running := k.GetTvlSampleCursor(ctx)
+	if !running && (params.TvlSampleInterval == 0 || uint64(ctx.BlockHeight())%params.TvlSampleInterval != 0) {
+		return
+	}
+
+	assets, skipped := k.tvlAssets(ctx)
+	if !running {
+		k.emitSkippedTvlAssets(ctx, skipped)
+		k.sampleTvlAccumulator(ctx, types.KeyPrefixTotalTvlAccumulator, totalTvl(assets))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDevEarn)
+	iterator := store.Iterator(cursor, nil)
+	var contracts []common.Address
+	cursor = nil
+	for ; iterator.Valid(); iterator.Next() {
+		if params.MaxDistributionsPerBlock > 0 && uint64(len(contracts)) == params.MaxDistributionsPerBlock {
+			cursor = append([]byte{}, iterator.Key()...)
+			break
+		}
+		contracts = append(contracts, common.BytesToAddress(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, contract := range contracts {
+		contractTvl := k.contractTvl(ctx, assets, contract.Hex())
+		k.sampleTvlAccumulator(ctx, types.GetTvlAccumulatorKey(contract), contractTvl)
+	}
+
+	if cursor == nil {
+		k.DeleteTvlSampleCursor(ctx)
+		return
+	}
+	k.SetTvlSampleCursor(ctx, cursor)
+}
+
+// GetTvlSampleCursor returns the key of the next contract to sample if a
+// sampling round is running
+func (k Keeper) GetTvlSampleCursor(ctx sdk.Context) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get(types.KeyPrefixTvlSampleCursor)
+	return cursor, len(cursor) > 0
+}
+
+// SetTvlSampleCursor stores the key of the next contract to sample
+func (k Keeper) SetTvlSampleCursor(ctx sdk.Context, cursor []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixTvlSampleCursor, cursor)
+}
+
+// DeleteTvlSampleCursor ends the sampling round
+func (k Keeper) DeleteTvlSampleCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPrefixTvlSampleCursor)
+}
+
+// sampleTvlAccumulator adds a sample to the accumulator stored at key
+func (k Keeper) sampleTvlAccumulator(ctx sdk.Context, key []byte, tvl sdk.Dec) {
+	accumulator, found := k.getTvlAccumulator(ctx, key)
+	if !found {
+		accumulator = types.NewTvlAccumulator(tvl, ctx.BlockTime())
+	} else {
+		accumulator.Sample(tvl, ctx.BlockTime())
+	}
+	k.setTvlAccumulator(ctx, key, accumulator)
+}
+
+// GetTotalTvlAccumulator returns the samples of the total TVL
+func (k Keeper) GetTotalTvlAccumulator(ctx sdk.Context) (types.TvlAccumulator, bool) {
+	return k.getTvlAccumulator(ctx, types.KeyPrefixTotalTvlAccumulator)
+}
+
+// SetTotalTvlAccumulator stores the samples of the total TVL
+func (k Keeper) SetTotalTvlAccumulator(ctx sdk.Context, accumulator types.TvlAccumulator) {
+	k.setTvlAccumulator(ctx, types.KeyPrefixTotalTvlAccumulator, accumulator)
+}
+
+// GetContractTvlAccumulator returns the samples of the TVL held in a contract
+func (k Keeper) GetContractTvlAccumulator(ctx sdk.Context, contract common.Address) (types.TvlAccumulator, bool) {
+	return k.getTvlAccumulator(ctx, types.GetTvlAccumulatorKey(contract))
+}
+
+// SetContractTvlAccumulator stores the samples of the TVL held in a contract
+func (k Keeper) SetContractTvlAccumulator(ctx sdk.Context, contract common.Address, accumulator types.TvlAccumulator) {
+	k.setTvlAccumulator(ctx, types.GetTvlAccumulatorKey(contract), accumulator)
+}
+
+// GetAllContractTvlAccumulators returns the TVL samples of all the contracts
+func (k Keeper) GetAllContractTvlAccumulators(ctx sdk.Context) []types.ContractTvlAccumulator {
+	accumulators := []types.ContractTvlAccumulator{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTvlAccumulator)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var accumulator types.TvlAccumulator
+		k.cdc.MustUnmarshal(iterator.Value(), &accumulator)
+		accumulators = append(accumulators, types.ContractTvlAccumulator{
+			Contract:    common.BytesToAddress(iterator.Key()).Hex(),
+			Accumulator: accumulator,
+		})
+	}
+
+	return accumulators
+}
+
+// DeleteContractTvlAccumulator removes the samples of the TVL held in a contract
+func (k Keeper) DeleteContractTvlAccumulator(ctx sdk.Context, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTvlAccumulatorKey(contract))
+}
+
+func (k Keeper) getTvlAccumulator(ctx sdk.Context, key []byte) (types.TvlAccumulator, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return types.TvlAccumulator{}, false
+	}
+
+	var accumulator types.TvlAccumulator
+	k.cdc.MustUnmarshal(bz, &accumulator)
+	return accumulator, true
+}
+
+func (k Keeper) setTvlAccumulator(ctx sdk.Context, key []byte, accumulator types.TvlAccumulator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshal(&accumulator))
+}
+
+// epochTvlRatio returns the share of the total TVL held in a contract during
+// the epoch being distributed. The time-weighted averages of the samples are
+// used if the TVL was sampled, and the TVL at the end of the epoch otherwise.
+func (k Keeper) epochTvlRatio(ctx sdk.Context, progress types.DistributionProgress, contract string) sdk.Dec {
+	if progress.TotalTvl.IsNil() || !progress.TotalTvl.IsPositive() {
+		return k.TvlReward(ctx, contract)
+	}
+
+	accumulator, found := k.GetContractTvlAccumulator(ctx, common.HexToAddress(contract))
+	if !found {
+		// the contract was registered after the last sample
+		return sdk.ZeroDec()
+	}
+	return accumulator.Average(progress.Time).Quo(progress.TotalTvl)
+}
+
+// averageTotalTvl returns the time-weighted average of the total TVL during the
+// epoch and clears its samples. It returns zero if the TVL isn't sampled.
+func (k Keeper) averageTotalTvl(ctx sdk.Context, params types.Params) sdk.Dec {
+	if params.TvlSampleInterval == 0 {
+		return sdk.ZeroDec()
+	}
+
+	accumulator, found := k.GetTotalTvlAccumulator(ctx)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	average := accumulator.Average(ctx.BlockTime())
+	accumulator.StartEpoch(ctx.BlockTime())
+	k.SetTotalTvlAccumulator(ctx, accumulator)
+	return average
+}
+
+// resetContractTvlAccumulator clears the samples of a settled contract
+func (k Keeper) resetContractTvlAccumulator(ctx sdk.Context, contract common.Address, endTime time.Time) {
+	accumulator, found := k.GetContractTvlAccumulator(ctx, contract)
+	if !found {
+		return
+	}
+	accumulator.StartEpoch(endTime)
+	k.SetContractTvlAccumulator(ctx, contract, accumulator)
+}
@@ -182,6 +182,15 @@ func (suite *KeeperTestSuite) MintFeeCollector(coins sdk.Coins) {
 	suite.Require().NoError(err)
 }
 
+// pendingRewards returns the unclaimed rewards accrued to the owner
+func (suite *KeeperTestSuite) pendingRewards(owner sdk.AccAddress) sdk.Coins {
+	total := sdk.Coins{}
+	for _, accrued := range suite.app.DevearnKeeper.GetOwnerAccruedRewards(suite.ctx, common.BytesToAddress(owner)) {
+		total = total.Add(accrued.Rewards...)
+	}
+	return total
+}
+
 // DeployContract deploys the ERC20MinterBurnerDecimalsContract.
 // func (suite *KeeperTestSuite) DeployContract(name, symbol string, decimals uint8) (common.Address, error) {
 // 	suite.Commit()
@@ -12,6 +12,12 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// NewDevEarnProposalHandler creates a governance handler to manage the legacy
+// devearn proposals.
+//
+// Deprecated: the legacy proposals are kept for compatibility. Submit the
+// MsgRegisterDevEarnInfo, MsgCancelDevEarnInfo, MsgAddAsset and MsgRemoveAsset
+// messages in a gov v1 proposal instead.
 func NewDevEarnProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
 	return func(ctx sdk.Context, content govv1beta1.Content) error {
 		switch c := content.(type) {
@@ -33,7 +39,7 @@ func NewDevEarnProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
 }
 
 func handleRegisterDevEarnProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RegisterDevEarnInfoProposal) error {
-	in, err := k.RegisterDevEarnInfo(ctx, common.HexToAddress(p.Contract), p.Epochs, p.OwnerAddress)
+	in, err := k.RegisterDevEarnContract(ctx, common.HexToAddress(p.Contract), p.Epochs, p.OwnerAddress)
 	if err != nil {
 		return err
 	}
@@ -51,7 +57,7 @@ func handleRegisterDevEarnProposal(ctx sdk.Context, k *keeper.Keeper, p *types.R
 }
 
 func handleCancelDevEarnProposal(ctx sdk.Context, k *keeper.Keeper, p *types.CancelDevEarnInfoProposal) error {
-	err := k.CancelDevEarnInfo(ctx, common.HexToAddress(p.Contract))
+	err := k.CancelDevEarnContract(ctx, common.HexToAddress(p.Contract))
 	if err != nil {
 		return err
 	}
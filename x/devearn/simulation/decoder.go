@@ -0,0 +1,79 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"sidechain/x/devearn/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding devearn type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.AssetsKey)):
+			var assetsA, assetsB types.Assets
+			cdc.MustUnmarshal(kvA.Value, &assetsA)
+			cdc.MustUnmarshal(kvB.Value, &assetsB)
+			return fmt.Sprintf("%v\n%v", assetsA, assetsB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixDevEarn):
+			var infoA, infoB types.DevEarnInfo
+			cdc.MustUnmarshal(kvA.Value, &infoA)
+			cdc.MustUnmarshal(kvB.Value, &infoB)
+			return fmt.Sprintf("%v\n%v", infoA, infoB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixAccruedRewards):
+			var accruedA, accruedB types.AccruedRewards
+			cdc.MustUnmarshal(kvA.Value, &accruedA)
+			cdc.MustUnmarshal(kvB.Value, &accruedB)
+			return fmt.Sprintf("%v\n%v", accruedA, accruedB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixContractAccruedRewards),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixContractRewardHistory):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixEpochRewards):
+			var recordA, recordB types.EpochRewardRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixEpochContractRewards):
+			var recordA, recordB types.ContractRewardRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixDistributionProgress):
+			var progressA, progressB types.DistributionProgress
+			cdc.MustUnmarshal(kvA.Value, &progressA)
+			cdc.MustUnmarshal(kvB.Value, &progressB)
+			return fmt.Sprintf("%v\n%v", progressA, progressB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixPendingGasMeter):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixTvlAccumulator),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixTotalTvlAccumulator):
+			var accumulatorA, accumulatorB types.TvlAccumulator
+			cdc.MustUnmarshal(kvA.Value, &accumulatorA)
+			cdc.MustUnmarshal(kvB.Value, &accumulatorB)
+			return fmt.Sprintf("%v\n%v", accumulatorA, accumulatorB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixAssetPrice):
+			var priceA, priceB types.AssetPrice
+			cdc.MustUnmarshal(kvA.Value, &priceA)
+			cdc.MustUnmarshal(kvB.Value, &priceB)
+			return fmt.Sprintf("%v\n%v", priceA, priceB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixBlocklist),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixTvlSampleCursor):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixSenderGas):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		default:
+			panic(fmt.Sprintf("invalid devearn key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"sidechain/x/devearn/simulation"
+	"sidechain/x/devearn/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	owner := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract := common.HexToAddress("0x2000000000000000000000000000000000000001")
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("aside", 100))
+	now := time.Now().UTC()
+
+	params := types.DefaultParams()
+	assets := types.Assets{Denom: "aside"}
+	devEarnInfo := types.NewDevEarn(contract, 100, 10, owner.Hex())
+	accrued := types.AccruedRewards{OwnerAddress: owner.Hex(), Contract: contract.Hex(), Rewards: rewards}
+	epochRecord := types.EpochRewardRecord{Epoch: 1, Time: now, TotalGas: 100, Rewards: rewards}
+	contractRecord := types.ContractRewardRecord{
+		Epoch: 1, Contract: contract.Hex(), GasUsed: 100, GasShare: sdk.OneDec(), TvlRatio: sdk.ZeroDec(), Rewards: rewards,
+	}
+	progress := types.DistributionProgress{Epoch: 1, Time: now, TotalGas: 100, RewardPool: rewards}
+	accumulator := types.NewTvlAccumulator(sdk.NewDec(10), now)
+	price := types.AssetPrice{Denom: "aside", Price: sdk.OneDec(), Time: now}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: append(types.KeyPrefix(types.AssetsKey), []byte("aside")...), Value: cdc.MustMarshal(&assets)},
+			{Key: append(types.KeyPrefixDevEarn, contract.Bytes()...), Value: cdc.MustMarshal(&devEarnInfo)},
+			{Key: append(types.KeyPrefixAccruedRewards, types.GetAccruedRewardsKey(owner, contract)...), Value: cdc.MustMarshal(&accrued)},
+			{Key: append(types.KeyPrefixEpochRewards, types.GetEpochRewardsKey(1)...), Value: cdc.MustMarshal(&epochRecord)},
+			{Key: append(types.KeyPrefixEpochContractRewards, types.GetEpochContractRewardsKey(1, contract)...), Value: cdc.MustMarshal(&contractRecord)},
+			{Key: types.KeyPrefixDistributionProgress, Value: cdc.MustMarshal(&progress)},
+			{Key: append(types.KeyPrefixPendingGasMeter, contract.Bytes()...), Value: sdk.Uint64ToBigEndian(100)},
+			{Key: types.GetTvlAccumulatorKey(contract), Value: cdc.MustMarshal(&accumulator)},
+			{Key: append(types.KeyPrefixAssetPrice, []byte("aside")...), Value: cdc.MustMarshal(&price)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"Assets", fmt.Sprintf("%v\n%v", assets, assets)},
+		{"DevEarnInfo", fmt.Sprintf("%v\n%v", devEarnInfo, devEarnInfo)},
+		{"AccruedRewards", fmt.Sprintf("%v\n%v", accrued, accrued)},
+		{"EpochRewardRecord", fmt.Sprintf("%v\n%v", epochRecord, epochRecord)},
+		{"ContractRewardRecord", fmt.Sprintf("%v\n%v", contractRecord, contractRecord)},
+		{"DistributionProgress", fmt.Sprintf("%v\n%v", progress, progress)},
+		{"PendingGasMeter", "100\n100"},
+		{"TvlAccumulator", fmt.Sprintf("%v\n%v", accumulator, accumulator)},
+		{"AssetPrice", fmt.Sprintf("%v\n%v", price, price)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"sidechain/x/devearn/types"
+	epochstypes "sidechain/x/epochs/types"
+)
+
+// Simulation parameter constants
+const (
+	enableDevEarnKey            = "enable_dev_earn"
+	rewardEpochIdentifierKey    = "reward_epoch_identifier"
+	devEarnInflationAPRKey      = "dev_earn_inflation_apr"
+	tvlShareKey                 = "tvl_share"
+	gasAttributionKey           = "gas_attribution"
+	feeShareKey                 = "fee_share"
+	rewardHistoryRetentionKey   = "reward_history_retention"
+	maxDistributionsPerBlockKey = "max_distributions_per_block"
+	tvlSampleIntervalKey        = "tvl_sample_interval"
+	maxPriceStalenessKey        = "max_price_staleness"
+	maxSenderGasPerEpochKey     = "max_sender_gas_per_epoch"
+	successfulTxsOnlyKey        = "successful_txs_only"
+	minGasPriceKey              = "min_gas_price"
+)
+
+// GenEnableDevEarn randomized EnableDevEarn
+func GenEnableDevEarn(r *rand.Rand) bool {
+	return r.Intn(10) > 0
+}
+
+// GenRewardEpochIdentifier randomized RewardEpochIdentifier
+func GenRewardEpochIdentifier(r *rand.Rand) string {
+	identifiers := []string{epochstypes.WeekEpochID, epochstypes.DayEpochID}
+	return identifiers[r.Intn(len(identifiers))]
+}
+
+// GenDevEarnInflationAPR randomized DevEarnInflation_APR
+func GenDevEarnInflationAPR(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(20)), 2)
+}
+
+// GenTvlShare randomized TvlShare
+func GenTvlShare(r *rand.Rand) uint64 {
+	return uint64(r.Intn(5000))
+}
+
+// GenGasAttribution randomized GasAttribution
+func GenGasAttribution(r *rand.Rand) types.GasAttribution {
+	return types.GasAttribution(r.Intn(len(types.GasAttribution_name)))
+}
+
+// GenFeeShare randomized FeeShare
+func GenFeeShare(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(100)), 2)
+}
+
+// GenRewardHistoryRetention randomized RewardHistoryRetention
+func GenRewardHistoryRetention(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
+// GenMaxDistributionsPerBlock randomized MaxDistributionsPerBlock
+func GenMaxDistributionsPerBlock(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
+// GenTvlSampleInterval randomized TvlSampleInterval
+func GenTvlSampleInterval(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
+// GenMaxPriceStaleness randomized MaxPriceStaleness
+func GenMaxPriceStaleness(r *rand.Rand) time.Duration {
+	return time.Duration(r.Intn(48)) * time.Hour
+}
+
+// GenMaxSenderGasPerEpoch randomized MaxSenderGasPerEpoch
+func GenMaxSenderGasPerEpoch(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return uint64(simtypes.RandIntBetween(r, 21000, 10_000_000))
+}
+
+// GenSuccessfulTxsOnly randomized SuccessfulTxsOnly
+func GenSuccessfulTxsOnly(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenMinGasPrice randomized MinGasPrice
+func GenMinGasPrice(r *rand.Rand) sdk.Int {
+	return sdk.NewInt(int64(r.Intn(1_000_000_000)))
+}
+
+// RandomizedGenState generates a random GenesisState for devearn
+func RandomizedGenState(simState *module.SimulationState) {
+	var enableDevEarn bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, enableDevEarnKey, &enableDevEarn, simState.Rand,
+		func(r *rand.Rand) { enableDevEarn = GenEnableDevEarn(r) },
+	)
+
+	var rewardEpochIdentifier string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rewardEpochIdentifierKey, &rewardEpochIdentifier, simState.Rand,
+		func(r *rand.Rand) { rewardEpochIdentifier = GenRewardEpochIdentifier(r) },
+	)
+
+	var devEarnInflationAPR sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, devEarnInflationAPRKey, &devEarnInflationAPR, simState.Rand,
+		func(r *rand.Rand) { devEarnInflationAPR = GenDevEarnInflationAPR(r) },
+	)
+
+	var tvlShare uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, tvlShareKey, &tvlShare, simState.Rand,
+		func(r *rand.Rand) { tvlShare = GenTvlShare(r) },
+	)
+
+	var gasAttribution types.GasAttribution
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, gasAttributionKey, &gasAttribution, simState.Rand,
+		func(r *rand.Rand) { gasAttribution = GenGasAttribution(r) },
+	)
+
+	var feeShare sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, feeShareKey, &feeShare, simState.Rand,
+		func(r *rand.Rand) { feeShare = GenFeeShare(r) },
+	)
+
+	var rewardHistoryRetention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rewardHistoryRetentionKey, &rewardHistoryRetention, simState.Rand,
+		func(r *rand.Rand) { rewardHistoryRetention = GenRewardHistoryRetention(r) },
+	)
+
+	var maxDistributionsPerBlock uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxDistributionsPerBlockKey, &maxDistributionsPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxDistributionsPerBlock = GenMaxDistributionsPerBlock(r) },
+	)
+
+	var tvlSampleInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, tvlSampleIntervalKey, &tvlSampleInterval, simState.Rand,
+		func(r *rand.Rand) { tvlSampleInterval = GenTvlSampleInterval(r) },
+	)
+
+	var maxPriceStaleness time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxPriceStalenessKey, &maxPriceStaleness, simState.Rand,
+		func(r *rand.Rand) { maxPriceStaleness = GenMaxPriceStaleness(r) },
+	)
+
+	var maxSenderGasPerEpoch uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxSenderGasPerEpochKey, &maxSenderGasPerEpoch, simState.Rand,
+		func(r *rand.Rand) { maxSenderGasPerEpoch = GenMaxSenderGasPerEpoch(r) },
+	)
+
+	var successfulTxsOnly bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, successfulTxsOnlyKey, &successfulTxsOnly, simState.Rand,
+		func(r *rand.Rand) { successfulTxsOnly = GenSuccessfulTxsOnly(r) },
+	)
+
+	var minGasPrice sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, minGasPriceKey, &minGasPrice, simState.Rand,
+		func(r *rand.Rand) { minGasPrice = GenMinGasPrice(r) },
+	)
+
+	devearnGenesis := types.GenesisState{
+		Params: types.NewParams(
+			enableDevEarn,
+			rewardEpochIdentifier,
+			devEarnInflationAPR,
+			tvlShare,
+			types.DefaultRegistrationDeposit,
+			gasAttribution,
+			types.DefaultRewardDenoms,
+			feeShare,
+			rewardHistoryRetention,
+			maxDistributionsPerBlock,
+			tvlSampleInterval,
+			maxPriceStaleness,
+			maxSenderGasPerEpoch,
+			successfulTxsOnly,
+			minGasPrice,
+		),
+		DevEarnInfos:   []types.DevEarnInfo{},
+		AssetsList:     []types.Assets{},
+		AccruedRewards: []types.AccruedRewards{},
+	}
+
+	bz, err := json.MarshalIndent(&devearnGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated devearn parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&devearnGenesis)
+}
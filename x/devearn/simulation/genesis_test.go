@@ -0,0 +1,45 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"sidechain/x/devearn/simulation"
+	"sidechain/x/devearn/types"
+	epochstypes "sidechain/x/epochs/types"
+)
+
+// TestRandomizedGenState tests that RandomizedGenState generates a valid
+// genesis hooked to an epoch of the epochs module.
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	r := rand.New(rand.NewSource(1))
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: sdkmath.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var devearnGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &devearnGenesis)
+
+	require.NoError(t, devearnGenesis.Validate())
+	require.Contains(t, []string{epochstypes.WeekEpochID, epochstypes.DayEpochID}, devearnGenesis.Params.RewardEpochIdentifier)
+	require.Empty(t, devearnGenesis.DevEarnInfos)
+	require.NoError(t, simulation.GenParams(r).Validate())
+}
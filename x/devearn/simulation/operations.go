@@ -0,0 +1,620 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"math/big"
+	"math/rand"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"sidechain/contracts"
+	"sidechain/x/devearn/keeper"
+	"sidechain/x/devearn/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec //these aren't hard coded credentials
+const (
+	OpWeightEthereumTx                   = "op_weight_ethereum_tx"
+	OpWeightMsgRegisterDevEarn           = "op_weight_msg_register_dev_earn"
+	OpWeightMsgCancelDevEarn             = "op_weight_msg_cancel_dev_earn"
+	OpWeightMsgClaimDevEarnRewards       = "op_weight_msg_claim_dev_earn_rewards"
+	OpWeightMsgUpdateDevEarnOwner        = "op_weight_msg_update_dev_earn_owner"
+	OpWeightMsgSetDevEarnWithdrawAddress = "op_weight_msg_set_dev_earn_withdraw_address"
+	OpWeightMsgUpdateParams              = "op_weight_msg_update_params"
+)
+
+// ethTxGasCap is the gas cap used to estimate the gas of the simulated
+// ethereum transactions
+const ethTxGasCap = 25_000_000
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	ek types.EvmKeeper,
+	gk types.GovKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightEthereumTx                   int
+		weightMsgRegisterDevEarn           int
+		weightMsgCancelDevEarn             int
+		weightMsgClaimDevEarnRewards       int
+		weightMsgUpdateDevEarnOwner        int
+		weightMsgSetDevEarnWithdrawAddress int
+		weightMsgUpdateParams              int
+	)
+	appParams.GetOrGenerate(cdc, OpWeightEthereumTx, &weightEthereumTx, nil,
+		func(_ *rand.Rand) {
+			weightEthereumTx = simappparams.DefaultWeightMsgSend
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRegisterDevEarn, &weightMsgRegisterDevEarn, nil,
+		func(_ *rand.Rand) {
+			weightMsgRegisterDevEarn = simappparams.DefaultWeightMsgSetWithdrawAddress
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelDevEarn, &weightMsgCancelDevEarn, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelDevEarn = simappparams.DefaultWeightMsgMultiSend
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgClaimDevEarnRewards, &weightMsgClaimDevEarnRewards, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimDevEarnRewards = simappparams.DefaultWeightMsgWithdrawDelegationReward
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateDevEarnOwner, &weightMsgUpdateDevEarnOwner, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateDevEarnOwner = simappparams.DefaultWeightMsgSetWithdrawAddress
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetDevEarnWithdrawAddress, &weightMsgSetDevEarnWithdrawAddress, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetDevEarnWithdrawAddress = simappparams.DefaultWeightMsgSetWithdrawAddress
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateParams, &weightMsgUpdateParams, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateParams = simappparams.DefaultWeightCommunitySpendProposal
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightEthereumTx,
+			SimulateEthereumTx(ak, bk, ek, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRegisterDevEarn,
+			SimulateMsgRegisterDevEarn(ak, bk, ek, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelDevEarn,
+			SimulateMsgCancelDevEarn(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClaimDevEarnRewards,
+			SimulateMsgClaimDevEarnRewards(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateDevEarnOwner,
+			SimulateMsgUpdateDevEarnOwner(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetDevEarnWithdrawAddress,
+			SimulateMsgSetDevEarnWithdrawAddress(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateParams,
+			SimulateMsgUpdateParams(ak, bk, gk),
+		),
+	}
+}
+
+// SimulateEthereumTx delivers an ethereum transaction from the ethereum
+// account of a random simulation account. The transaction either deploys a new
+// ERC20 contract, which can later be self-registered, or transfers tokens of a
+// random registered contract so that its gas usage is accounted by the hooks.
+func SimulateEthereumTx(ak types.AccountKeeper, bk types.BankKeeper, ek types.EvmKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		ethAccount := ethSimAccount(simAccount)
+		from := common.BytesToAddress(ethAccount.Address)
+
+		var (
+			to   *common.Address
+			data []byte
+			err  error
+		)
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract
+		devEarnInfos := k.GetAllDevEarnInfos(ctx)
+		if len(devEarnInfos) == 0 || r.Intn(4) == 0 {
+			var ctorArgs []byte
+			ctorArgs, err = erc20.ABI.Pack("", simtypes.RandStringOfLength(r, 10), simtypes.RandStringOfLength(r, 3), uint8(18))
+			data = append(append([]byte{}, erc20.Bin...), ctorArgs...)
+		} else {
+			contract := common.HexToAddress(devEarnInfos[r.Intn(len(devEarnInfos))].Contract)
+			recipient, _ := simtypes.RandomAcc(r, accs)
+			to = &contract
+			data, err = erc20.ABI.Pack("transfer", common.BytesToAddress(recipient.Address), big.NewInt(0))
+		}
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, evmtypes.TypeMsgEthereumTx, "unable to pack the call data"), nil, err
+		}
+
+		// calls to contracts that are not ERC20 tokens revert
+		gas, err := estimateGas(ctx, ek, from, to, data)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, evmtypes.TypeMsgEthereumTx, "unable to estimate gas"), nil, nil
+		}
+
+		evmParams := ek.GetParams(ctx)
+		baseFee := ek.GetBaseFee(ctx, evmParams.ChainConfig.EthereumConfig(ek.ChainID()))
+		if baseFee == nil {
+			return simtypes.NoOpMsg(types.ModuleName, evmtypes.TypeMsgEthereumTx, "base fee not available"), nil, nil
+		}
+
+		fees := sdk.NewCoins(sdk.NewCoin(
+			evmParams.EvmDenom,
+			sdkmath.NewIntFromBigInt(new(big.Int).Mul(baseFee, new(big.Int).SetUint64(gas))),
+		))
+		funded, err := fundEthAccount(r, app, ctx, ak, bk, simAccount, ethAccount, fees)
+		if !funded {
+			return simtypes.NoOpMsg(types.ModuleName, evmtypes.TypeMsgEthereumTx, "unable to fund the transaction fees"), nil, err
+		}
+
+		msg := evmtypes.NewTx(
+			ek.ChainID(), ek.GetNonce(ctx, from), to, nil, gas, nil, baseFee, big.NewInt(1), data, &ethtypes.AccessList{},
+		)
+		return deliverEthTx(app, ethAccount, msg, ek.ChainID(), evmParams.EvmDenom)
+	}
+}
+
+// SimulateMsgRegisterDevEarn generates a MsgRegisterDevEarn for a contract
+// deployed by the ethereum account of a random simulation account that is not
+// registered yet.
+func SimulateMsgRegisterDevEarn(ak types.AccountKeeper, bk types.BankKeeper, ek types.EvmKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParams(ctx)
+		if !params.EnableDevEarn {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRegisterDevEarn, "dev earn is disabled"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		ethAccount := ethSimAccount(simAccount)
+		deployer := common.BytesToAddress(ethAccount.Address)
+		if k.IsBlocked(ctx, deployer) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRegisterDevEarn, "deployer is blocked"), nil, nil
+		}
+
+		var nonces []uint64
+		for nonce := uint64(0); nonce < ek.GetNonce(ctx, deployer); nonce++ {
+			contract := crypto.CreateAddress(deployer, nonce)
+			acc := ek.GetAccountWithoutBalance(ctx, contract)
+			if acc == nil || !acc.IsContract() || k.IsDevEarnInfoRegistered(ctx, contract) || k.IsBlocked(ctx, contract) {
+				continue
+			}
+			nonces = append(nonces, nonce)
+		}
+		if len(nonces) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRegisterDevEarn, "no unregistered contract deployed by the account"), nil, nil
+		}
+
+		funded, err := fundEthAccount(r, app, ctx, ak, bk, simAccount, ethAccount, params.RegistrationDeposit)
+		if !funded {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRegisterDevEarn, "unable to fund the registration deposit"), nil, err
+		}
+
+		nonce := nonces[r.Intn(len(nonces))]
+		owner, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgRegisterDevEarn(
+			crypto.CreateAddress(deployer, nonce),
+			ethAccount.Address,
+			[]uint64{nonce},
+			common.BytesToAddress(owner.Address).Hex(),
+			uint32(simtypes.RandIntBetween(r, 1, 10)),
+		)
+		return deliverTx(r, app, ctx, ak, bk, ethAccount, msg, msg.Type(), params.RegistrationDeposit)
+	}
+}
+
+// SimulateMsgCancelDevEarn generates a MsgCancelDevEarn for a contract
+// self-registered by the ethereum account of a simulation account.
+func SimulateMsgCancelDevEarn(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		ethAccounts := make(map[string]simtypes.Account, len(accs))
+		for _, simAccount := range accs {
+			ethAccount := ethSimAccount(simAccount)
+			ethAccounts[ethAccount.Address.String()] = ethAccount
+		}
+
+		var (
+			registered []types.DevEarnInfo
+			deployers  []simtypes.Account
+		)
+		for _, devEarnInfo := range k.GetAllDevEarnInfos(ctx) {
+			if ethAccount, found := ethAccounts[devEarnInfo.DeployerAddress]; found {
+				registered = append(registered, devEarnInfo)
+				deployers = append(deployers, ethAccount)
+			}
+		}
+
+		if len(registered) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelDevEarn, "no contract self-registered by an account"), nil, nil
+		}
+
+		i := r.Intn(len(registered))
+		msg := types.NewMsgCancelDevEarn(common.HexToAddress(registered[i].Contract), deployers[i].Address)
+		return deliverTx(r, app, ctx, ak, bk, deployers[i], msg, msg.Type(), sdk.NewCoins())
+	}
+}
+
+// SimulateMsgClaimDevEarnRewards generates a MsgClaimDevEarnRewards for the
+// rewards accrued to a random account, either for a single contract or for
+// every contract.
+func SimulateMsgClaimDevEarnRewards(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		accrued := k.GetOwnerAccruedRewards(ctx, common.BytesToAddress(simAccount.Address))
+		if len(accrued) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClaimDevEarnRewards, "no rewards accrued"), nil, nil
+		}
+
+		contract := ""
+		if r.Intn(2) == 0 {
+			contract = accrued[r.Intn(len(accrued))].Contract
+		}
+
+		msg := types.NewMsgClaimDevEarnRewards(simAccount.Address, contract, "")
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type(), sdk.NewCoins())
+	}
+}
+
+// SimulateMsgUpdateDevEarnOwner generates a MsgUpdateDevEarnOwner that
+// transfers a contract owned by a simulation account to another one.
+func SimulateMsgUpdateDevEarnOwner(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		devEarnInfo, simAccount, found := randomOwnedDevEarnInfo(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateDevEarnOwner, "no contract owned by an account"), nil, nil
+		}
+
+		newOwner, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgUpdateDevEarnOwner(
+			common.HexToAddress(devEarnInfo.Contract),
+			simAccount.Address,
+			common.BytesToAddress(newOwner.Address),
+		)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type(), sdk.NewCoins())
+	}
+}
+
+// SimulateMsgSetDevEarnWithdrawAddress generates a MsgSetDevEarnWithdrawAddress
+// that sets or resets the withdraw address of a contract owned by a simulation
+// account.
+func SimulateMsgSetDevEarnWithdrawAddress(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		devEarnInfo, simAccount, found := randomOwnedDevEarnInfo(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetWithdrawAddress, "no contract owned by an account"), nil, nil
+		}
+
+		withdrawAddr := ""
+		if r.Intn(4) > 0 {
+			withdrawAccount, _ := simtypes.RandomAcc(r, accs)
+			withdrawAddr = common.BytesToAddress(withdrawAccount.Address).Hex()
+		}
+
+		msg := types.NewMsgSetDevEarnWithdrawAddress(
+			common.HexToAddress(devEarnInfo.Contract),
+			simAccount.Address,
+			withdrawAddr,
+		)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type(), sdk.NewCoins())
+	}
+}
+
+// SimulateMsgUpdateParams submits a governance proposal that executes a
+// MsgUpdateParams with random parameters on behalf of the governance account.
+func SimulateMsgUpdateParams(ak types.AccountKeeper, bk types.BankKeeper, gk types.GovKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgUpdateParams{
+			Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			Params:    GenParams(r),
+		}
+
+		return deliverProposal(r, app, ctx, accs, chainID, ak, bk, gk, msg)
+	}
+}
+
+// randomOwnedDevEarnInfo returns a random registered contract owned by one of
+// the simulation accounts, together with the owner account
+func randomOwnedDevEarnInfo(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+) (types.DevEarnInfo, simtypes.Account, bool) {
+	var (
+		owned  []types.DevEarnInfo
+		owners []simtypes.Account
+	)
+	for _, devEarnInfo := range k.GetAllDevEarnInfos(ctx) {
+		owner := common.HexToAddress(devEarnInfo.OwnerAddress)
+		if simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(owner.Bytes())); found {
+			owned = append(owned, devEarnInfo)
+			owners = append(owners, simAccount)
+		}
+	}
+
+	if len(owned) == 0 {
+		return types.DevEarnInfo{}, simtypes.Account{}, false
+	}
+
+	i := r.Intn(len(owned))
+	return owned[i], owners[i], true
+}
+
+// deliverTx signs the message with the simulation account and delivers it with
+// random fees
+func deliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+	msgType string,
+	spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: spent,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// deliverProposal submits a governance proposal that executes the message,
+// with the minimum deposit paid by a random account, and schedules YES votes on
+// it from random accounts within the voting period
+func deliverProposal(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	accs []simtypes.Account,
+	chainID string,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	gk types.GovKeeper,
+	msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	proposer, _ := simtypes.RandomAcc(r, accs)
+	deposit := gk.GetDepositParams(ctx).MinDeposit
+
+	account := ak.GetAccount(ctx, proposer.Address)
+	spendable := bk.SpendableCoins(ctx, proposer.Address)
+	coins, hasNeg := spendable.SafeSub(deposit...)
+	if account == nil || hasNeg {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to pay the proposal deposit"), nil, nil
+	}
+
+	proposalID, err := gk.GetProposalID(ctx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to get the proposal id"), nil, err
+	}
+
+	submitMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, proposer.Address.String(), "")
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to build the proposal"), nil, err
+	}
+
+	fees, err := simtypes.RandomFees(r, ctx, coins)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to generate fees"), nil, err
+	}
+
+	// the module messages are not registered on the gov amino codec, so the
+	// proposal is signed in direct mode and delivered without amino sign bytes
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenSignedMockTx(
+		r,
+		txGen,
+		[]sdk.Msg{submitMsg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		proposer.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to generate mock tx"), nil, err
+	}
+
+	if _, _, err := app.SimDeliver(txGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to deliver tx"), nil, err
+	}
+
+	votingPeriod := gk.GetVotingParams(ctx).VotingPeriod
+	numVotes := simtypes.RandIntBetween(r, 1, len(accs)+1)
+
+	fops := make([]simtypes.FutureOperation, 0, numVotes)
+	for _, i := range r.Perm(len(accs))[:numVotes] {
+		fops = append(fops, simtypes.FutureOperation{
+			BlockTime: ctx.BlockHeader().Time.Add(time.Duration(r.Int63n(int64(*votingPeriod)))),
+			Op:        simulateMsgVote(ak, bk, accs[i], proposalID),
+		})
+	}
+
+	return simtypes.NewOperationMsgBasic(govtypes.ModuleName, submitMsg.Type(), "", true, nil), fops, nil
+}
+
+// simulateMsgVote votes YES on the proposal with the simulation account
+func simulateMsgVote(ak types.AccountKeeper, bk types.BankKeeper, simAccount simtypes.Account, proposalID uint64) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := govv1.NewMsgVote(simAccount.Address, proposalID, govv1.OptionYes, "")
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type(), bk.SpendableCoins(ctx, simAccount.Address))
+	}
+}
+
+// ethSimAccount returns the account of the ethereum key derived from the
+// private key of the simulation account. Both are the same account when the
+// simulation account already uses an ethsecp256k1 key.
+func ethSimAccount(simAccount simtypes.Account) simtypes.Account {
+	privKey := &ethsecp256k1.PrivKey{Key: simAccount.PrivKey.Bytes()}
+	return simtypes.Account{
+		PrivKey: privKey,
+		PubKey:  privKey.PubKey(),
+		Address: sdk.AccAddress(privKey.PubKey().Address()),
+		ConsKey: simAccount.ConsKey,
+	}
+}
+
+// fundEthAccount sends the coins from the simulation account to its ethereum
+// account, unless the ethereum account can already spend them. It returns
+// false if the coins cannot be funded.
+func fundEthAccount(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	ethAccount simtypes.Account,
+	amount sdk.Coins,
+) (bool, error) {
+	if bk.SpendableCoins(ctx, ethAccount.Address).IsAllGTE(amount) {
+		return true, nil
+	}
+	if ethAccount.Address.Equals(simAccount.Address) {
+		return false, nil
+	}
+
+	msg := banktypes.NewMsgSend(simAccount.Address, ethAccount.Address, amount)
+	opMsg, _, err := deliverTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type(), amount)
+	return opMsg.OK && err == nil, err
+}
+
+// estimateGas estimates the gas used by an ethereum transaction
+func estimateGas(ctx sdk.Context, ek types.EvmKeeper, from common.Address, to *common.Address, data []byte) (uint64, error) {
+	args, err := json.Marshal(&evmtypes.TransactionArgs{
+		From: &from,
+		To:   to,
+		Data: (*hexutil.Bytes)(&data),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := ek.EstimateGas(sdk.WrapSDKContext(ctx), &evmtypes.EthCallRequest{
+		Args:   args,
+		GasCap: ethTxGasCap,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.Gas, nil
+}
+
+// deliverEthTx signs the ethereum transaction with the key of the ethereum
+// account and delivers it
+func deliverEthTx(
+	app *baseapp.BaseApp,
+	ethAccount simtypes.Account,
+	msg *evmtypes.MsgEthereumTx,
+	chainID *big.Int,
+	evmDenom string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	privKey, ok := ethAccount.PrivKey.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return simtypes.NoOpMsg(types.ModuleName, evmtypes.TypeMsgEthereumTx, "account is not an ethereum account"), nil, nil
+	}
+
+	key, err := privKey.ToECDSA()
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, evmtypes.TypeMsgEthereumTx, "invalid private key"), nil, err
+	}
+
+	signedTx, err := ethtypes.SignTx(msg.AsTransaction(), ethtypes.LatestSignerForChainID(chainID), key)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, evmtypes.TypeMsgEthereumTx, "unable to sign tx"), nil, err
+	}
+	if err := msg.FromEthereumTx(signedTx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, evmtypes.TypeMsgEthereumTx, "unable to build msg"), nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := msg.BuildTx(txGen.NewTxBuilder(), evmDenom)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, evmtypes.TypeMsgEthereumTx, "unable to build tx"), nil, err
+	}
+
+	if _, _, err := app.SimDeliver(txGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, evmtypes.TypeMsgEthereumTx, "unable to deliver tx"), nil, err
+	}
+
+	// MsgEthereumTx cannot produce amino sign bytes
+	return simtypes.NewOperationMsgBasic(evmtypes.RouterKey, evmtypes.TypeMsgEthereumTx, "", true, nil), nil, nil
+}
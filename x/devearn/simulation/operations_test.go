@@ -0,0 +1,127 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"sidechain/app"
+	"sidechain/testutil"
+	"sidechain/x/devearn/simulation"
+	"sidechain/x/devearn/types"
+)
+
+// setupOperations starts a block on a new app and returns it together with a
+// funded simulation account
+func setupOperations(t *testing.T, r *rand.Rand) (*app.Sidechain, sdk.Context, []simtypes.Account) {
+	sideApp := app.Setup(false, nil)
+
+	validators := sideApp.StakingKeeper.GetAllValidators(sideApp.BaseApp.NewContext(false, tmproto.Header{}))
+	require.Len(t, validators, 1)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+
+	header := tmproto.Header{
+		Height:          1,
+		ChainID:         "sidechain_7071-1",
+		Time:            time.Now().UTC(),
+		ProposerAddress: consAddr,
+	}
+	sideApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := sideApp.BaseApp.NewContext(false, header)
+
+	coins := sdk.NewCoins(sdk.NewCoin(sideApp.EvmKeeper.GetParams(ctx).EvmDenom, sdkmath.NewIntWithDecimal(100, 18)))
+	coins = coins.Add(sideApp.GovKeeper.GetDepositParams(ctx).MinDeposit...)
+
+	accs := simtypes.RandomAccounts(r, 1)
+	for _, acc := range accs {
+		sideApp.AccountKeeper.SetAccount(ctx, sideApp.AccountKeeper.NewAccountWithAddress(ctx, acc.Address))
+		require.NoError(t, testutil.FundAccount(ctx, sideApp.BankKeeper, acc.Address, coins))
+	}
+
+	return sideApp, ctx, accs
+}
+
+// TestSimulateSelfRegistration deploys a contract from the ethereum account of
+// a simulation account, self-registers it, calls it and cancels it.
+func TestSimulateSelfRegistration(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sideApp, ctx, accs := setupOperations(t, r)
+	ak, bk, ek, k := sideApp.AccountKeeper, sideApp.BankKeeper, sideApp.EvmKeeper, sideApp.DevearnKeeper
+
+	deployer := &ethsecp256k1.PrivKey{Key: accs[0].PrivKey.Bytes()}
+	deployerAddr := common.BytesToAddress(deployer.PubKey().Address())
+	contract := crypto.CreateAddress(deployerAddr, 0)
+
+	// without registered contracts the ethereum tx deploys a new one
+	opMsg, _, err := simulation.SimulateEthereumTx(ak, bk, ek, k)(r, sideApp.BaseApp, ctx, accs, ctx.ChainID())
+	require.NoError(t, err)
+	require.True(t, opMsg.OK, opMsg.Comment)
+	acc := ek.GetAccountWithoutBalance(ctx, contract)
+	require.NotNil(t, acc)
+	require.True(t, acc.IsContract())
+
+	opMsg, _, err = simulation.SimulateMsgRegisterDevEarn(ak, bk, ek, k)(r, sideApp.BaseApp, ctx, accs, ctx.ChainID())
+	require.NoError(t, err)
+	require.True(t, opMsg.OK, opMsg.Comment)
+	devEarnInfo, found := k.GetDevEarnInfo(ctx, contract)
+	require.True(t, found)
+	require.Equal(t, sdk.AccAddress(deployerAddr.Bytes()).String(), devEarnInfo.DeployerAddress)
+	require.Equal(t, []uint64{0}, devEarnInfo.Nonces)
+
+	opMsg, _, err = simulation.SimulateEthereumTx(ak, bk, ek, k)(r, sideApp.BaseApp, ctx, accs, ctx.ChainID())
+	require.NoError(t, err)
+	require.True(t, opMsg.OK, opMsg.Comment)
+	// the registration tx also increments the account sequence, so the call
+	// is the third transaction of the deployer
+	require.Equal(t, uint64(3), ek.GetNonce(ctx, deployerAddr))
+	devEarnInfo, _ = k.GetDevEarnInfo(ctx, contract)
+	require.NotZero(t, devEarnInfo.GasMeter)
+
+	opMsg, _, err = simulation.SimulateMsgCancelDevEarn(ak, bk, k)(r, sideApp.BaseApp, ctx, accs, ctx.ChainID())
+	require.NoError(t, err)
+	require.True(t, opMsg.OK, opMsg.Comment)
+	require.False(t, k.IsDevEarnInfoRegistered(ctx, contract))
+}
+
+// TestSimulateMsgUpdateParams checks that the params update is submitted as a
+// governance proposal and voted on in the future operations.
+func TestSimulateMsgUpdateParams(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sideApp, ctx, accs := setupOperations(t, r)
+
+	proposalID, err := sideApp.GovKeeper.GetProposalID(ctx)
+	require.NoError(t, err)
+
+	op := simulation.SimulateMsgUpdateParams(sideApp.AccountKeeper, sideApp.BankKeeper, sideApp.GovKeeper)
+	opMsg, fops, err := op(r, sideApp.BaseApp, ctx, accs, ctx.ChainID())
+	require.NoError(t, err)
+	require.True(t, opMsg.OK, opMsg.Comment)
+	require.Len(t, fops, 1)
+
+	proposal, found := sideApp.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, found)
+	require.Equal(t, govv1.StatusVotingPeriod, proposal.Status)
+	msgs, err := proposal.GetMsgs()
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.IsType(t, &types.MsgUpdateParams{}, msgs[0])
+
+	opMsg, _, err = fops[0].Op(r, sideApp.BaseApp, ctx, accs, ctx.ChainID())
+	require.NoError(t, err)
+	require.True(t, opMsg.OK, opMsg.Comment)
+	vote, found := sideApp.GovKeeper.GetVote(ctx, proposalID, accs[0].Address)
+	require.True(t, found)
+	require.Equal(t, govv1.OptionYes, vote.Options[0].Option)
+}
@@ -0,0 +1,33 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"sidechain/x/devearn/types"
+)
+
+// GenParams returns randomized devearn parameters. The parameters are kept in
+// the module store instead of the params subspace, so they are changed with a
+// MsgUpdateParams from the governance account rather than with a param change
+// proposal.
+func GenParams(r *rand.Rand) types.Params {
+	return types.NewParams(
+		GenEnableDevEarn(r),
+		GenRewardEpochIdentifier(r),
+		GenDevEarnInflationAPR(r),
+		GenTvlShare(r),
+		types.DefaultRegistrationDeposit,
+		GenGasAttribution(r),
+		types.DefaultRewardDenoms,
+		GenFeeShare(r),
+		GenRewardHistoryRetention(r),
+		GenMaxDistributionsPerBlock(r),
+		GenTvlSampleInterval(r),
+		GenMaxPriceStaleness(r),
+		GenMaxSenderGasPerEpoch(r),
+		GenSuccessfulTxsOnly(r),
+		GenMinGasPrice(r),
+	)
+}
@@ -0,0 +1,172 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ethereum/go-ethereum/common"
+
+	"sidechain/x/devearn/keeper"
+	"sidechain/x/devearn/types"
+	erc20types "sidechain/x/erc20/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec //these aren't hard coded credentials
+const (
+	OpWeightRegisterDevEarnInfoProposal      = "op_weight_register_dev_earn_info_proposal"
+	OpWeightCancelDevEarnInfoProposal        = "op_weight_cancel_dev_earn_info_proposal"
+	OpWeightAddAssetToWhitelistProposal      = "op_weight_add_asset_to_whitelist_proposal"
+	OpWeightRemoveAssetFromWhitelistProposal = "op_weight_remove_asset_from_whitelist_proposal"
+)
+
+// ProposalContents returns the devearn governance proposal contents with their
+// respective weights
+func ProposalContents(bk types.BankKeeper, ek types.Erc20Keeper, k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightRegisterDevEarnInfoProposal,
+			simappparams.DefaultWeightTextProposal,
+			SimulateRegisterDevEarnInfoProposal(bk, ek, k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightCancelDevEarnInfoProposal,
+			simappparams.DefaultWeightTextProposal/2,
+			SimulateCancelDevEarnInfoProposal(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightAddAssetToWhitelistProposal,
+			simappparams.DefaultWeightTextProposal,
+			SimulateAddAssetToWhitelistProposal(bk, ek, k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightRemoveAssetFromWhitelistProposal,
+			simappparams.DefaultWeightTextProposal/2,
+			SimulateRemoveAssetFromWhitelistProposal(k),
+		),
+	}
+}
+
+// SimulateRegisterDevEarnInfoProposal generates a RegisterDevEarnInfoProposal
+// for the ERC20 contract of a random token pair, owned by a random account
+func SimulateRegisterDevEarnInfoProposal(bk types.BankKeeper, ek types.Erc20Keeper, k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		if !k.GetParams(ctx).EnableDevEarn {
+			return nil
+		}
+
+		pairs := tokenPairs(ctx, bk, ek)
+		if len(pairs) == 0 {
+			return nil
+		}
+
+		contract := common.HexToAddress(pairs[r.Intn(len(pairs))].Erc20Address)
+		if k.IsDevEarnInfoRegistered(ctx, contract) {
+			return nil
+		}
+
+		owner, _ := simtypes.RandomAcc(r, accs)
+
+		return types.NewRegisterDevEarnInfoProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			contract.Hex(),
+			common.BytesToAddress(owner.Address).Hex(),
+			uint32(simtypes.RandIntBetween(r, 1, 10)),
+		)
+	}
+}
+
+// SimulateCancelDevEarnInfoProposal generates a CancelDevEarnInfoProposal for
+// a random registered contract
+func SimulateCancelDevEarnInfoProposal(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		if !k.GetParams(ctx).EnableDevEarn {
+			return nil
+		}
+
+		devEarnInfos := k.GetAllDevEarnInfos(ctx)
+		if len(devEarnInfos) == 0 {
+			return nil
+		}
+
+		return types.NewCancelDevEarnProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			devEarnInfos[r.Intn(len(devEarnInfos))].Contract,
+		)
+	}
+}
+
+// SimulateAddAssetToWhitelistProposal generates an AddAssetToWhitelistProposal
+// for a random token pair denom that isn't whitelisted yet
+func SimulateAddAssetToWhitelistProposal(bk types.BankKeeper, ek types.Erc20Keeper, k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		if !k.GetParams(ctx).EnableDevEarn {
+			return nil
+		}
+
+		var denoms []string
+		for _, pair := range tokenPairs(ctx, bk, ek) {
+			if !k.IsAssetRegistered(ctx, pair.Denom) {
+				denoms = append(denoms, pair.Denom)
+			}
+		}
+		if len(denoms) == 0 {
+			return nil
+		}
+
+		return types.NewAddAssetToWhitelistProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			denoms[r.Intn(len(denoms))],
+		)
+	}
+}
+
+// SimulateRemoveAssetFromWhitelistProposal generates a
+// RemoveAssetFromWhitelistProposal for a random whitelisted asset
+func SimulateRemoveAssetFromWhitelistProposal(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		if !k.GetParams(ctx).EnableDevEarn {
+			return nil
+		}
+
+		assets := k.GetAllAssets(ctx)
+		if len(assets) == 0 {
+			return nil
+		}
+
+		return types.NewRemoveAssetFromWhitelistProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			assets[r.Intn(len(assets))].Denom,
+		)
+	}
+}
+
+// tokenPairs returns the token pairs registered for the coins with a supply
+func tokenPairs(ctx sdk.Context, bk types.BankKeeper, ek types.Erc20Keeper) []erc20types.TokenPair {
+	supply, _, err := bk.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		return nil
+	}
+
+	var pairs []erc20types.TokenPair
+	for _, coin := range supply {
+		res, err := ek.TokenPair(sdk.WrapSDKContext(ctx), &erc20types.QueryTokenPairRequest{Token: coin.Denom})
+		if err != nil {
+			continue
+		}
+		pairs = append(pairs, res.TokenPair)
+	}
+
+	return pairs
+}
@@ -1,8 +1,12 @@
 package types
 
 import (
+	"sidechain/types"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 var _ sdk.Msg = &MsgUpdateParams{}
@@ -30,3 +34,391 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+var (
+	_ sdk.Msg = &MsgAddToBlocklist{}
+	_ sdk.Msg = &MsgRemoveFromBlocklist{}
+)
+
+// GetSigners returns the expected signers for a MsgAddToBlocklist message.
+func (m *MsgAddToBlocklist) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgAddToBlocklist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return validateBlocklist(m.Addresses, false)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAddToBlocklist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveFromBlocklist message.
+func (m *MsgRemoveFromBlocklist) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRemoveFromBlocklist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return validateBlocklist(m.Addresses, false)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveFromBlocklist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+var (
+	_ sdk.Msg = &MsgRegisterDevEarnInfo{}
+	_ sdk.Msg = &MsgCancelDevEarnInfo{}
+	_ sdk.Msg = &MsgAddAsset{}
+	_ sdk.Msg = &MsgRemoveAsset{}
+)
+
+// GetSigners returns the expected signers for a MsgRegisterDevEarnInfo message.
+func (m *MsgRegisterDevEarnInfo) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterDevEarnInfo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := types.ValidateAddress(m.Contract); err != nil {
+		return err
+	}
+
+	if m.OwnerAddress != "" {
+		if err := types.ValidateAddress(m.OwnerAddress); err != nil {
+			return err
+		}
+	}
+
+	return validateEpochs(m.Epochs)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterDevEarnInfo) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCancelDevEarnInfo message.
+func (m *MsgCancelDevEarnInfo) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCancelDevEarnInfo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return types.ValidateAddress(m.Contract)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCancelDevEarnInfo) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgAddAsset message.
+func (m *MsgAddAsset) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgAddAsset) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return sdk.ValidateDenom(m.Denom)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAddAsset) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveAsset message.
+func (m *MsgRemoveAsset) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRemoveAsset) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return sdk.ValidateDenom(m.Denom)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveAsset) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+var (
+	_ sdk.Msg = &MsgRegisterDevEarn{}
+	_ sdk.Msg = &MsgCancelDevEarn{}
+	_ sdk.Msg = &MsgClaimDevEarnRewards{}
+	_ sdk.Msg = &MsgUpdateDevEarnOwner{}
+	_ sdk.Msg = &MsgSetDevEarnWithdrawAddress{}
+)
+
+const (
+	TypeMsgRegisterDevEarn     = "register_dev_earn"
+	TypeMsgCancelDevEarn       = "cancel_dev_earn"
+	TypeMsgClaimDevEarnRewards = "claim_dev_earn_rewards"
+	TypeMsgUpdateDevEarnOwner  = "update_dev_earn_owner"
+	TypeMsgSetWithdrawAddress  = "set_dev_earn_withdraw_address"
+
+	// MaxDerivationNonces bounds the factory depth a deployer can prove
+	MaxDerivationNonces = 20
+)
+
+// NewMsgRegisterDevEarn creates a new instance of MsgRegisterDevEarn
+func NewMsgRegisterDevEarn(
+	contract common.Address,
+	deployer sdk.AccAddress,
+	nonces []uint64,
+	ownerAddr string,
+	epochs uint32,
+) *MsgRegisterDevEarn {
+	return &MsgRegisterDevEarn{
+		DeployerAddress: deployer.String(),
+		Contract:        contract.String(),
+		Nonces:          nonces,
+		OwnerAddress:    ownerAddr,
+		Epochs:          epochs,
+	}
+}
+
+// Route returns the name of the module
+func (m MsgRegisterDevEarn) Route() string { return RouterKey }
+
+// Type returns the action
+func (m MsgRegisterDevEarn) Type() string { return TypeMsgRegisterDevEarn }
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgRegisterDevEarn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", m.DeployerAddress)
+	}
+
+	if err := types.ValidateAddress(m.Contract); err != nil {
+		return err
+	}
+
+	if m.OwnerAddress != "" {
+		if err := types.ValidateAddress(m.OwnerAddress); err != nil {
+			return err
+		}
+	}
+
+	if len(m.Nonces) == 0 || len(m.Nonces) > MaxDerivationNonces {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"nonces must contain between 1 and %d entries, got %d", MaxDerivationNonces, len(m.Nonces),
+		)
+	}
+
+	return validateEpochs(m.Epochs)
+}
+
+// GetSignBytes encodes the message for signing
+func (m MsgRegisterDevEarn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgRegisterDevEarn) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.DeployerAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgCancelDevEarn creates a new instance of MsgCancelDevEarn
+func NewMsgCancelDevEarn(contract common.Address, deployer sdk.AccAddress) *MsgCancelDevEarn {
+	return &MsgCancelDevEarn{
+		DeployerAddress: deployer.String(),
+		Contract:        contract.String(),
+	}
+}
+
+// Route returns the name of the module
+func (m MsgCancelDevEarn) Route() string { return RouterKey }
+
+// Type returns the action
+func (m MsgCancelDevEarn) Type() string { return TypeMsgCancelDevEarn }
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgCancelDevEarn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", m.DeployerAddress)
+	}
+
+	return types.ValidateAddress(m.Contract)
+}
+
+// GetSignBytes encodes the message for signing
+func (m MsgCancelDevEarn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgCancelDevEarn) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.DeployerAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgClaimDevEarnRewards creates a new instance of MsgClaimDevEarnRewards.
+// The rewards of every contract are claimed if contract is empty.
+func NewMsgClaimDevEarnRewards(owner sdk.AccAddress, contract, withdrawAddr string) *MsgClaimDevEarnRewards {
+	return &MsgClaimDevEarnRewards{
+		OwnerAddress:    owner.String(),
+		Contract:        contract,
+		WithdrawAddress: withdrawAddr,
+	}
+}
+
+// Route returns the name of the module
+func (m MsgClaimDevEarnRewards) Route() string { return RouterKey }
+
+// Type returns the action
+func (m MsgClaimDevEarnRewards) Type() string { return TypeMsgClaimDevEarnRewards }
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgClaimDevEarnRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.OwnerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid owner address %s", m.OwnerAddress)
+	}
+
+	if m.Contract == "" {
+		if m.WithdrawAddress != "" {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, "claiming for a withdraw address requires the contract")
+		}
+		return nil
+	}
+
+	if m.WithdrawAddress != "" {
+		if err := types.ValidateAddress(m.WithdrawAddress); err != nil {
+			return err
+		}
+	}
+
+	return types.ValidateAddress(m.Contract)
+}
+
+// GetSignBytes encodes the message for signing
+func (m MsgClaimDevEarnRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgClaimDevEarnRewards) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.OwnerAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUpdateDevEarnOwner creates a new instance of MsgUpdateDevEarnOwner
+func NewMsgUpdateDevEarnOwner(contract common.Address, owner sdk.AccAddress, newOwner common.Address) *MsgUpdateDevEarnOwner {
+	return &MsgUpdateDevEarnOwner{
+		OwnerAddress:    owner.String(),
+		Contract:        contract.String(),
+		NewOwnerAddress: newOwner.String(),
+	}
+}
+
+// Route returns the name of the module
+func (m MsgUpdateDevEarnOwner) Route() string { return RouterKey }
+
+// Type returns the action
+func (m MsgUpdateDevEarnOwner) Type() string { return TypeMsgUpdateDevEarnOwner }
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgUpdateDevEarnOwner) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.OwnerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid owner address %s", m.OwnerAddress)
+	}
+
+	if err := types.ValidateAddress(m.Contract); err != nil {
+		return err
+	}
+
+	return types.ValidateAddress(m.NewOwnerAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (m MsgUpdateDevEarnOwner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgUpdateDevEarnOwner) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.OwnerAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetDevEarnWithdrawAddress creates a new instance of
+// MsgSetDevEarnWithdrawAddress. The withdraw address is reset to the owner if
+// withdrawAddr is empty.
+func NewMsgSetDevEarnWithdrawAddress(contract common.Address, owner sdk.AccAddress, withdrawAddr string) *MsgSetDevEarnWithdrawAddress {
+	return &MsgSetDevEarnWithdrawAddress{
+		OwnerAddress:    owner.String(),
+		Contract:        contract.String(),
+		WithdrawAddress: withdrawAddr,
+	}
+}
+
+// Route returns the name of the module
+func (m MsgSetDevEarnWithdrawAddress) Route() string { return RouterKey }
+
+// Type returns the action
+func (m MsgSetDevEarnWithdrawAddress) Type() string { return TypeMsgSetWithdrawAddress }
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgSetDevEarnWithdrawAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.OwnerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid owner address %s", m.OwnerAddress)
+	}
+
+	if err := types.ValidateAddress(m.Contract); err != nil {
+		return err
+	}
+
+	if m.WithdrawAddress == "" {
+		return nil
+	}
+
+	return types.ValidateAddress(m.WithdrawAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (m MsgSetDevEarnWithdrawAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgSetDevEarnWithdrawAddress) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.OwnerAddress)
+	return []sdk.AccAddress{addr}
+}
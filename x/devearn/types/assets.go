@@ -0,0 +1,20 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a stateless validation of an AssetPrice
+func (p AssetPrice) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	if p.Price.IsNil() || !p.Price.IsPositive() {
+		return fmt.Errorf("invalid price %s for asset %s", p.Price, p.Denom)
+	}
+
+	return nil
+}
@@ -5,16 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -66,23 +72,90 @@ func (m *Assets) GetDenom() string {
 	return ""
 }
 
+// AssetPrice is the last known oracle price of a whitelisted asset
+type AssetPrice struct {
+	// denom of the asset
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the oracle exchange rate of the asset
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// time is the block time the price was read at
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *AssetPrice) Reset()         { *m = AssetPrice{} }
+func (m *AssetPrice) String() string { return proto.CompactTextString(m) }
+func (*AssetPrice) ProtoMessage()    {}
+func (*AssetPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf150e5da95e969e, []int{1}
+}
+func (m *AssetPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetPrice.Merge(m, src)
+}
+func (m *AssetPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetPrice proto.InternalMessageInfo
+
+func (m *AssetPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AssetPrice) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Assets)(nil), "sidechain.devearn.Assets")
+	proto.RegisterType((*AssetPrice)(nil), "sidechain.devearn.AssetPrice")
 }
 
 func init() { proto.RegisterFile("sidechain/devearn/assets.proto", fileDescriptor_bf150e5da95e969e) }
 
 var fileDescriptor_bf150e5da95e969e = []byte{
-	// 132 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0xce, 0x4c, 0x49,
-	0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0x49, 0x2d, 0x4b, 0x4d, 0x2c, 0xca, 0xd3, 0x4f, 0x2c,
-	0x2e, 0x4e, 0x2d, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xcb, 0xeb, 0x41,
-	0xe5, 0x95, 0xe4, 0xb8, 0xd8, 0x1c, 0xc1, 0x4a, 0x84, 0x44, 0xb8, 0x58, 0x53, 0x52, 0xf3, 0xf2,
-	0x73, 0x25, 0x98, 0x14, 0x18, 0x35, 0x38, 0x83, 0x20, 0x1c, 0x27, 0xe3, 0x13, 0x8f, 0xe4, 0x18,
-	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
-	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x92, 0x44, 0x58, 0x56, 0x01, 0xb7, 0xae, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0x6c, 0x9d, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x40, 0x02, 0xd1,
-	0x90, 0x00, 0x00, 0x00,
+	// 273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x85, 0x63, 0xa0, 0x15, 0x98, 0x89, 0xa8, 0x43, 0xc9, 0xe0, 0x54, 0x1d, 0x50, 0x17, 0x6c,
+	0x89, 0x2e, 0xac, 0x44, 0x7d, 0x00, 0x14, 0x31, 0xb1, 0xe5, 0xe7, 0x92, 0x5a, 0xe0, 0xdc, 0x28,
+	0x76, 0x11, 0xbc, 0x45, 0x9f, 0x81, 0xa7, 0xe9, 0xd8, 0x11, 0x31, 0x14, 0x94, 0xbc, 0x08, 0x8a,
+	0xdd, 0xbf, 0xc9, 0xbe, 0x3a, 0xdf, 0xb9, 0xf7, 0xe8, 0x50, 0xa6, 0x65, 0x0e, 0xd9, 0x3c, 0x91,
+	0xa5, 0xc8, 0xe1, 0x1d, 0x92, 0xba, 0x14, 0x89, 0xd6, 0x60, 0x34, 0xaf, 0x6a, 0x34, 0xe8, 0x5f,
+	0xed, 0x75, 0xbe, 0xd5, 0x83, 0x41, 0x81, 0x05, 0x5a, 0x55, 0x74, 0x3f, 0x07, 0x06, 0x61, 0x81,
+	0x58, 0xbc, 0x81, 0xb0, 0x53, 0xba, 0x78, 0x11, 0x46, 0x2a, 0xd0, 0x26, 0x51, 0x95, 0x03, 0xc6,
+	0x8c, 0xf6, 0x1f, 0xec, 0x66, 0x7f, 0x40, 0x7b, 0x39, 0x94, 0xa8, 0x86, 0x27, 0x23, 0x32, 0xb9,
+	0x88, 0xdd, 0x30, 0xfe, 0x22, 0x94, 0x5a, 0xe0, 0xb1, 0x96, 0x19, 0x1c, 0x20, 0x72, 0x04, 0xf9,
+	0x33, 0xda, 0xab, 0x3a, 0xd9, 0x59, 0x23, 0xbe, 0xda, 0x84, 0xde, 0xcf, 0x26, 0xbc, 0x29, 0xa4,
+	0x99, 0x2f, 0x52, 0x9e, 0xa1, 0x12, 0x19, 0x6a, 0x85, 0x7a, 0xfb, 0xdc, 0xea, 0xfc, 0x55, 0x98,
+	0xcf, 0x0a, 0x34, 0x9f, 0x41, 0x16, 0x3b, 0xb3, 0x7f, 0x4f, 0xcf, 0xba, 0x74, 0xc3, 0xd3, 0x11,
+	0x99, 0x5c, 0xde, 0x05, 0xdc, 0x45, 0xe7, 0xbb, 0xe8, 0xfc, 0x69, 0x17, 0x3d, 0x3a, 0xef, 0x0e,
+	0x2c, 0x7f, 0x43, 0x12, 0x5b, 0x47, 0x34, 0x5d, 0x35, 0x8c, 0xac, 0x1b, 0x46, 0xfe, 0x1a, 0x46,
+	0x96, 0x2d, 0xf3, 0xd6, 0x2d, 0xf3, 0xbe, 0x5b, 0xe6, 0x3d, 0x5f, 0x1f, 0x8a, 0xfc, 0xd8, 0x57,
+	0x69, 0x2f, 0xa7, 0x7d, 0xbb, 0x78, 0xfa, 0x3f, 0x00, 0xb2, 0x6b, 0x3a, 0x6c, 0x6c, 0x01, 0x00,
+	0x00,
 }
 
 func (m *Assets) Marshal() (dAtA []byte, err error) {
@@ -115,6 +188,54 @@ func (m *Assets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AssetPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAssets(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAssets(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAssets(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAssets(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAssets(v)
 	base := offset
@@ -139,6 +260,23 @@ func (m *Assets) Size() (n int) {
 	return n
 }
 
+func (m *AssetPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAssets(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovAssets(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAssets(uint64(l))
+	return n
+}
+
 func sovAssets(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -227,6 +365,155 @@ func (m *Assets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AssetPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAssets
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAssets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAssets
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAssets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAssets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAssets
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAssets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAssets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAssets
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAssets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAssets(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAssets
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAssets(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
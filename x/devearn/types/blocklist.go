@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"sidechain/types"
+)
+
+// validateBlocklist checks that the blocklist addresses are valid hex addresses
+// without duplicates. An empty list is only valid in the genesis state.
+func validateBlocklist(addresses []string, allowEmpty bool) error {
+	if len(addresses) == 0 && !allowEmpty {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "addresses cannot be empty")
+	}
+
+	seen := make(map[common.Address]bool, len(addresses))
+	for _, addr := range addresses {
+		if err := types.ValidateAddress(addr); err != nil {
+			return err
+		}
+
+		address := common.HexToAddress(addr)
+		if seen[address] {
+			return fmt.Errorf("duplicated blocklist address %s", addr)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
@@ -12,7 +12,18 @@ import (
 
 const (
 	// Amino names
-	updateParamsName = "sidechain/devearn/MsgUpdateParams"
+	updateParamsName    = "sidechain/devearn/MsgUpdateParams"
+	registerDevEarnName = "sidechain/devearn/MsgRegisterDevEarn"
+	cancelDevEarnName   = "sidechain/devearn/MsgCancelDevEarn"
+	claimRewardsName    = "sidechain/devearn/MsgClaimDevEarnRewards"
+	updateOwnerName     = "sidechain/devearn/MsgUpdateDevEarnOwner"
+	setWithdrawAddrName = "sidechain/devearn/MsgSetDevEarnWithdrawAddress"
+	addToBlocklistName  = "sidechain/devearn/MsgAddToBlocklist"
+	removeBlocklistName = "sidechain/devearn/MsgRemoveFromBlocklist"
+	registerInfoName    = "sidechain/devearn/MsgRegisterDevEarnInfo"
+	cancelInfoName      = "sidechain/devearn/MsgCancelDevEarnInfo"
+	addAssetName        = "sidechain/devearn/MsgAddAsset"
+	removeAssetName     = "sidechain/devearn/MsgRemoveAsset"
 )
 
 var (
@@ -39,12 +50,27 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgRegisterDevEarn{},
+		&MsgCancelDevEarn{},
+		&MsgClaimDevEarnRewards{},
+		&MsgUpdateDevEarnOwner{},
+		&MsgSetDevEarnWithdrawAddress{},
+		&MsgAddToBlocklist{},
+		&MsgRemoveFromBlocklist{},
+		&MsgRegisterDevEarnInfo{},
+		&MsgCancelDevEarnInfo{},
+		&MsgAddAsset{},
+		&MsgRemoveAsset{},
 	)
 
+	// the legacy proposals are deprecated in favor of the authority gated Msgs
+	// but kept for compatibility
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
 		&RegisterDevEarnInfoProposal{},
 		&CancelDevEarnInfoProposal{},
+		&AddAssetToWhitelistProposal{},
+		&RemoveAssetFromWhitelistProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -52,4 +78,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgRegisterDevEarn{}, registerDevEarnName, nil)
+	cdc.RegisterConcrete(&MsgCancelDevEarn{}, cancelDevEarnName, nil)
+	cdc.RegisterConcrete(&MsgClaimDevEarnRewards{}, claimRewardsName, nil)
+	cdc.RegisterConcrete(&MsgUpdateDevEarnOwner{}, updateOwnerName, nil)
+	cdc.RegisterConcrete(&MsgSetDevEarnWithdrawAddress{}, setWithdrawAddrName, nil)
+	cdc.RegisterConcrete(&MsgAddToBlocklist{}, addToBlocklistName, nil)
+	cdc.RegisterConcrete(&MsgRemoveFromBlocklist{}, removeBlocklistName, nil)
+	cdc.RegisterConcrete(&MsgRegisterDevEarnInfo{}, registerInfoName, nil)
+	cdc.RegisterConcrete(&MsgCancelDevEarnInfo{}, cancelInfoName, nil)
+	cdc.RegisterConcrete(&MsgAddAsset{}, addAssetName, nil)
+	cdc.RegisterConcrete(&MsgRemoveAsset{}, removeAssetName, nil)
 }
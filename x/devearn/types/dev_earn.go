@@ -39,3 +39,12 @@ func (d DevEarnInfo) Validate() error {
 func (d DevEarnInfo) IsActive() bool {
 	return d.Epochs > 0
 }
+
+// RewardReceiver returns the hex address the rewards are accrued to, which is
+// the withdraw address if set and the owner otherwise
+func (d DevEarnInfo) RewardReceiver() string {
+	if d.WithdrawAddress != "" {
+		return d.WithdrawAddress
+	}
+	return d.OwnerAddress
+}
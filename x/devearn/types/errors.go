@@ -10,4 +10,9 @@ import (
 var (
 	ErrInternalDevEarn  = errorsmod.Register(ModuleName, 1099, "internal dev earn error")
 	ErrContractNotFound = errorsmod.Register(ModuleName, 1100, "contract is not fond,please check your contract address")
+	ErrNotDeployer      = errorsmod.Register(ModuleName, 1101, "signer is not the contract deployer")
+	ErrNoRewards        = errorsmod.Register(ModuleName, 1102, "no rewards to claim")
+	ErrNotOwner         = errorsmod.Register(ModuleName, 1103, "signer is not the contract owner")
+	ErrUnknownEpoch     = errorsmod.Register(ModuleName, 1104, "unknown reward epoch identifier")
+	ErrBlocked          = errorsmod.Register(ModuleName, 1105, "address is blocked from dev earn")
 )
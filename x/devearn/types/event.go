@@ -6,8 +6,35 @@ const (
 	EventTypeDistributeRewards        = "distribute_rewards"
 	EventTypeAddAssetToWhitelist      = "add_asset_whitelist"
 	EventTypeRemoveAssetFromWhitelist = "remove_asset_whitelist"
+	EventTypeFundRewardPool           = "fund_reward_pool"
+	EventTypeAccrueRewards            = "accrue_rewards"
+	EventTypeClaimRewards             = "claim_rewards"
+	EventTypeUpdateDevEarnOwner       = "update_dev_earn_owner"
+	EventTypeSetWithdrawAddress       = "set_withdraw_address"
+	EventTypeFundCommunityPool        = "fund_community_pool"
+	EventTypeSkipTvlAsset             = "skip_tvl_asset"
+	EventTypeAddToBlocklist           = "add_to_blocklist"
+	EventTypeRemoveFromBlocklist      = "remove_from_blocklist"
 
-	AttributeKeyContract = "contract"
-	AttributeKeyEpochs   = "epochs"
-	AttributeKeyAsset    = "assets"
+	AttributeKeyContract  = "contract"
+	AttributeKeyEpochs    = "epochs"
+	AttributeKeyAsset     = "assets"
+	AttributeKeyDeployer  = "deployer"
+	AttributeKeyOwner     = "owner"
+	AttributeKeySource    = "source"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyNewOwner  = "new_owner"
+	AttributeKeyWithdraw  = "withdraw_address"
+	AttributeKeyReason    = "reason"
+	AttributeKeyAddress   = "address"
+
+	RewardSourceInflation = "inflation"
+	RewardSourceFees      = "fees"
+
+	SkipReasonNoTokenPair = "no_token_pair"
+	SkipReasonNoPrice     = "no_price"
+	SkipReasonStalePrice  = "stale_price"
+	SkipReasonNoSupply    = "no_supply"
+
+	CancelReasonNotDeployed = "not_deployed"
 )
@@ -4,13 +4,16 @@ import (
 	context "context"
 	"math/big"
 
+	epochstypes "sidechain/x/epochs/types"
 	erc20types "sidechain/x/erc20/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
@@ -31,6 +34,10 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error
 }
@@ -38,6 +45,35 @@ type BankKeeper interface {
 type EvmKeeper interface {
 	GetParams(ctx sdk.Context) evmtypes.Params
 	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+	ChainID() *big.Int
+	GetNonce(ctx sdk.Context, addr common.Address) uint64
+	GetBaseFee(ctx sdk.Context, ethCfg *params.ChainConfig) *big.Int
+	EstimateGas(c context.Context, req *evmtypes.EthCallRequest) (*evmtypes.EstimateGasResponse, error)
+}
+
+// GovKeeper defines the expected gov keeper used to submit governance
+// proposals in simulations
+type GovKeeper interface {
+	GetProposalID(ctx sdk.Context) (uint64, error)
+	GetDepositParams(ctx sdk.Context) govv1.DepositParams
+	GetVotingParams(ctx sdk.Context) govv1.VotingParams
+}
+
+// StakingKeeper defines the expected staking keeper used to retrieve the bond denom
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+}
+
+// DistributionKeeper defines the expected distribution keeper used to fund the
+// community pool
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// EpochsKeeper defines the expected epochs keeper used to get the duration of
+// the reward epoch
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, bool)
 }
 
 type OracleKeeper interface {
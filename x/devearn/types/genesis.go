@@ -2,6 +2,10 @@ package types
 
 import (
 	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sidetypes "sidechain/types"
 )
 
 // DefaultIndex is the default global index
@@ -19,6 +23,19 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Check for invalid or duplicated contracts
+	contractMap := make(map[string]bool)
+	for _, devEarnInfo := range gs.DevEarnInfos {
+		if err := devEarnInfo.Validate(); err != nil {
+			return err
+		}
+		contract := common.HexToAddress(devEarnInfo.Contract).Hex()
+		if contractMap[contract] {
+			return fmt.Errorf("duplicated dev earn info for contract %s", devEarnInfo.Contract)
+		}
+		contractMap[contract] = true
+	}
+
 	// Check for duplicated denom in assets
 	assetsIdMap := make(map[string]bool)
 	for _, elem := range gs.AssetsList {
@@ -27,6 +44,112 @@ func (gs GenesisState) Validate() error {
 		}
 		assetsIdMap[elem.Denom] = true
 	}
+
+	// Check for invalid or duplicated accrued rewards
+	accruedMap := make(map[string]bool)
+	for _, accrued := range gs.AccruedRewards {
+		if err := accrued.Validate(); err != nil {
+			return err
+		}
+		key := accrued.OwnerAddress + accrued.Contract
+		if accruedMap[key] {
+			return fmt.Errorf("duplicated accrued rewards for owner %s and contract %s", accrued.OwnerAddress, accrued.Contract)
+		}
+		accruedMap[key] = true
+	}
+
+	// Check for invalid or duplicated reward records
+	epochMap := make(map[int64]bool)
+	for _, record := range gs.EpochRewards {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if epochMap[record.Epoch] {
+			return fmt.Errorf("duplicated reward record for epoch %d", record.Epoch)
+		}
+		epochMap[record.Epoch] = true
+	}
+
+	contractRewardsMap := make(map[string]bool)
+	for _, record := range gs.ContractRewards {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%d/%s", record.Epoch, common.HexToAddress(record.Contract).Hex())
+		if contractRewardsMap[key] {
+			return fmt.Errorf("duplicated reward record for contract %s in epoch %d", record.Contract, record.Epoch)
+		}
+		contractRewardsMap[key] = true
+	}
+
+	if gs.DistributionProgress != nil {
+		if err := gs.DistributionProgress.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Pending gas is only tracked while a distribution is running
+	if len(gs.PendingGasMeters) > 0 && gs.DistributionProgress == nil {
+		return fmt.Errorf("pending gas meters without a running distribution")
+	}
+	gasMeterMap := make(map[string]bool)
+	for _, gasMeter := range gs.PendingGasMeters {
+		if err := sidetypes.ValidateAddress(gasMeter.Contract); err != nil {
+			return err
+		}
+		contract := common.HexToAddress(gasMeter.Contract).Hex()
+		if gasMeterMap[contract] {
+			return fmt.Errorf("duplicated pending gas meter for contract %s", gasMeter.Contract)
+		}
+		gasMeterMap[contract] = true
+	}
+
+	// Check for invalid or duplicated tvl samples
+	accumulatorMap := make(map[string]bool)
+	for _, accumulator := range gs.TvlAccumulators {
+		if err := sidetypes.ValidateAddress(accumulator.Contract); err != nil {
+			return err
+		}
+		if err := accumulator.Accumulator.Validate(); err != nil {
+			return err
+		}
+		contract := common.HexToAddress(accumulator.Contract).Hex()
+		if accumulatorMap[contract] {
+			return fmt.Errorf("duplicated tvl accumulator for contract %s", accumulator.Contract)
+		}
+		accumulatorMap[contract] = true
+	}
+
+	if gs.TotalTvlAccumulator != nil {
+		if err := gs.TotalTvlAccumulator.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if gs.TvlSampleCursor != "" {
+		if err := sidetypes.ValidateAddress(gs.TvlSampleCursor); err != nil {
+			return err
+		}
+	}
+
+	// Prices are only kept for the whitelisted assets
+	priceMap := make(map[string]bool)
+	for _, price := range gs.AssetPrices {
+		if err := price.Validate(); err != nil {
+			return err
+		}
+		if !assetsIdMap[price.Denom] {
+			return fmt.Errorf("price for asset %s that is not whitelisted", price.Denom)
+		}
+		if priceMap[price.Denom] {
+			return fmt.Errorf("duplicated price for asset %s", price.Denom)
+		}
+		priceMap[price.Denom] = true
+	}
+
+	if err := validateBlocklist(gs.Blocklist, true); err != nil {
+		return err
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 	return gs.Params.Validate()
 }
@@ -30,6 +30,30 @@ type GenesisState struct {
 	DevEarnInfos []DevEarnInfo `protobuf:"bytes,2,rep,name=devEarnInfos,proto3" json:"devEarnInfos"`
 	// Assets is a list of whitelisted assets for tvl calculation
 	AssetsList []Assets `protobuf:"bytes,3,rep,name=assetsList,proto3" json:"assetsList"`
+	// accrued_rewards are the unclaimed rewards
+	AccruedRewards []AccruedRewards `protobuf:"bytes,4,rep,name=accrued_rewards,json=accruedRewards,proto3" json:"accrued_rewards"`
+	// blocklist are the hex addresses of the contracts and deployers excluded
+	// from dev earn
+	Blocklist []string `protobuf:"bytes,5,rep,name=blocklist,proto3" json:"blocklist,omitempty"`
+	// epoch_rewards are the reward records of the retained epochs
+	EpochRewards []EpochRewardRecord `protobuf:"bytes,6,rep,name=epoch_rewards,json=epochRewards,proto3" json:"epoch_rewards"`
+	// contract_rewards are the rewards earned by each contract in the retained
+	// epochs
+	ContractRewards []ContractRewardRecord `protobuf:"bytes,7,rep,name=contract_rewards,json=contractRewards,proto3" json:"contract_rewards"`
+	// distribution_progress is the reward distribution being settled, if any
+	DistributionProgress *DistributionProgress `protobuf:"bytes,8,opt,name=distribution_progress,json=distributionProgress,proto3" json:"distribution_progress,omitempty"`
+	// pending_gas_meters are the gas used by the contracts that are not settled
+	// yet by the running distribution
+	PendingGasMeters []PendingGasMeter `protobuf:"bytes,9,rep,name=pending_gas_meters,json=pendingGasMeters,proto3" json:"pending_gas_meters"`
+	// tvl_accumulators are the TVL samples of the contracts in the current epoch
+	TvlAccumulators []ContractTvlAccumulator `protobuf:"bytes,10,rep,name=tvl_accumulators,json=tvlAccumulators,proto3" json:"tvl_accumulators"`
+	// total_tvl_accumulator is the total TVL samples in the current epoch, if any
+	TotalTvlAccumulator *TvlAccumulator `protobuf:"bytes,11,opt,name=total_tvl_accumulator,json=totalTvlAccumulator,proto3" json:"total_tvl_accumulator,omitempty"`
+	// tvl_sample_cursor is the hex address of the next contract to sample if a
+	// sampling round is running
+	TvlSampleCursor string `protobuf:"bytes,12,opt,name=tvl_sample_cursor,json=tvlSampleCursor,proto3" json:"tvl_sample_cursor,omitempty"`
+	// asset_prices are the last known oracle prices of the whitelisted assets
+	AssetPrices []AssetPrice `protobuf:"bytes,13,rep,name=asset_prices,json=assetPrices,proto3" json:"asset_prices"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +110,76 @@ func (m *GenesisState) GetAssetsList() []Assets {
 	return nil
 }
 
+func (m *GenesisState) GetAccruedRewards() []AccruedRewards {
+	if m != nil {
+		return m.AccruedRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlocklist() []string {
+	if m != nil {
+		return m.Blocklist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochRewards() []EpochRewardRecord {
+	if m != nil {
+		return m.EpochRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractRewards() []ContractRewardRecord {
+	if m != nil {
+		return m.ContractRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionProgress() *DistributionProgress {
+	if m != nil {
+		return m.DistributionProgress
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingGasMeters() []PendingGasMeter {
+	if m != nil {
+		return m.PendingGasMeters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTvlAccumulators() []ContractTvlAccumulator {
+	if m != nil {
+		return m.TvlAccumulators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalTvlAccumulator() *TvlAccumulator {
+	if m != nil {
+		return m.TotalTvlAccumulator
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTvlSampleCursor() string {
+	if m != nil {
+		return m.TvlSampleCursor
+	}
+	return ""
+}
+
+func (m *GenesisState) GetAssetPrices() []AssetPrice {
+	if m != nil {
+		return m.AssetPrices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "sidechain.devearn.GenesisState")
 }
@@ -93,23 +187,42 @@ func init() {
 func init() { proto.RegisterFile("sidechain/devearn/genesis.proto", fileDescriptor_918c1c313564b3ef) }
 
 var fileDescriptor_918c1c313564b3ef = []byte{
-	// 243 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2f, 0xce, 0x4c, 0x49,
-	0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0x49, 0x2d, 0x4b, 0x4d, 0x2c, 0xca, 0xd3, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x2b, 0xd0,
-	0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58, 0x10, 0x85, 0x52,
-	0x72, 0x98, 0x26, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x16, 0xe3, 0x96, 0x4f, 0x2c, 0x2e, 0x4e, 0x2d,
-	0x81, 0xca, 0x2b, 0x5d, 0x62, 0xe4, 0xe2, 0x71, 0x87, 0x58, 0x1d, 0x5c, 0x92, 0x58, 0x92, 0x2a,
-	0x64, 0xce, 0xc5, 0x06, 0x31, 0x40, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x52, 0x0f, 0xc3,
-	0x29, 0x7a, 0x01, 0x60, 0x05, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x95, 0x0b, 0x79,
-	0x70, 0xf1, 0xa4, 0xa4, 0x96, 0xb9, 0x26, 0x16, 0xe5, 0x79, 0xe6, 0xa5, 0xe5, 0x17, 0x4b, 0x30,
-	0x29, 0x30, 0x6b, 0x70, 0x1b, 0xc9, 0x61, 0xd1, 0xee, 0x82, 0x50, 0x06, 0x35, 0x03, 0x45, 0xa7,
-	0x90, 0x3d, 0x17, 0x17, 0xc4, 0x8d, 0x3e, 0x99, 0xc5, 0x25, 0x12, 0xcc, 0x60, 0x73, 0xb0, 0x39,
-	0xc3, 0x11, 0xac, 0x08, 0x6a, 0x04, 0x92, 0x16, 0x27, 0xe3, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0x92, 0x44, 0x04, 0x47, 0x05, 0x3c, 0x40, 0x4a, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0x01, 0x62, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x99, 0x8d, 0x33, 0x94, 0x9c,
-	0x01, 0x00, 0x00,
+	// 546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x63, 0x52, 0x5a, 0xb2, 0x49, 0x69, 0xba, 0xb4, 0x92, 0x5b, 0x81, 0x6b, 0x2a, 0x24,
+	0x02, 0x87, 0x44, 0x6a, 0x0f, 0x1c, 0x51, 0xff, 0x51, 0x90, 0x40, 0x44, 0x2e, 0x20, 0x54, 0x21,
+	0x59, 0x9b, 0xf5, 0xe0, 0x5a, 0x38, 0x5e, 0x6b, 0x67, 0x13, 0xe0, 0x2d, 0x78, 0xac, 0x8a, 0x53,
+	0x8f, 0x9c, 0x10, 0x4a, 0x5e, 0x04, 0x79, 0xbd, 0x49, 0x6a, 0x6a, 0x73, 0xb3, 0xbe, 0xf9, 0xcd,
+	0x37, 0xdf, 0x58, 0xa3, 0x25, 0x3b, 0x18, 0x05, 0xc0, 0x2f, 0x58, 0x94, 0xf4, 0x02, 0x18, 0x03,
+	0x93, 0x49, 0x2f, 0x84, 0x04, 0x30, 0xc2, 0x6e, 0x2a, 0x85, 0x12, 0x74, 0x7d, 0x0e, 0x74, 0x0d,
+	0xb0, 0xbd, 0x11, 0x8a, 0x50, 0xe8, 0x6a, 0x2f, 0xfb, 0xca, 0xc1, 0x6d, 0xe7, 0xa6, 0x53, 0xca,
+	0x24, 0x1b, 0x62, 0x75, 0x9d, 0x21, 0x82, 0x9a, 0xd5, 0x4b, 0x92, 0x48, 0xf8, 0xca, 0x64, 0x60,
+	0x80, 0xdd, 0x9f, 0x2b, 0xa4, 0x75, 0x9a, 0x67, 0x3b, 0x53, 0x4c, 0x01, 0x7d, 0x46, 0x96, 0xf3,
+	0x09, 0xb6, 0xe5, 0x5a, 0x9d, 0xe6, 0xde, 0x56, 0xf7, 0x46, 0xd6, 0x6e, 0x5f, 0x03, 0x87, 0x4b,
+	0x97, 0xbf, 0x77, 0x6a, 0x9e, 0xc1, 0xe9, 0x4b, 0xd2, 0x0a, 0x60, 0x7c, 0xc2, 0x64, 0xf2, 0x2a,
+	0xf9, 0x2c, 0xd0, 0xbe, 0xe5, 0xd6, 0x3b, 0xcd, 0x3d, 0xa7, 0xa4, 0xfd, 0x78, 0x81, 0x19, 0x8f,
+	0x42, 0x27, 0x7d, 0x4e, 0x48, 0xbe, 0xc4, 0xeb, 0x08, 0x95, 0x5d, 0x77, 0xeb, 0x15, 0x31, 0x0e,
+	0x34, 0x64, 0x2c, 0xae, 0xb5, 0xd0, 0x3e, 0x59, 0x63, 0x9c, 0xcb, 0x11, 0x04, 0xbe, 0xd9, 0xd6,
+	0x5e, 0xd2, 0x2e, 0x0f, 0xcb, 0x5c, 0x72, 0xd2, 0xcb, 0x41, 0xe3, 0x76, 0x97, 0x15, 0x54, 0x7a,
+	0x9f, 0x34, 0x06, 0xb1, 0xe0, 0x5f, 0xe2, 0x2c, 0xd1, 0x6d, 0xb7, 0xde, 0x69, 0x78, 0x0b, 0x81,
+	0xbe, 0x25, 0xab, 0x90, 0x0a, 0x7e, 0x31, 0x9f, 0xb6, 0xac, 0xa7, 0x3d, 0x2a, 0x99, 0x76, 0x92,
+	0x71, 0xb9, 0xab, 0x07, 0x5c, 0xc8, 0x60, 0xf6, 0x07, 0x60, 0x51, 0x40, 0xfa, 0x91, 0xb4, 0xb9,
+	0x48, 0x94, 0x64, 0x5c, 0xcd, 0x3d, 0x57, 0xb4, 0xe7, 0xe3, 0x12, 0xcf, 0x23, 0x83, 0x96, 0xd8,
+	0xae, 0xf1, 0x42, 0x0d, 0xe9, 0x27, 0xb2, 0x19, 0x44, 0xa8, 0x64, 0x34, 0x18, 0xa9, 0x48, 0x24,
+	0x7e, 0x2a, 0x45, 0x28, 0x01, 0xd1, 0xbe, 0xe3, 0x5a, 0x15, 0xf6, 0xc7, 0xd7, 0xf8, 0xbe, 0xc1,
+	0xbd, 0x8d, 0xa0, 0x44, 0xa5, 0x1f, 0x08, 0x4d, 0x21, 0x09, 0xa2, 0x24, 0xf4, 0x43, 0x86, 0xfe,
+	0x10, 0x14, 0x48, 0xb4, 0x1b, 0x3a, 0xf9, 0x6e, 0xd9, 0x21, 0xe5, 0xf0, 0x29, 0xc3, 0x37, 0x19,
+	0x6a, 0x42, 0xb7, 0xd3, 0xa2, 0x8c, 0xf4, 0x9c, 0xb4, 0xd5, 0x38, 0xf6, 0x19, 0xe7, 0xa3, 0xe1,
+	0x28, 0x66, 0x4a, 0x48, 0xb4, 0x89, 0x76, 0x7d, 0xf2, 0x9f, 0xff, 0xf1, 0x6e, 0x1c, 0x1f, 0x2c,
+	0x3a, 0x66, 0x7f, 0x44, 0x15, 0x54, 0xa4, 0xef, 0xc9, 0xa6, 0x12, 0x8a, 0xc5, 0xfe, 0x3f, 0x13,
+	0xec, 0xa6, 0x6b, 0x55, 0x9c, 0x4c, 0xd1, 0xd8, 0xbb, 0xa7, 0xfb, 0x8b, 0x22, 0x7d, 0x4a, 0xd6,
+	0x33, 0x43, 0x64, 0xc3, 0x34, 0x06, 0x9f, 0x8f, 0x24, 0x0a, 0x69, 0xb7, 0x5c, 0xab, 0xd3, 0xd0,
+	0x11, 0xce, 0xb4, 0x7e, 0xa4, 0x65, 0xfa, 0x82, 0xb4, 0xf4, 0xf5, 0xfa, 0xa9, 0x8c, 0x38, 0xa0,
+	0xbd, 0xaa, 0x57, 0x7b, 0x50, 0x75, 0xf2, 0xfd, 0x8c, 0x32, 0xeb, 0x34, 0xd9, 0x5c, 0xc1, 0xc3,
+	0xfd, 0xcb, 0x89, 0x63, 0x5d, 0x4d, 0x1c, 0xeb, 0xcf, 0xc4, 0xb1, 0x7e, 0x4c, 0x9d, 0xda, 0xd5,
+	0xd4, 0xa9, 0xfd, 0x9a, 0x3a, 0xb5, 0xf3, 0xad, 0xc5, 0x3b, 0xf0, 0x6d, 0xfe, 0x12, 0xa8, 0xef,
+	0x29, 0xe0, 0x60, 0x59, 0x3f, 0x04, 0xfb, 0x7f, 0x07, 0x00, 0x6b, 0x12, 0x3c, 0xf7, 0xb5, 0x04,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,6 +245,130 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AssetPrices) > 0 {
+		for iNdEx := len(m.AssetPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.TvlSampleCursor) > 0 {
+		i -= len(m.TvlSampleCursor)
+		copy(dAtA[i:], m.TvlSampleCursor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TvlSampleCursor)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.TotalTvlAccumulator != nil {
+		{
+			size, err := m.TotalTvlAccumulator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.TvlAccumulators) > 0 {
+		for iNdEx := len(m.TvlAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TvlAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PendingGasMeters) > 0 {
+		for iNdEx := len(m.PendingGasMeters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingGasMeters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.DistributionProgress != nil {
+		{
+			size, err := m.DistributionProgress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ContractRewards) > 0 {
+		for iNdEx := len(m.ContractRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.EpochRewards) > 0 {
+		for iNdEx := len(m.EpochRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Blocklist) > 0 {
+		for iNdEx := len(m.Blocklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Blocklist[iNdEx])
+			copy(dAtA[i:], m.Blocklist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Blocklist[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AccruedRewards) > 0 {
+		for iNdEx := len(m.AccruedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.AssetsList) > 0 {
 		for iNdEx := len(m.AssetsList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -204,6 +441,60 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AccruedRewards) > 0 {
+		for _, e := range m.AccruedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Blocklist) > 0 {
+		for _, s := range m.Blocklist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochRewards) > 0 {
+		for _, e := range m.EpochRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractRewards) > 0 {
+		for _, e := range m.ContractRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DistributionProgress != nil {
+		l = m.DistributionProgress.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PendingGasMeters) > 0 {
+		for _, e := range m.PendingGasMeters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TvlAccumulators) > 0 {
+		for _, e := range m.TvlAccumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TotalTvlAccumulator != nil {
+		l = m.TotalTvlAccumulator.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.TvlSampleCursor)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AssetPrices) > 0 {
+		for _, e := range m.AssetPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -343,6 +634,346 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccruedRewards = append(m.AccruedRewards, AccruedRewards{})
+			if err := m.AccruedRewards[len(m.AccruedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocklist = append(m.Blocklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochRewards = append(m.EpochRewards, EpochRewardRecord{})
+			if err := m.EpochRewards[len(m.EpochRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractRewards = append(m.ContractRewards, ContractRewardRecord{})
+			if err := m.ContractRewards[len(m.ContractRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProgress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DistributionProgress == nil {
+				m.DistributionProgress = &DistributionProgress{}
+			}
+			if err := m.DistributionProgress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingGasMeters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingGasMeters = append(m.PendingGasMeters, PendingGasMeter{})
+			if err := m.PendingGasMeters[len(m.PendingGasMeters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TvlAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TvlAccumulators = append(m.TvlAccumulators, ContractTvlAccumulator{})
+			if err := m.TvlAccumulators[len(m.TvlAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTvlAccumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalTvlAccumulator == nil {
+				m.TotalTvlAccumulator = &TvlAccumulator{}
+			}
+			if err := m.TotalTvlAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TvlSampleCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TvlSampleCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetPrices = append(m.AssetPrices, AssetPrice{})
+			if err := m.AssetPrices[len(m.AssetPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
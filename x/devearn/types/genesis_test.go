@@ -2,9 +2,12 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"sidechain/x/devearn/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -51,6 +54,229 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated dev earn infos",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DevEarnInfos: []types.DevEarnInfo{
+					types.NewDevEarn(common.HexToAddress("0x1111111111111111111111111111111111111111"), 0, 5, "0x2222222222222222222222222222222222222222"),
+					types.NewDevEarn(common.HexToAddress("0x1111111111111111111111111111111111111111"), 0, 5, "0x2222222222222222222222222222222222222222"),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "dev earn info without epochs",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DevEarnInfos: []types.DevEarnInfo{
+					types.NewDevEarn(common.HexToAddress("0x1111111111111111111111111111111111111111"), 0, 0, "0x2222222222222222222222222222222222222222"),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid blocklist",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Blocklist: []string{
+					"0x1111111111111111111111111111111111111111",
+					"0x2222222222222222222222222222222222222222",
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated blocklist address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Blocklist: []string{
+					"0x1111111111111111111111111111111111111111",
+					"0x1111111111111111111111111111111111111111",
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid blocklist address",
+			genState: &types.GenesisState{
+				Params:    types.DefaultParams(),
+				Blocklist: []string{"side1invalid"},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid reward records",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				EpochRewards: []types.EpochRewardRecord{{Epoch: 1}, {Epoch: 2}},
+				ContractRewards: []types.ContractRewardRecord{
+					{
+						Epoch:    1,
+						Contract: "0x1111111111111111111111111111111111111111",
+						Receiver: "0x2222222222222222222222222222222222222222",
+						GasShare: sdk.OneDec(),
+						TvlRatio: sdk.ZeroDec(),
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated epoch reward records",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				EpochRewards: []types.EpochRewardRecord{{Epoch: 1}, {Epoch: 1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated contract reward records",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractRewards: []types.ContractRewardRecord{
+					{
+						Epoch:    1,
+						Contract: "0x1111111111111111111111111111111111111111",
+						Receiver: "0x2222222222222222222222222222222222222222",
+						GasShare: sdk.OneDec(),
+						TvlRatio: sdk.ZeroDec(),
+					},
+					{
+						Epoch:    1,
+						Contract: "0x1111111111111111111111111111111111111111",
+						Receiver: "0x2222222222222222222222222222222222222222",
+						GasShare: sdk.ZeroDec(),
+						TvlRatio: sdk.ZeroDec(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "contract reward record with invalid gas share",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractRewards: []types.ContractRewardRecord{
+					{
+						Epoch:    1,
+						Contract: "0x1111111111111111111111111111111111111111",
+						Receiver: "0x2222222222222222222222222222222222222222",
+						GasShare: sdk.NewDec(2),
+						TvlRatio: sdk.ZeroDec(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending gas meters without a running distribution",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingGasMeters: []types.PendingGasMeter{
+					{Contract: "0x1111111111111111111111111111111111111111", GasMeter: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "distribution progress with an invalid cursor",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DistributionProgress: &types.DistributionProgress{
+					Epoch:    1,
+					Cursor:   []byte{1},
+					TotalTvl: sdk.ZeroDec(),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "running distribution with pending gas meters",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DistributionProgress: &types.DistributionProgress{
+					Epoch:    1,
+					TotalTvl: sdk.ZeroDec(),
+				},
+				PendingGasMeters: []types.PendingGasMeter{
+					{Contract: "0x1111111111111111111111111111111111111111", GasMeter: 1},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated tvl accumulators",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TvlAccumulators: []types.ContractTvlAccumulator{
+					{
+						Contract:    "0x1111111111111111111111111111111111111111",
+						Accumulator: types.NewTvlAccumulator(sdk.OneDec(), time.Unix(0, 0)),
+					},
+					{
+						Contract:    "0x1111111111111111111111111111111111111111",
+						Accumulator: types.NewTvlAccumulator(sdk.OneDec(), time.Unix(0, 0)),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "negative total tvl samples",
+			genState: &types.GenesisState{
+				Params:              types.DefaultParams(),
+				TotalTvlAccumulator: &types.TvlAccumulator{WeightedTvl: sdk.NewDec(-1), LastTvl: sdk.OneDec()},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid tvl sample cursor",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				TvlSampleCursor: "0x1",
+			},
+			valid: false,
+		},
+		{
+			desc: "price of an asset that is not whitelisted",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AssetPrices: []types.AssetPrice{
+					{Denom: "aside", Price: sdk.OneDec()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "non-positive asset price",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				AssetsList: []types.Assets{{Denom: "aside"}},
+				AssetPrices: []types.AssetPrice{
+					{Denom: "aside", Price: sdk.ZeroDec()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid tvl samples and asset prices",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				AssetsList: []types.Assets{{Denom: "aside"}},
+				TvlAccumulators: []types.ContractTvlAccumulator{
+					{
+						Contract:    "0x1111111111111111111111111111111111111111",
+						Accumulator: types.NewTvlAccumulator(sdk.OneDec(), time.Unix(0, 0)),
+					},
+				},
+				TvlSampleCursor: "0x1111111111111111111111111111111111111111",
+				AssetPrices: []types.AssetPrice{
+					{Denom: "aside", Price: sdk.OneDec()},
+				},
+			},
+			valid: true,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -28,13 +29,86 @@ func init() {
 
 const (
 	prefixDevEarn = iota + 1
+	prefixAccruedRewards
+	prefixContractAccruedRewards
+	prefixEpochRewards
+	prefixEpochContractRewards
+	prefixContractRewardHistory
+	prefixDistributionProgress
+	prefixPendingGasMeter
+	prefixTvlAccumulator
+	prefixTotalTvlAccumulator
+	prefixAssetPrice
+	prefixBlocklist
+	prefixSenderGas
+	prefixTvlSampleCursor
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixDevEarn = []byte{prefixDevEarn}
+	KeyPrefixDevEarn                = []byte{prefixDevEarn}
+	KeyPrefixAccruedRewards         = []byte{prefixAccruedRewards}
+	KeyPrefixContractAccruedRewards = []byte{prefixContractAccruedRewards}
+	KeyPrefixEpochRewards           = []byte{prefixEpochRewards}
+	KeyPrefixEpochContractRewards   = []byte{prefixEpochContractRewards}
+	KeyPrefixContractRewardHistory  = []byte{prefixContractRewardHistory}
+	KeyPrefixDistributionProgress   = []byte{prefixDistributionProgress}
+	KeyPrefixPendingGasMeter        = []byte{prefixPendingGasMeter}
+	KeyPrefixTvlAccumulator         = []byte{prefixTvlAccumulator}
+	KeyPrefixTotalTvlAccumulator    = []byte{prefixTotalTvlAccumulator}
+	KeyPrefixAssetPrice             = []byte{prefixAssetPrice}
+	KeyPrefixBlocklist              = []byte{prefixBlocklist}
+	KeyPrefixSenderGas              = []byte{prefixSenderGas}
+	KeyPrefixTvlSampleCursor        = []byte{prefixTvlSampleCursor}
 )
 
+// GetAccruedRewardsKey returns the key of the rewards accrued to an owner by a
+// contract
+func GetAccruedRewardsKey(owner, contract common.Address) []byte {
+	return append(owner.Bytes(), contract.Bytes()...)
+}
+
+// GetContractAccruedRewardsKey returns the key indexing the rewards accrued to
+// an owner by the contract
+func GetContractAccruedRewardsKey(contract, owner common.Address) []byte {
+	return append(contract.Bytes(), owner.Bytes()...)
+}
+
+// GetEpochRewardsKey returns the key of the reward record of an epoch
+func GetEpochRewardsKey(epoch int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(epoch))
+}
+
+// GetEpochContractRewardsKey returns the key of the rewards earned by a
+// contract in an epoch
+func GetEpochContractRewardsKey(epoch int64, contract common.Address) []byte {
+	return append(GetEpochRewardsKey(epoch), contract.Bytes()...)
+}
+
+// GetContractRewardHistoryKey returns the key indexing the rewards earned by
+// the contract in an epoch
+func GetContractRewardHistoryKey(contract common.Address, epoch int64) []byte {
+	return append(contract.Bytes(), GetEpochRewardsKey(epoch)...)
+}
+
+// GetTvlAccumulatorKey returns the key of the TVL samples of a contract
+func GetTvlAccumulatorKey(contract common.Address) []byte {
+	return append(KeyPrefixTvlAccumulator, contract.Bytes()...)
+}
+
+// GetSenderGasEpochKey returns the key prefix of the gas the senders credited
+// to the contracts during an epoch
+func GetSenderGasEpochKey(epoch int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(epoch))
+}
+
+// GetSenderGasKey returns the key of the gas a sender credited to a contract
+// during an epoch
+func GetSenderGasKey(epoch int64, contract, sender common.Address) []byte {
+	key := append(GetSenderGasEpochKey(epoch), contract.Bytes()...)
+	return append(key, sender.Bytes()...)
+}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	epochstypes "sidechain/x/epochs/types"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -12,10 +13,21 @@ import (
 
 // ParamsKey params store key
 var (
-	DefaultEnableDevEarn              bool    = true
-	DefaultRewardEpochIdentifier      string  = epochstypes.WeekEpochID
-	DefaultDevEarnInflationPercentage sdk.Dec = sdk.NewDecWithPrec(5, 2)
-	DefaultTvlShare                   uint64  = 1000 // 1000 is equivalent to 10%
+	DefaultEnableDevEarn              bool           = true
+	DefaultRewardEpochIdentifier      string         = epochstypes.WeekEpochID
+	DefaultDevEarnInflationPercentage sdk.Dec        = sdk.NewDecWithPrec(5, 2)
+	DefaultTvlShare                   uint64         = 1000 // 1000 is equivalent to 10%
+	DefaultRegistrationDeposit        sdk.Coins             // no deposit is required by default
+	DefaultGasAttribution             GasAttribution = GAS_ATTRIBUTION_TOP_LEVEL
+	DefaultRewardDenoms               []string       // the staking bond denom is used by default
+	DefaultFeeShare                   sdk.Dec        = sdk.ZeroDec()
+	DefaultRewardHistoryRetention     uint64         = 52 // one year of weekly epochs
+	DefaultMaxDistributionsPerBlock   uint64         = 100
+	DefaultTvlSampleInterval          uint64         = 600 // about an hour of 6s blocks
+	DefaultMaxPriceStaleness          time.Duration  = 24 * time.Hour
+	DefaultMaxSenderGasPerEpoch       uint64         // no cap by default
+	DefaultSuccessfulTxsOnly          bool           // failed receipts are credited by default
+	DefaultMinGasPrice                sdk.Int        = sdk.ZeroInt()
 )
 
 var (
@@ -24,6 +36,17 @@ var (
 	ParamStoreKeyRewardEpochIdentifier      = []byte("EarnEpochIdentifier")
 	ParamStoreKeyDevEarnInflationPercentage = []byte("EarnInflationPercentage")
 	ParamStoreKeyTvlShare                   = []byte("TvlSharePercentage")
+	ParamStoreKeyRegistrationDeposit        = []byte("RegistrationDeposit")
+	ParamStoreKeyGasAttribution             = []byte("GasAttribution")
+	ParamStoreKeyRewardDenoms               = []byte("RewardDenoms")
+	ParamStoreKeyFeeShare                   = []byte("FeeShare")
+	ParamStoreKeyRewardHistoryRetention     = []byte("RewardHistoryRetention")
+	ParamStoreKeyMaxDistributionsPerBlock   = []byte("MaxDistributionsPerBlock")
+	ParamStoreKeyTvlSampleInterval          = []byte("TvlSampleInterval")
+	ParamStoreKeyMaxPriceStaleness          = []byte("MaxPriceStaleness")
+	ParamStoreKeyMaxSenderGasPerEpoch       = []byte("MaxSenderGasPerEpoch")
+	ParamStoreKeySuccessfulTxsOnly          = []byte("SuccessfulTxsOnly")
+	ParamStoreKeyMinGasPrice                = []byte("MinGasPrice")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -37,12 +60,34 @@ func NewParams(
 	rewardEpochIdentifier string,
 	devEarnInflationAPR sdk.Dec,
 	tvlShare uint64,
+	registrationDeposit sdk.Coins,
+	gasAttribution GasAttribution,
+	rewardDenoms []string,
+	feeShare sdk.Dec,
+	rewardHistoryRetention uint64,
+	maxDistributionsPerBlock uint64,
+	tvlSampleInterval uint64,
+	maxPriceStaleness time.Duration,
+	maxSenderGasPerEpoch uint64,
+	successfulTxsOnly bool,
+	minGasPrice sdk.Int,
 ) Params {
 	return Params{
-		EnableDevEarn:         enableDevEarn,
-		RewardEpochIdentifier: rewardEpochIdentifier,
-		DevEarnInflation_APR:  devEarnInflationAPR,
-		TvlShare:              tvlShare,
+		EnableDevEarn:            enableDevEarn,
+		RewardEpochIdentifier:    rewardEpochIdentifier,
+		DevEarnInflation_APR:     devEarnInflationAPR,
+		TvlShare:                 tvlShare,
+		RegistrationDeposit:      registrationDeposit,
+		GasAttribution:           gasAttribution,
+		RewardDenoms:             rewardDenoms,
+		FeeShare:                 feeShare,
+		RewardHistoryRetention:   rewardHistoryRetention,
+		MaxDistributionsPerBlock: maxDistributionsPerBlock,
+		TvlSampleInterval:        tvlSampleInterval,
+		MaxPriceStaleness:        maxPriceStaleness,
+		MaxSenderGasPerEpoch:     maxSenderGasPerEpoch,
+		SuccessfulTxsOnly:        successfulTxsOnly,
+		MinGasPrice:              minGasPrice,
 	}
 }
 
@@ -53,6 +98,17 @@ func DefaultParams() Params {
 		DefaultRewardEpochIdentifier,
 		DefaultDevEarnInflationPercentage,
 		DefaultTvlShare,
+		DefaultRegistrationDeposit,
+		DefaultGasAttribution,
+		DefaultRewardDenoms,
+		DefaultFeeShare,
+		DefaultRewardHistoryRetention,
+		DefaultMaxDistributionsPerBlock,
+		DefaultTvlSampleInterval,
+		DefaultMaxPriceStaleness,
+		DefaultMaxSenderGasPerEpoch,
+		DefaultSuccessfulTxsOnly,
+		DefaultMinGasPrice,
 	)
 }
 
@@ -63,6 +119,17 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyRewardEpochIdentifier, &p.RewardEpochIdentifier, epochstypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(ParamStoreKeyDevEarnInflationPercentage, &p.DevEarnInflation_APR, validatePercentage),
 		paramtypes.NewParamSetPair(ParamStoreKeyTvlShare, &p.TvlShare, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyRegistrationDeposit, &p.RegistrationDeposit, validateCoins),
+		paramtypes.NewParamSetPair(ParamStoreKeyGasAttribution, &p.GasAttribution, validateGasAttribution),
+		paramtypes.NewParamSetPair(ParamStoreKeyRewardDenoms, &p.RewardDenoms, validateDenoms),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeShare, &p.FeeShare, validateFeeShare),
+		paramtypes.NewParamSetPair(ParamStoreKeyRewardHistoryRetention, &p.RewardHistoryRetention, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxDistributionsPerBlock, &p.MaxDistributionsPerBlock, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyTvlSampleInterval, &p.TvlSampleInterval, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxPriceStaleness, &p.MaxPriceStaleness, validateDuration),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxSenderGasPerEpoch, &p.MaxSenderGasPerEpoch, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeySuccessfulTxsOnly, &p.SuccessfulTxsOnly, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
 	}
 }
 
@@ -92,6 +159,99 @@ func validateUint64(v interface{}) error {
 	return nil
 }
 
+// validateCoins validates the RegistrationDeposit param
+func validateCoins(v interface{}) error {
+	coins, ok := v.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return coins.Validate()
+}
+
+// validateGasAttribution validates the GasAttribution param
+func validateGasAttribution(v interface{}) error {
+	mode, ok := v.(GasAttribution)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if _, ok := GasAttribution_name[int32(mode)]; !ok {
+		return fmt.Errorf("invalid gas attribution mode: %d", mode)
+	}
+
+	return nil
+}
+
+// validateDenoms validates the RewardDenoms param
+func validateDenoms(v interface{}) error {
+	denoms, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate reward denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// validateFeeShare validates the FeeShare param
+func validateFeeShare(v interface{}) error {
+	dec, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if dec.IsNil() {
+		return fmt.Errorf("fee share cannot be nil")
+	}
+	if dec.IsNegative() {
+		return fmt.Errorf("fee share must be positive: %s", dec)
+	}
+	if dec.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee share must <= 100: %s", dec)
+	}
+
+	return nil
+}
+
+// validateDuration validates the MaxPriceStaleness param
+func validateDuration(v interface{}) error {
+	duration, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if duration < 0 {
+		return fmt.Errorf("duration cannot be negative: %s", duration)
+	}
+
+	return nil
+}
+
+// validateMinGasPrice validates the MinGasPrice param
+func validateMinGasPrice(v interface{}) error {
+	price, ok := v.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if price.IsNil() {
+		return fmt.Errorf("min gas price cannot be nil")
+	}
+	if price.IsNegative() {
+		return fmt.Errorf("min gas price cannot be negative: %s", price)
+	}
+
+	return nil
+}
+
 // validateDevEarnEpoch validates the DevEarnEpoch param
 func validatePercentage(v interface{}) error {
 	dec, ok := v.(sdk.Dec)
@@ -116,5 +276,29 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateCoins(p.RegistrationDeposit); err != nil {
+		return err
+	}
+
+	if err := validateGasAttribution(p.GasAttribution); err != nil {
+		return err
+	}
+
+	if err := validateDenoms(p.RewardDenoms); err != nil {
+		return err
+	}
+
+	if err := validateFeeShare(p.FeeShare); err != nil {
+		return err
+	}
+
+	if err := validateDuration(p.MaxPriceStaleness); err != nil {
+		return err
+	}
+
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
 	return epochstypes.ValidateEpochIdentifierString(p.RewardEpochIdentifier)
 }
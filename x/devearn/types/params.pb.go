@@ -5,11 +5,12 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -29,6 +30,37 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// GasAttribution defines how the gas used by a transaction is attributed to
+// registered contracts.
+type GasAttribution int32
+
+const (
+	// GAS_ATTRIBUTION_TOP_LEVEL credits the whole gas to the contract called by
+	// the transaction.
+	GAS_ATTRIBUTION_TOP_LEVEL GasAttribution = 0
+	// GAS_ATTRIBUTION_CALL_TREE splits the gas across every registered contract
+	// in the call tree, weighted by the gas each call frame used.
+	GAS_ATTRIBUTION_CALL_TREE GasAttribution = 1
+)
+
+var GasAttribution_name = map[int32]string{
+	0: "GAS_ATTRIBUTION_TOP_LEVEL",
+	1: "GAS_ATTRIBUTION_CALL_TREE",
+}
+
+var GasAttribution_value = map[string]int32{
+	"GAS_ATTRIBUTION_TOP_LEVEL": 0,
+	"GAS_ATTRIBUTION_CALL_TREE": 1,
+}
+
+func (x GasAttribution) String() string {
+	return proto.EnumName(GasAttribution_name, int32(x))
+}
+
+func (GasAttribution) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e2167e980e89f74c, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	//  enable_dev_earn is the parameter to enable dev_earn
@@ -39,6 +71,45 @@ type Params struct {
 	DevEarnInflation_APR github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=dev_earn_inflation_APR,json=devEarnInflationAPR,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dev_earn_inflation_APR"`
 	// Tvl share is a parameter to define the tvl rewards as a percentage of the dev_earn rewards.
 	TvlShare uint64 `protobuf:"varint,4,opt,name=tvl_share,json=tvlShare,proto3" json:"tvl_share,omitempty"`
+	// registration_deposit is the amount escrowed from the deployer when a
+	// contract is self-registered through MsgRegisterDevEarn. It is refunded
+	// once the registration is cancelled or expires.
+	RegistrationDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=registration_deposit,json=registrationDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_deposit"`
+	// gas_attribution selects how the gas of a transaction is credited to the
+	// registered contracts.
+	GasAttribution GasAttribution `protobuf:"varint,6,opt,name=gas_attribution,json=gasAttribution,proto3,enum=sidechain.devearn.GasAttribution" json:"gas_attribution,omitempty"`
+	// reward_denoms are the denominations minted as dev earn inflation. The
+	// staking bond denom is used when empty.
+	RewardDenoms []string `protobuf:"bytes,7,rep,name=reward_denoms,json=rewardDenoms,proto3" json:"reward_denoms,omitempty"`
+	// fee_share is the share of the EVM transaction fees that is added to the
+	// dev earn reward pool. Fee funded rewards are disabled when zero.
+	FeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=fee_share,json=feeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_share"`
+	// reward_history_retention is the number of epochs the reward records are
+	// kept for. No records are stored when zero.
+	RewardHistoryRetention uint64 `protobuf:"varint,9,opt,name=reward_history_retention,json=rewardHistoryRetention,proto3" json:"reward_history_retention,omitempty"`
+	// max_distributions_per_block is the number of contracts settled per block
+	// when the rewards of an epoch are distributed, and the number of contracts
+	// whose TVL is sampled per block. The remaining contracts are settled or
+	// sampled in the following blocks. There is no limit when zero.
+	MaxDistributionsPerBlock uint64 `protobuf:"varint,10,opt,name=max_distributions_per_block,json=maxDistributionsPerBlock,proto3" json:"max_distributions_per_block,omitempty"`
+	// tvl_sample_interval is the number of blocks between the starts of two
+	// rounds sampling the TVL held in the registered contracts. The TVL reward
+	// share is computed from the time-weighted average of the samples, or from
+	// the TVL at the end of the epoch when zero.
+	TvlSampleInterval uint64 `protobuf:"varint,11,opt,name=tvl_sample_interval,json=tvlSampleInterval,proto3" json:"tvl_sample_interval,omitempty"`
+	// max_price_staleness is how long the last known price of a whitelisted
+	// asset is used in the TVL when the oracle has no exchange rate for it. The
+	// asset is skipped once its last price is older, or right away when zero.
+	MaxPriceStaleness time.Duration `protobuf:"bytes,12,opt,name=max_price_staleness,json=maxPriceStaleness,proto3,stdduration" json:"max_price_staleness"`
+	// max_sender_gas_per_epoch is the gas a single sender can credit to a
+	// contract during an epoch. The gas sent above the cap is not credited.
+	// There is no cap when zero.
+	MaxSenderGasPerEpoch uint64 `protobuf:"varint,13,opt,name=max_sender_gas_per_epoch,json=maxSenderGasPerEpoch,proto3" json:"max_sender_gas_per_epoch,omitempty"`
+	// successful_txs_only credits the gas of successful transactions only
+	SuccessfulTxsOnly bool `protobuf:"varint,14,opt,name=successful_txs_only,json=successfulTxsOnly,proto3" json:"successful_txs_only,omitempty"`
+	// min_gas_price is the effective gas price a transaction must pay for its gas
+	// to be credited. Every transaction is credited when zero.
+	MinGasPrice github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,15,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_gas_price"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -94,6 +165,69 @@ func (m *Params) GetTvlShare() uint64 {
 	return 0
 }
 
+func (m *Params) GetRegistrationDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetGasAttribution() GasAttribution {
+	if m != nil {
+		return m.GasAttribution
+	}
+	return GAS_ATTRIBUTION_TOP_LEVEL
+}
+
+func (m *Params) GetRewardDenoms() []string {
+	if m != nil {
+		return m.RewardDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetRewardHistoryRetention() uint64 {
+	if m != nil {
+		return m.RewardHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDistributionsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxDistributionsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetTvlSampleInterval() uint64 {
+	if m != nil {
+		return m.TvlSampleInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPriceStaleness() time.Duration {
+	if m != nil {
+		return m.MaxPriceStaleness
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSenderGasPerEpoch() uint64 {
+	if m != nil {
+		return m.MaxSenderGasPerEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetSuccessfulTxsOnly() bool {
+	if m != nil {
+		return m.SuccessfulTxsOnly
+	}
+	return false
+}
+
 // DevEarnInfo defines an instance that organizes distribution conditions for a
 // given smart contract
 type DevEarnInfo struct {
@@ -107,6 +241,17 @@ type DevEarnInfo struct {
 	OwnerAddress string `protobuf:"bytes,4,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
 	// epochs defines the number of remaining epochs for the dev earn
 	Epochs uint32 `protobuf:"varint,5,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	// deployer_address is the bech32 address of the account that self-registered
+	// the contract. It is empty for registrations made through governance.
+	DeployerAddress string `protobuf:"bytes,6,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
+	// deposit escrowed by the deployer on self-registration
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// withdraw_address is the hex address the rewards are accrued to instead of
+	// the owner. The owner receives the rewards when empty.
+	WithdrawAddress string `protobuf:"bytes,8,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	// nonces is the path of account nonces deriving the contract address from
+	// the deployer on self-registration, through the factories that created it
+	Nonces []uint64 `protobuf:"varint,9,rep,packed,name=nonces,proto3" json:"nonces,omitempty"`
 }
 
 func (m *DevEarnInfo) Reset()         { *m = DevEarnInfo{} }
@@ -177,7 +322,36 @@ func (m *DevEarnInfo) GetEpochs() uint32 {
 	return 0
 }
 
+func (m *DevEarnInfo) GetDeployerAddress() string {
+	if m != nil {
+		return m.DeployerAddress
+	}
+	return ""
+}
+
+func (m *DevEarnInfo) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *DevEarnInfo) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+func (m *DevEarnInfo) GetNonces() []uint64 {
+	if m != nil {
+		return m.Nonces
+	}
+	return nil
+}
+
 // RegisterDevEarnInfoProposal is a gov Content type to register an incentive
+// Deprecated: use MsgRegisterDevEarnInfo in a gov v1 proposal instead.
 type RegisterDevEarnInfoProposal struct {
 	// title of the proposal
 	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -260,6 +434,7 @@ func (m *RegisterDevEarnInfoProposal) GetEpochs() uint32 {
 }
 
 // CancelDevEarnInfoProposal is a gov Content type to cancel an incentive
+// Deprecated: use MsgCancelDevEarnInfo in a gov v1 proposal instead.
 type CancelDevEarnInfoProposal struct {
 	// title of the proposal
 	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -324,6 +499,7 @@ func (m *CancelDevEarnInfoProposal) GetContract() string {
 }
 
 // AddAssetToWhitelistProposal is a gov Content type to add asset to whitelist
+// Deprecated: use MsgAddAsset in a gov v1 proposal instead.
 type AddAssetToWhitelistProposal struct {
 	// title of the proposal
 	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -388,6 +564,7 @@ func (m *AddAssetToWhitelistProposal) GetDenom() string {
 }
 
 // RemoveAssetFromWhitelistProposal is a gov Content type to remove asset from whitelist
+// Deprecated: use MsgRemoveAsset in a gov v1 proposal instead.
 type RemoveAssetFromWhitelistProposal struct {
 	// title of the proposal
 	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -452,6 +629,7 @@ func (m *RemoveAssetFromWhitelistProposal) GetDenom() string {
 }
 
 func init() {
+	proto.RegisterEnum("sidechain.devearn.GasAttribution", GasAttribution_name, GasAttribution_value)
 	proto.RegisterType((*Params)(nil), "sidechain.devearn.Params")
 	proto.RegisterType((*DevEarnInfo)(nil), "sidechain.devearn.DevEarnInfo")
 	proto.RegisterType((*RegisterDevEarnInfoProposal)(nil), "sidechain.devearn.RegisterDevEarnInfoProposal")
@@ -463,44 +641,73 @@ func init() {
 func init() { proto.RegisterFile("sidechain/devearn/params.proto", fileDescriptor_e2167e980e89f74c) }
 
 var fileDescriptor_e2167e980e89f74c = []byte{
-	// 584 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcd, 0x6e, 0xd4, 0x3c,
-	0x14, 0x1d, 0x7f, 0x9d, 0x56, 0x33, 0xee, 0x57, 0x21, 0x42, 0x29, 0xd3, 0xa9, 0x94, 0x89, 0x8a,
-	0x54, 0xcd, 0x86, 0x44, 0xa5, 0x12, 0x8b, 0xee, 0xa6, 0x3f, 0x48, 0x5d, 0x20, 0x8d, 0x4c, 0x25,
-	0x24, 0x36, 0x91, 0x13, 0xdf, 0x66, 0x2c, 0x12, 0x3b, 0xd8, 0x6e, 0x0a, 0x6f, 0xd1, 0x25, 0x4b,
-	0x1e, 0x82, 0x87, 0xe8, 0xb2, 0x4b, 0xc4, 0xa2, 0xa0, 0x99, 0x0d, 0x4b, 0x1e, 0x01, 0xd9, 0xc9,
-	0x0c, 0x85, 0x6d, 0xa5, 0xae, 0x92, 0x73, 0x8f, 0x7d, 0xcf, 0x3d, 0xc7, 0x89, 0xb1, 0xaf, 0x39,
-	0x83, 0x74, 0x42, 0xb9, 0x88, 0x18, 0x54, 0x40, 0x95, 0x88, 0x4a, 0xaa, 0x68, 0xa1, 0xc3, 0x52,
-	0x49, 0x23, 0xbd, 0x87, 0x0b, 0x3e, 0x6c, 0xf8, 0xfe, 0x7a, 0x26, 0x33, 0xe9, 0xd8, 0xc8, 0xbe,
-	0xd5, 0x0b, 0xfb, 0x7e, 0x2a, 0x75, 0x21, 0x75, 0x94, 0x50, 0x0d, 0x51, 0xb5, 0x9b, 0x80, 0xa1,
-	0xbb, 0x51, 0x2a, 0xb9, 0x68, 0xf8, 0x41, 0x26, 0x65, 0x96, 0x43, 0xe4, 0x50, 0x72, 0x7e, 0x16,
-	0x19, 0x5e, 0x80, 0x36, 0xb4, 0x28, 0xeb, 0x05, 0xdb, 0xbf, 0x10, 0x5e, 0x19, 0x3b, 0x69, 0x6f,
-	0x07, 0x3f, 0x00, 0x41, 0x93, 0x1c, 0x62, 0x06, 0x55, 0x6c, 0x45, 0x7b, 0x28, 0x40, 0xc3, 0x0e,
-	0x59, 0xab, 0xcb, 0x47, 0x50, 0x1d, 0x53, 0x25, 0xbc, 0x17, 0xf8, 0x89, 0x82, 0x0b, 0xaa, 0x58,
-	0x0c, 0xa5, 0x4c, 0x27, 0x31, 0x67, 0x20, 0x0c, 0x3f, 0xe3, 0xa0, 0x7a, 0xff, 0x05, 0x68, 0xd8,
-	0x25, 0x8f, 0x6b, 0xfa, 0xd8, 0xb2, 0x27, 0x0b, 0xd2, 0x4b, 0xf1, 0xc6, 0xbc, 0x71, 0xcc, 0xc5,
-	0x59, 0x4e, 0x0d, 0x97, 0x22, 0x1e, 0x8d, 0x49, 0x6f, 0xc9, 0x6e, 0x3b, 0x08, 0xaf, 0x6e, 0x06,
-	0xad, 0x6f, 0x37, 0x83, 0x9d, 0x8c, 0x9b, 0xc9, 0x79, 0x12, 0xa6, 0xb2, 0x88, 0x1a, 0x7b, 0xf5,
-	0xe3, 0x99, 0x66, 0xef, 0x22, 0xf3, 0xb1, 0x04, 0x1d, 0x1e, 0x41, 0x4a, 0x1e, 0xb1, 0x7a, 0xa0,
-	0x93, 0x79, 0xaf, 0xd1, 0x98, 0x78, 0x5b, 0xb8, 0x6b, 0xaa, 0x3c, 0xd6, 0x13, 0xaa, 0xa0, 0xd7,
-	0x0e, 0xd0, 0xb0, 0x4d, 0x3a, 0xa6, 0xca, 0x5f, 0x5b, 0xbc, 0xdf, 0xfe, 0xf4, 0x79, 0xd0, 0xda,
-	0xbe, 0x42, 0x78, 0xf5, 0x68, 0xb1, 0x55, 0x7a, 0x7d, 0xdc, 0x49, 0xa5, 0x30, 0x8a, 0xa6, 0xc6,
-	0x19, 0xee, 0x92, 0x05, 0xb6, 0xed, 0x32, 0xaa, 0xe3, 0x02, 0x4c, 0xe3, 0xae, 0x4d, 0x3a, 0x19,
-	0xd5, 0xaf, 0x2c, 0xf6, 0x0e, 0x31, 0xd6, 0x86, 0x2a, 0x13, 0xdb, 0x50, 0x9d, 0x89, 0xd5, 0xe7,
-	0xfd, 0xb0, 0x4e, 0x3c, 0x9c, 0x27, 0x1e, 0x9e, 0xce, 0x13, 0x3f, 0xe8, 0x58, 0x83, 0x97, 0xdf,
-	0x07, 0x88, 0x74, 0xdd, 0x3e, 0xcb, 0x78, 0x4f, 0xf1, 0x9a, 0xbc, 0x10, 0xa0, 0x62, 0xca, 0x98,
-	0x02, 0xad, 0xdd, 0xd0, 0x5d, 0xf2, 0xbf, 0x2b, 0x8e, 0xea, 0x9a, 0xb7, 0x81, 0x57, 0x5c, 0xd6,
-	0xba, 0xb7, 0x1c, 0xa0, 0xe1, 0x1a, 0x69, 0xd0, 0xf6, 0x17, 0x84, 0xb7, 0x08, 0x64, 0x5c, 0x1b,
-	0x50, 0xb7, 0x2c, 0x8d, 0x95, 0x2c, 0xa5, 0xa6, 0xb9, 0xb7, 0x8e, 0x97, 0x0d, 0x37, 0x39, 0x34,
-	0xbe, 0x6a, 0xe0, 0x05, 0x78, 0x95, 0x81, 0x4e, 0x15, 0x2f, 0x6d, 0x6a, 0xcd, 0xa1, 0xdd, 0x2e,
-	0xfd, 0x15, 0xc9, 0xd2, 0x3f, 0x91, 0xdc, 0x65, 0xe0, 0xfd, 0xf6, 0x4f, 0x7b, 0x02, 0xe7, 0x78,
-	0xf3, 0x90, 0x8a, 0x14, 0xf2, 0x7b, 0x9a, 0xb9, 0x91, 0x7d, 0x8f, 0xb7, 0x46, 0x8c, 0x8d, 0xb4,
-	0x06, 0x73, 0x2a, 0xdf, 0x4c, 0xb8, 0x81, 0x9c, 0x6b, 0x73, 0x67, 0xe1, 0x75, 0xbc, 0xcc, 0x40,
-	0xc8, 0xa2, 0x51, 0xad, 0x41, 0x23, 0x59, 0xe1, 0x80, 0x40, 0x21, 0x2b, 0x70, 0xaa, 0x2f, 0x95,
-	0x2c, 0xee, 0x45, 0xf7, 0x60, 0xef, 0x6a, 0xea, 0xa3, 0xeb, 0xa9, 0x8f, 0x7e, 0x4c, 0x7d, 0x74,
-	0x39, 0xf3, 0x5b, 0xd7, 0x33, 0xbf, 0xf5, 0x75, 0xe6, 0xb7, 0xde, 0x6e, 0xfe, 0xb9, 0x7a, 0x3e,
-	0x2c, 0x2e, 0x1f, 0xf7, 0x53, 0x25, 0x2b, 0xee, 0x9b, 0xdd, 0xfb, 0x1d, 0x00, 0x00, 0xff, 0xff,
-	0xe9, 0x69, 0x3e, 0x2e, 0x9e, 0x04, 0x00, 0x00,
+	// 1044 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0x7e, 0xed, 0x24, 0xf6, 0x38, 0x3f, 0x37, 0xf9, 0x86, 0x4d, 0x22, 0x6c, 0x13, 0xa4,
+	0xca, 0x20, 0xb1, 0xa6, 0xa9, 0x54, 0xa1, 0x4a, 0x1c, 0xec, 0xd8, 0x04, 0x43, 0x20, 0xd6, 0xc6,
+	0x80, 0xc4, 0x65, 0x34, 0xde, 0x7d, 0xb6, 0x47, 0xdd, 0x9d, 0x59, 0x66, 0xc6, 0x8e, 0x73, 0xe1,
+	0xcc, 0xb1, 0xc7, 0x5e, 0x90, 0x90, 0xb8, 0x71, 0xe6, 0x8f, 0xe8, 0xb1, 0x47, 0xc4, 0xa1, 0x45,
+	0xc9, 0xa5, 0x7f, 0x06, 0x9a, 0xd9, 0x5d, 0xc7, 0x69, 0x39, 0x00, 0x45, 0x3d, 0x25, 0xef, 0x7d,
+	0xf6, 0xbd, 0xf7, 0xd9, 0xf7, 0x99, 0xcf, 0x78, 0x51, 0x45, 0xd2, 0x00, 0xfc, 0x31, 0xa1, 0xac,
+	0x11, 0xc0, 0x14, 0x88, 0x60, 0x8d, 0x98, 0x08, 0x12, 0x49, 0x37, 0x16, 0x5c, 0x71, 0x7b, 0x6b,
+	0x8e, 0xbb, 0x29, 0xbe, 0xbf, 0x33, 0xe2, 0x23, 0x6e, 0xd0, 0x86, 0xfe, 0x2f, 0x79, 0x70, 0xbf,
+	0xe2, 0x73, 0x19, 0x71, 0xd9, 0x18, 0x10, 0x09, 0x8d, 0xe9, 0xdd, 0x01, 0x28, 0x72, 0xb7, 0xe1,
+	0x73, 0xca, 0x52, 0xbc, 0x3a, 0xe2, 0x7c, 0x14, 0x42, 0xc3, 0x44, 0x83, 0xc9, 0xb0, 0xa1, 0x68,
+	0x04, 0x52, 0x91, 0x28, 0xce, 0x1a, 0xbc, 0xfc, 0x40, 0x30, 0x11, 0x44, 0x51, 0x9e, 0x36, 0x38,
+	0x7c, 0xb1, 0x82, 0x96, 0x7b, 0x86, 0x9a, 0x7d, 0x07, 0x6d, 0x00, 0x23, 0x83, 0x10, 0x70, 0x00,
+	0x53, 0xac, 0x49, 0x39, 0x56, 0xcd, 0xaa, 0x17, 0xbd, 0xb5, 0x24, 0xdd, 0x86, 0x69, 0x87, 0x08,
+	0x66, 0xdf, 0x47, 0x6f, 0x09, 0xb8, 0x20, 0x22, 0xc0, 0x10, 0x73, 0x7f, 0x8c, 0x69, 0x00, 0x4c,
+	0xd1, 0x21, 0x05, 0xe1, 0xfc, 0xaf, 0x66, 0xd5, 0x4b, 0xde, 0xff, 0x13, 0xb8, 0xa3, 0xd1, 0xee,
+	0x1c, 0xb4, 0x7d, 0xb4, 0x9b, 0x35, 0xc6, 0x94, 0x0d, 0x43, 0x43, 0x03, 0x37, 0x7b, 0x9e, 0x93,
+	0xd7, 0x65, 0x2d, 0xf7, 0xc9, 0xb3, 0x6a, 0xee, 0xf7, 0x67, 0xd5, 0x3b, 0x23, 0xaa, 0xc6, 0x93,
+	0x81, 0xeb, 0xf3, 0xa8, 0x91, 0xbe, 0x7e, 0xf2, 0xe7, 0x03, 0x19, 0x3c, 0x6c, 0xa8, 0xcb, 0x18,
+	0xa4, 0xdb, 0x06, 0xdf, 0xdb, 0x0e, 0x12, 0x42, 0xdd, 0xac, 0x57, 0xb3, 0xe7, 0xd9, 0x07, 0xa8,
+	0xa4, 0xa6, 0x21, 0x96, 0x63, 0x22, 0xc0, 0x29, 0xd4, 0xac, 0x7a, 0xc1, 0x2b, 0xaa, 0x69, 0x78,
+	0xae, 0x63, 0xfb, 0x7b, 0xb4, 0x23, 0x60, 0x44, 0xa5, 0x4a, 0x56, 0x80, 0x03, 0x88, 0xb9, 0xa4,
+	0xca, 0x59, 0xaa, 0xe5, 0xeb, 0xe5, 0xa3, 0x3d, 0x37, 0x19, 0xe3, 0xea, 0x65, 0xbb, 0xe9, 0xb2,
+	0xdd, 0x63, 0x4e, 0x59, 0xeb, 0x43, 0x4d, 0xed, 0x97, 0xe7, 0xd5, 0xfa, 0xdf, 0xa0, 0xa6, 0x0b,
+	0xa4, 0xb7, 0xbd, 0x38, 0xa8, 0x9d, 0xcc, 0xb1, 0x3f, 0x43, 0x1b, 0x23, 0x22, 0x31, 0x51, 0x4a,
+	0xd0, 0xc1, 0x44, 0x23, 0xce, 0x72, 0xcd, 0xaa, 0xaf, 0x1f, 0xbd, 0xe3, 0xbe, 0x72, 0x20, 0xdc,
+	0x13, 0x22, 0x9b, 0x37, 0x0f, 0x7a, 0xeb, 0xa3, 0x5b, 0xb1, 0xfd, 0x2e, 0x5a, 0x4b, 0x55, 0x08,
+	0x80, 0xf1, 0x48, 0x3a, 0x2b, 0xb5, 0x7c, 0xbd, 0xe4, 0xad, 0x26, 0xc9, 0xb6, 0xc9, 0xd9, 0x9f,
+	0xa3, 0xd2, 0x10, 0x20, 0xdd, 0x46, 0xf1, 0x5f, 0x6d, 0xb9, 0x38, 0x04, 0x48, 0xb6, 0xf7, 0x11,
+	0x72, 0xd2, 0x89, 0x63, 0x2a, 0x15, 0x17, 0x97, 0x58, 0x80, 0xd2, 0xea, 0x72, 0xe6, 0x94, 0xcc,
+	0xa6, 0x77, 0x13, 0xfc, 0xd3, 0x04, 0xf6, 0x32, 0xd4, 0xfe, 0x18, 0x1d, 0x44, 0x64, 0x86, 0x03,
+	0x2a, 0xe7, 0xfc, 0x25, 0x8e, 0x41, 0xe0, 0x41, 0xc8, 0xfd, 0x87, 0x0e, 0x32, 0xc5, 0x4e, 0x44,
+	0x66, 0xed, 0xc5, 0x27, 0x7a, 0x20, 0x5a, 0x1a, 0xb7, 0x5d, 0xb4, 0x6d, 0x34, 0x25, 0x51, 0x1c,
+	0x02, 0xa6, 0x4c, 0x81, 0x98, 0x92, 0xd0, 0x29, 0x9b, 0xb2, 0x2d, 0xad, 0xae, 0x41, 0xba, 0x29,
+	0x60, 0x9f, 0xa3, 0x6d, 0x3d, 0x2e, 0x16, 0xd4, 0x07, 0x2c, 0x15, 0x09, 0x81, 0x81, 0x94, 0xce,
+	0x6a, 0xcd, 0x32, 0x2a, 0x27, 0x8e, 0x70, 0x33, 0x47, 0xb8, 0xed, 0xd4, 0x11, 0xad, 0xa2, 0x5e,
+	0xcd, 0xe3, 0xe7, 0x55, 0xcb, 0xdb, 0x8a, 0xc8, 0xac, 0xa7, 0xcb, 0xcf, 0xb3, 0x6a, 0xfb, 0x3e,
+	0xd2, 0x04, 0xb1, 0x04, 0x16, 0x80, 0xc0, 0x23, 0x92, 0xbc, 0x80, 0x71, 0x80, 0xb3, 0x66, 0x98,
+	0xec, 0x44, 0x64, 0x76, 0x6e, 0xe0, 0x13, 0xa2, 0xc9, 0x9b, 0xf3, 0xaf, 0xc9, 0xcb, 0x89, 0xef,
+	0x83, 0x94, 0xc3, 0x49, 0x88, 0xd5, 0x4c, 0x62, 0xce, 0xc2, 0x4b, 0x67, 0xdd, 0x38, 0x6b, 0xeb,
+	0x06, 0xea, 0xcf, 0xe4, 0x19, 0x0b, 0x2f, 0x6d, 0x0f, 0xad, 0x45, 0x94, 0x25, 0x03, 0x34, 0x03,
+	0x67, 0xe3, 0x1f, 0xcb, 0xd6, 0x65, 0xca, 0x2b, 0x47, 0x94, 0x69, 0x1a, 0xba, 0xc5, 0x83, 0xc2,
+	0xe3, 0x9f, 0xaa, 0xb9, 0xc3, 0x1f, 0xf3, 0xa8, 0xdc, 0x9e, 0x5b, 0x86, 0xdb, 0xfb, 0xa8, 0xe8,
+	0x73, 0xa6, 0x04, 0xf1, 0x95, 0x31, 0x7a, 0xc9, 0x9b, 0xc7, 0xda, 0x46, 0x9a, 0x41, 0x04, 0x2a,
+	0x75, 0x75, 0xc1, 0x2b, 0x8e, 0x88, 0xfc, 0x42, 0xc7, 0xf6, 0x31, 0x42, 0x52, 0x11, 0xa1, 0xb0,
+	0xbe, 0x6c, 0x8c, 0x79, 0xcb, 0x47, 0xfb, 0xaf, 0xac, 0xb5, 0x9f, 0xdd, 0x44, 0xc9, 0x5e, 0x1f,
+	0xe9, 0xbd, 0x96, 0x4c, 0x9d, 0x46, 0xf4, 0xf9, 0xe5, 0x17, 0x0c, 0x04, 0x26, 0x41, 0x20, 0xb4,
+	0x3c, 0x05, 0x43, 0x61, 0xd5, 0x24, 0x9b, 0x49, 0xce, 0xde, 0x45, 0xcb, 0x66, 0xc3, 0xd2, 0x59,
+	0xaa, 0x59, 0xf5, 0x35, 0x2f, 0x8d, 0xec, 0xf7, 0xd0, 0x66, 0x00, 0x71, 0xc8, 0x2f, 0x17, 0xea,
+	0x97, 0x4d, 0xfd, 0x46, 0x96, 0xcf, 0x5a, 0x00, 0x5a, 0xc9, 0x6c, 0xbe, 0xf2, 0xdf, 0xdb, 0x3c,
+	0xeb, 0xad, 0x19, 0x5d, 0x50, 0x35, 0x0e, 0x04, 0xb9, 0x98, 0x33, 0x2a, 0x26, 0x8c, 0xb2, 0xfc,
+	0xc2, 0x4b, 0x31, 0xce, 0x7c, 0x90, 0x4e, 0xa9, 0x96, 0xaf, 0x17, 0xbc, 0x34, 0x3a, 0xfc, 0xd5,
+	0x42, 0x07, 0x9e, 0xb9, 0x35, 0x40, 0x2c, 0xe8, 0xd4, 0x13, 0x3c, 0xe6, 0x92, 0x84, 0xf6, 0x0e,
+	0x5a, 0x52, 0x54, 0x85, 0x90, 0x8a, 0x95, 0x04, 0x76, 0x0d, 0x95, 0x03, 0x90, 0xbe, 0xa0, 0xb1,
+	0x31, 0x62, 0x72, 0x03, 0x2f, 0xa6, 0x6e, 0xe9, 0x9c, 0x7f, 0x49, 0xe7, 0xd7, 0x51, 0xe1, 0x41,
+	0xe1, 0x85, 0x3e, 0x56, 0x13, 0xb4, 0x77, 0x4c, 0x98, 0x0f, 0xe1, 0x1b, 0xe2, 0x9c, 0x8e, 0xfd,
+	0x0e, 0x1d, 0x34, 0x83, 0xa0, 0x29, 0x25, 0xa8, 0x3e, 0xff, 0x66, 0x4c, 0x15, 0x84, 0x54, 0xaa,
+	0xd7, 0x1e, 0xbc, 0x83, 0x96, 0xcc, 0x7d, 0x9a, 0x4e, 0x4d, 0x82, 0x74, 0xe4, 0x14, 0xd5, 0x3c,
+	0x88, 0xf8, 0x14, 0xcc, 0xd4, 0x4f, 0x04, 0x8f, 0xde, 0xc8, 0xdc, 0xf7, 0xfb, 0x68, 0xfd, 0xf6,
+	0x8f, 0x81, 0xfd, 0x36, 0xda, 0x3b, 0x69, 0x9e, 0xe3, 0x66, 0xbf, 0xef, 0x75, 0x5b, 0x5f, 0xf5,
+	0xbb, 0x67, 0x5f, 0xe2, 0xfe, 0x59, 0x0f, 0x9f, 0x76, 0xbe, 0xee, 0x9c, 0x6e, 0xe6, 0xfe, 0x0a,
+	0x3e, 0x6e, 0x9e, 0x9e, 0xe2, 0xbe, 0xd7, 0xe9, 0x6c, 0x5a, 0xfb, 0x85, 0x1f, 0x7e, 0xae, 0xe4,
+	0x5a, 0xf7, 0x9e, 0x5c, 0x55, 0xac, 0xa7, 0x57, 0x15, 0xeb, 0x8f, 0xab, 0x8a, 0xf5, 0xe8, 0xba,
+	0x92, 0x7b, 0x7a, 0x5d, 0xc9, 0xfd, 0x76, 0x5d, 0xc9, 0x7d, 0xbb, 0x77, 0xf3, 0xf5, 0x32, 0x9b,
+	0x7f, 0xbf, 0x98, 0x53, 0x3f, 0x58, 0x36, 0xf6, 0xbe, 0xf7, 0xe7, 0x00, 0x66, 0x45, 0xd8, 0x53,
+	0xe1, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -523,6 +730,92 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.SuccessfulTxsOnly {
+		i--
+		if m.SuccessfulTxsOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxSenderGasPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSenderGasPerEpoch))
+		i--
+		dAtA[i] = 0x68
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxPriceStaleness, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceStaleness):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	if m.TvlSampleInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TvlSampleInterval))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxDistributionsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDistributionsPerBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RewardHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardHistoryRetention))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.FeeShare.Size()
+		i -= size
+		if _, err := m.FeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.RewardDenoms) > 0 {
+		for iNdEx := len(m.RewardDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RewardDenoms[iNdEx])
+			copy(dAtA[i:], m.RewardDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.RewardDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.GasAttribution != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasAttribution))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RegistrationDeposit) > 0 {
+		for iNdEx := len(m.RegistrationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.TvlShare != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.TvlShare))
 		i--
@@ -578,6 +871,52 @@ func (m *DevEarnInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Nonces) > 0 {
+		dAtA3 := make([]byte, len(m.Nonces)*10)
+		var j2 int
+		for _, num := range m.Nonces {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintParams(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DeployerAddress) > 0 {
+		i -= len(m.DeployerAddress)
+		copy(dAtA[i:], m.DeployerAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DeployerAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Epochs != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.Epochs))
 		i--
@@ -590,12 +929,12 @@ func (m *DevEarnInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n1
-	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	if m.GasMeter != 0 {
@@ -830,6 +1169,42 @@ func (m *Params) Size() (n int) {
 	if m.TvlShare != 0 {
 		n += 1 + sovParams(uint64(m.TvlShare))
 	}
+	if len(m.RegistrationDeposit) > 0 {
+		for _, e := range m.RegistrationDeposit {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.GasAttribution != 0 {
+		n += 1 + sovParams(uint64(m.GasAttribution))
+	}
+	if len(m.RewardDenoms) > 0 {
+		for _, s := range m.RewardDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.FeeShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.RewardHistoryRetention != 0 {
+		n += 1 + sovParams(uint64(m.RewardHistoryRetention))
+	}
+	if m.MaxDistributionsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxDistributionsPerBlock))
+	}
+	if m.TvlSampleInterval != 0 {
+		n += 1 + sovParams(uint64(m.TvlSampleInterval))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceStaleness)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxSenderGasPerEpoch != 0 {
+		n += 1 + sovParams(uint64(m.MaxSenderGasPerEpoch))
+	}
+	if m.SuccessfulTxsOnly {
+		n += 2
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -855,6 +1230,27 @@ func (m *DevEarnInfo) Size() (n int) {
 	if m.Epochs != 0 {
 		n += 1 + sovParams(uint64(m.Epochs))
 	}
+	l = len(m.DeployerAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1089,6 +1485,288 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDeposit = append(m.RegistrationDeposit, types.Coin{})
+			if err := m.RegistrationDeposit[len(m.RegistrationDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasAttribution", wireType)
+			}
+			m.GasAttribution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasAttribution |= GasAttribution(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenoms = append(m.RewardDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistoryRetention", wireType)
+			}
+			m.RewardHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDistributionsPerBlock", wireType)
+			}
+			m.MaxDistributionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDistributionsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TvlSampleInterval", wireType)
+			}
+			m.TvlSampleInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TvlSampleInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceStaleness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxPriceStaleness, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSenderGasPerEpoch", wireType)
+			}
+			m.MaxSenderGasPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSenderGasPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulTxsOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuccessfulTxsOnly = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1274,6 +1952,180 @@ func (m *DevEarnInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nonces = append(m.Nonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nonces) == 0 {
+					m.Nonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nonces = append(m.Nonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -484,796 +486,3028 @@ func (m *QueryAllAssetsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "sidechain.devearn.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "sidechain.devearn.QueryParamsResponse")
-	proto.RegisterType((*QueryDevEarnInfosRequest)(nil), "sidechain.devearn.QueryDevEarnInfosRequest")
-	proto.RegisterType((*QueryDevEarnInfosResponse)(nil), "sidechain.devearn.QueryDevEarnInfosResponse")
-	proto.RegisterType((*QueryDevEarnInfoRequest)(nil), "sidechain.devearn.QueryDevEarnInfoRequest")
-	proto.RegisterType((*QueryDevEarnInfoResponse)(nil), "sidechain.devearn.QueryDevEarnInfoResponse")
-	proto.RegisterType((*QueryGetAssetsRequest)(nil), "sidechain.devearn.QueryGetAssetsRequest")
-	proto.RegisterType((*QueryGetAssetsResponse)(nil), "sidechain.devearn.QueryGetAssetsResponse")
-	proto.RegisterType((*QueryAllAssetsRequest)(nil), "sidechain.devearn.QueryAllAssetsRequest")
-	proto.RegisterType((*QueryAllAssetsResponse)(nil), "sidechain.devearn.QueryAllAssetsResponse")
+type QueryPendingRewardsRequest struct {
+	// owner_address is the hex or bech32 address of the rewards owner
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
 }
 
-func init() { proto.RegisterFile("sidechain/devearn/query.proto", fileDescriptor_e958742cdc1ac27b) }
-
-var fileDescriptor_e958742cdc1ac27b = []byte{
-	// 617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x2d, 0x89, 0xe8, 0xa5, 0x20, 0x71, 0x04, 0x48, 0x0c, 0x98, 0xd4, 0x88, 0x92,
-	0xb6, 0x60, 0xab, 0xa9, 0x10, 0x73, 0x2a, 0xa0, 0xc0, 0x80, 0xda, 0x8c, 0x2c, 0xd1, 0x25, 0x3e,
-	0x8c, 0xa5, 0xe4, 0xce, 0xf5, 0xb9, 0x11, 0x05, 0xb1, 0xc0, 0xc2, 0x58, 0x09, 0x26, 0x3e, 0x05,
-	0x9f, 0x80, 0xb9, 0x63, 0x25, 0x16, 0x26, 0x84, 0x12, 0x3e, 0x08, 0xca, 0xdd, 0x39, 0xb1, 0x13,
-	0xbb, 0x71, 0xa5, 0x6e, 0xb1, 0xdf, 0xff, 0xbd, 0xf7, 0x7b, 0x4f, 0xef, 0xef, 0xc0, 0xdb, 0xdc,
-	0xb5, 0x49, 0xe7, 0x2d, 0x76, 0xa9, 0x65, 0x93, 0x3e, 0xc1, 0x3e, 0xb5, 0xf6, 0x0f, 0x88, 0x7f,
-	0x68, 0x7a, 0x3e, 0x0b, 0x18, 0xba, 0x32, 0x0e, 0x9b, 0x2a, 0xac, 0x95, 0x1c, 0xe6, 0x30, 0x11,
-	0xb5, 0x46, 0xbf, 0xa4, 0x50, 0xbb, 0xe5, 0x30, 0xe6, 0x74, 0x89, 0x85, 0x3d, 0xd7, 0xc2, 0x94,
-	0xb2, 0x00, 0x07, 0x2e, 0xa3, 0x5c, 0x45, 0xd7, 0x3b, 0x8c, 0xf7, 0x18, 0xb7, 0xda, 0x98, 0x13,
-	0x59, 0xdf, 0xea, 0x6f, 0xb6, 0x49, 0x80, 0x37, 0x2d, 0x0f, 0x3b, 0x2e, 0x15, 0x62, 0xa5, 0xd5,
-	0x67, 0x89, 0x3c, 0xec, 0xe3, 0x1e, 0x4f, 0x8f, 0x63, 0xce, 0x49, 0xa0, 0xe2, 0x46, 0x09, 0xa2,
-	0xbd, 0x51, 0x87, 0x5d, 0x91, 0xd4, 0x24, 0xfb, 0x07, 0x84, 0x07, 0xc6, 0x2b, 0x78, 0x35, 0xf6,
-	0x96, 0x7b, 0x8c, 0x72, 0x82, 0x1e, 0xc3, 0x82, 0x2c, 0x5e, 0x06, 0x55, 0x50, 0x2b, 0xd6, 0x2b,
-	0xe6, 0xcc, 0xc0, 0xa6, 0x4c, 0xd9, 0xbe, 0x70, 0xfc, 0xe7, 0x4e, 0xae, 0xa9, 0xe4, 0x46, 0x1b,
-	0x96, 0x45, 0xbd, 0x27, 0xa4, 0xff, 0x14, 0xfb, 0xf4, 0x05, 0x7d, 0xc3, 0xc2, 0x5e, 0xe8, 0x19,
-	0x84, 0x93, 0xa9, 0x54, 0xe1, 0x55, 0x53, 0xae, 0xc0, 0x1c, 0xad, 0xc0, 0x94, 0x2b, 0x56, 0x2b,
-	0x30, 0x77, 0xb1, 0x43, 0x54, 0x6e, 0x33, 0x92, 0x69, 0xfc, 0x00, 0xb0, 0x92, 0xd0, 0x44, 0xa1,
-	0xbf, 0x84, 0x97, 0x6d, 0xd2, 0x6f, 0x8d, 0x10, 0x5b, 0xee, 0x28, 0x52, 0x06, 0xd5, 0xc5, 0x5a,
-	0xb1, 0xae, 0x27, 0x8c, 0x10, 0x29, 0xa0, 0xe6, 0x58, 0xb6, 0x23, 0x35, 0xd1, 0x4e, 0x8c, 0x78,
-	0x41, 0x10, 0xdf, 0x9f, 0x4b, 0x2c, 0x41, 0x62, 0xc8, 0x8f, 0xe0, 0x8d, 0x69, 0xe2, 0x70, 0x2b,
-	0x1a, 0xbc, 0xd8, 0x61, 0x34, 0xf0, 0x71, 0x27, 0x10, 0x3b, 0x59, 0x6a, 0x8e, 0x9f, 0x0d, 0x7b,
-	0x76, 0x9b, 0xe3, 0x39, 0x9f, 0xc3, 0x4b, 0xb1, 0x39, 0xd5, 0x42, 0xb3, 0x8d, 0x59, 0x8c, 0x8c,
-	0x69, 0x3c, 0x84, 0xd7, 0x44, 0x97, 0x1d, 0x12, 0x34, 0xc4, 0xc5, 0x84, 0x68, 0x25, 0x98, 0xb7,
-	0x09, 0x65, 0x3d, 0xc5, 0x25, 0x1f, 0x8c, 0x3d, 0x78, 0x7d, 0x5a, 0x3e, 0xb9, 0x1a, 0xf9, 0xe6,
-	0x94, 0xab, 0x91, 0x82, 0xf0, 0x6a, 0xe4, 0x93, 0xd1, 0x52, 0x04, 0x8d, 0x6e, 0x37, 0x4e, 0x70,
-	0x5e, 0x27, 0xf3, 0x1d, 0x28, 0xe8, 0x48, 0x87, 0x04, 0xe8, 0xc5, 0x33, 0x40, 0x9f, 0xdb, 0x71,
-	0xd4, 0x7f, 0xe6, 0x61, 0x5e, 0xc0, 0xa1, 0xf7, 0xb0, 0x20, 0x5d, 0x85, 0xee, 0x25, 0x50, 0xcc,
-	0xda, 0x57, 0x5b, 0x9d, 0x27, 0x93, 0xed, 0x8c, 0x95, 0x4f, 0xbf, 0xfe, 0x7d, 0x5d, 0xb8, 0x89,
-	0x2a, 0x56, 0xda, 0x57, 0x04, 0x7d, 0x03, 0x70, 0x39, 0x6a, 0x28, 0xb4, 0x91, 0x56, 0x3b, 0xc1,
-	0xdb, 0xda, 0x83, 0x6c, 0x62, 0x85, 0xb3, 0x26, 0x70, 0xee, 0xa2, 0x95, 0x04, 0x9c, 0xb8, 0x79,
-	0xd1, 0x11, 0x80, 0xc5, 0x48, 0x0d, 0xb4, 0x9e, 0xa1, 0x51, 0x08, 0xb5, 0x91, 0x49, 0xab, 0x98,
-	0x6a, 0x82, 0xc9, 0x40, 0xd5, 0x79, 0x4c, 0xe8, 0x0b, 0x08, 0x4f, 0x06, 0xd5, 0xd2, 0x3a, 0x4c,
-	0x7b, 0x49, 0x5b, 0xcb, 0xa0, 0xcc, 0xb0, 0x1d, 0xf9, 0x49, 0xb7, 0x3e, 0x08, 0x2b, 0x7e, 0x44,
-	0x9f, 0x01, 0x5c, 0x92, 0xd9, 0x8d, 0x6e, 0x37, 0x9d, 0x66, 0xda, 0x57, 0xe9, 0x34, 0x33, 0xfe,
-	0x38, 0xf5, 0x74, 0x24, 0xcd, 0xf6, 0xd6, 0xf1, 0x40, 0x07, 0x27, 0x03, 0x1d, 0xfc, 0x1d, 0xe8,
-	0xe0, 0x68, 0xa8, 0xe7, 0x4e, 0x86, 0x7a, 0xee, 0xf7, 0x50, 0xcf, 0xbd, 0xae, 0x4c, 0x72, 0xde,
-	0x8d, 0xb3, 0x82, 0x43, 0x8f, 0xf0, 0x76, 0x41, 0xfc, 0x2d, 0x6d, 0xfd, 0x0f, 0x00, 0x00, 0xff,
-	0xff, 0xea, 0x80, 0x0c, 0x78, 0x6a, 0x07, 0x00, 0x00,
+func (m *QueryPendingRewardsRequest) Reset()         { *m = QueryPendingRewardsRequest{} }
+func (m *QueryPendingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsRequest) ProtoMessage()    {}
+func (*QueryPendingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e958742cdc1ac27b, []int{10}
+}
+func (m *QueryPendingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsRequest.Merge(m, src)
+}
+func (m *QueryPendingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPendingRewardsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of DevEarnInfos items.
-	DevEarnInfos(ctx context.Context, in *QueryDevEarnInfosRequest, opts ...grpc.CallOption) (*QueryDevEarnInfosResponse, error)
-	// Queries a list of DevEarnInfo items.
-	DevEarnInfo(ctx context.Context, in *QueryDevEarnInfoRequest, opts ...grpc.CallOption) (*QueryDevEarnInfoResponse, error)
-	// Queries a list of Assets items.
-	Assets(ctx context.Context, in *QueryGetAssetsRequest, opts ...grpc.CallOption) (*QueryGetAssetsResponse, error)
-	AssetsAll(ctx context.Context, in *QueryAllAssetsRequest, opts ...grpc.CallOption) (*QueryAllAssetsResponse, error)
+func (m *QueryPendingRewardsRequest) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryPendingRewardsResponse struct {
+	// rewards are the unclaimed rewards of the owner per contract
+	Rewards []AccruedRewards `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// total is the sum of the unclaimed rewards of the owner
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPendingRewardsResponse) Reset()         { *m = QueryPendingRewardsResponse{} }
+func (m *QueryPendingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsResponse) ProtoMessage()    {}
+func (*QueryPendingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e958742cdc1ac27b, []int{11}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPendingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsResponse.Merge(m, src)
+}
+func (m *QueryPendingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DevEarnInfos(ctx context.Context, in *QueryDevEarnInfosRequest, opts ...grpc.CallOption) (*QueryDevEarnInfosResponse, error) {
-	out := new(QueryDevEarnInfosResponse)
-	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/DevEarnInfos", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRewardsResponse) GetRewards() []AccruedRewards {
+	if m != nil {
+		return m.Rewards
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DevEarnInfo(ctx context.Context, in *QueryDevEarnInfoRequest, opts ...grpc.CallOption) (*QueryDevEarnInfoResponse, error) {
-	out := new(QueryDevEarnInfoResponse)
-	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/DevEarnInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingRewardsResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Assets(ctx context.Context, in *QueryGetAssetsRequest, opts ...grpc.CallOption) (*QueryGetAssetsResponse, error) {
-	out := new(QueryGetAssetsResponse)
-	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/Assets", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryContractPendingRewardsRequest struct {
+	// contract is the hex address of the contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
 }
 
-func (c *queryClient) AssetsAll(ctx context.Context, in *QueryAllAssetsRequest, opts ...grpc.CallOption) (*QueryAllAssetsResponse, error) {
-	out := new(QueryAllAssetsResponse)
-	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/AssetsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryContractPendingRewardsRequest) Reset()         { *m = QueryContractPendingRewardsRequest{} }
+func (m *QueryContractPendingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractPendingRewardsRequest) ProtoMessage()    {}
+func (*QueryContractPendingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e958742cdc1ac27b, []int{12}
+}
+func (m *QueryContractPendingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractPendingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractPendingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryContractPendingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractPendingRewardsRequest.Merge(m, src)
+}
+func (m *QueryContractPendingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractPendingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractPendingRewardsRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of DevEarnInfos items.
-	DevEarnInfos(context.Context, *QueryDevEarnInfosRequest) (*QueryDevEarnInfosResponse, error)
-	// Queries a list of DevEarnInfo items.
-	DevEarnInfo(context.Context, *QueryDevEarnInfoRequest) (*QueryDevEarnInfoResponse, error)
-	// Queries a list of Assets items.
-	Assets(context.Context, *QueryGetAssetsRequest) (*QueryGetAssetsResponse, error)
-	AssetsAll(context.Context, *QueryAllAssetsRequest) (*QueryAllAssetsResponse, error)
+var xxx_messageInfo_QueryContractPendingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryContractPendingRewardsRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryContractPendingRewardsResponse struct {
+	// rewards are the unclaimed rewards earned by the contract per owner
+	Rewards []AccruedRewards `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// total is the sum of the unclaimed rewards earned by the contract
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryContractPendingRewardsResponse) Reset()         { *m = QueryContractPendingRewardsResponse{} }
+func (m *QueryContractPendingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractPendingRewardsResponse) ProtoMessage()    {}
+func (*QueryContractPendingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e958742cdc1ac27b, []int{13}
 }
-func (*UnimplementedQueryServer) DevEarnInfos(ctx context.Context, req *QueryDevEarnInfosRequest) (*QueryDevEarnInfosResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DevEarnInfos not implemented")
+func (m *QueryContractPendingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DevEarnInfo(ctx context.Context, req *QueryDevEarnInfoRequest) (*QueryDevEarnInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DevEarnInfo not implemented")
+func (m *QueryContractPendingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractPendingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Assets(ctx context.Context, req *QueryGetAssetsRequest) (*QueryGetAssetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Assets not implemented")
+func (m *QueryContractPendingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractPendingRewardsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AssetsAll(ctx context.Context, req *QueryAllAssetsRequest) (*QueryAllAssetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssetsAll not implemented")
+func (m *QueryContractPendingRewardsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryContractPendingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractPendingRewardsResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sidechain.devearn.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryContractPendingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryContractPendingRewardsResponse) GetRewards() []AccruedRewards {
+	if m != nil {
+		return m.Rewards
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DevEarnInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDevEarnInfosRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DevEarnInfos(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sidechain.devearn.Query/DevEarnInfos",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DevEarnInfos(ctx, req.(*QueryDevEarnInfosRequest))
+func (m *QueryContractPendingRewardsResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DevEarnInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDevEarnInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DevEarnInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sidechain.devearn.Query/DevEarnInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DevEarnInfo(ctx, req.(*QueryDevEarnInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryEpochRewardsRequest struct {
+	// epoch is the number of the epoch
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// pagination defines an optional pagination for the contract records.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Assets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAssetsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Assets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sidechain.devearn.Query/Assets",
+func (m *QueryEpochRewardsRequest) Reset()         { *m = QueryEpochRewardsRequest{} }
+func (m *QueryEpochRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochRewardsRequest) ProtoMessage()    {}
+func (*QueryEpochRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e958742cdc1ac27b, []int{14}
+}
+func (m *QueryEpochRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Assets(ctx, req.(*QueryGetAssetsRequest))
+}
+func (m *QueryEpochRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochRewardsRequest.Merge(m, src)
+}
+func (m *QueryEpochRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryEpochRewardsRequest) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_AssetsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllAssetsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryEpochRewardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AssetsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sidechain.devearn.Query/AssetsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AssetsAll(ctx, req.(*QueryAllAssetsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sidechain.devearn.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DevEarnInfos",
-			Handler:    _Query_DevEarnInfos_Handler,
-		},
-		{
-			MethodName: "DevEarnInfo",
-			Handler:    _Query_DevEarnInfo_Handler,
-		},
-		{
-			MethodName: "Assets",
-			Handler:    _Query_Assets_Handler,
-		},
-		{
-			MethodName: "AssetsAll",
-			Handler:    _Query_AssetsAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sidechain/devearn/query.proto",
+type QueryEpochRewardsResponse struct {
+	// record is the summary of the epoch's distribution
+	Record EpochRewardRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+	// contracts are the rewards earned by each contract in the epoch
+	Contracts []ContractRewardRecord `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEpochRewardsResponse) Reset()         { *m = QueryEpochRewardsResponse{} }
+func (m *QueryEpochRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochRewardsResponse) ProtoMessage()    {}
+func (*QueryEpochRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e958742cdc1ac27b, []int{15}
+}
+func (m *QueryEpochRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryEpochRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochRewardsResponse.Merge(m, src)
+}
+func (m *QueryEpochRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryEpochRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryEpochRewardsResponse) GetRecord() EpochRewardRecord {
+	if m != nil {
+		return m.Record
+	}
+	return EpochRewardRecord{}
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEpochRewardsResponse) GetContracts() []ContractRewardRecord {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEpochRewardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryContractRewardHistoryRequest struct {
+	// contract is the hex address of the contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryContractRewardHistoryRequest) Reset()         { *m = QueryContractRewardHistoryRequest{} }
+func (m *QueryContractRewardHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractRewardHistoryRequest) ProtoMessage()    {}
+func (*QueryContractRewardHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e958742cdc1ac27b, []int{16}
+}
+func (m *QueryContractRewardHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractRewardHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractRewardHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDevEarnInfosRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryContractRewardHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractRewardHistoryRequest.Merge(m, src)
 }
-
-func (m *QueryDevEarnInfosRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryContractRewardHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractRewardHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractRewardHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDevEarnInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryContractRewardHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryContractRewardHistoryRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDevEarnInfosResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContractRewardHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDevEarnInfosResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryContractRewardHistoryResponse struct {
+	// records are the rewards earned by the contract per epoch
+	Records []ContractRewardRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDevEarnInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevEarnInfos) > 0 {
-		for iNdEx := len(m.DevEarnInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DevEarnInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryContractRewardHistoryResponse) Reset()         { *m = QueryContractRewardHistoryResponse{} }
+func (m *QueryContractRewardHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractRewardHistoryResponse) ProtoMessage()    {}
+func (*QueryContractRewardHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e958742cdc1ac27b, []int{17}
+}
+func (m *QueryContractRewardHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractRewardHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractRewardHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDevEarnInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryContractRewardHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractRewardHistoryResponse.Merge(m, src)
 }
-
-func (m *QueryDevEarnInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryContractRewardHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractRewardHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractRewardHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDevEarnInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryContractRewardHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryContractRewardHistoryResponse) GetRecords() []ContractRewardRecord {
+	if m != nil {
+		return m.Records
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDevEarnInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContractRewardHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDevEarnInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryBlocklistRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDevEarnInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DevEarnInfo.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryBlocklistRequest) Reset()         { *m = QueryBlocklistRequest{} }
+func (m *QueryBlocklistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlocklistRequest) ProtoMessage()    {}
+func (*QueryBlocklistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e958742cdc1ac27b, []int{18}
+}
+func (m *QueryBlocklistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlocklistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlocklistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryBlocklistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlocklistRequest.Merge(m, src)
+}
+func (m *QueryBlocklistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlocklistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlocklistRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetAssetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlocklistRequest proto.InternalMessageInfo
+
+func (m *QueryBlocklistRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryBlocklistResponse struct {
+	// addresses are the hex addresses of the blocked contracts and deployers
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryBlocklistResponse) Reset()         { *m = QueryBlocklistResponse{} }
+func (m *QueryBlocklistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlocklistResponse) ProtoMessage()    {}
+func (*QueryBlocklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e958742cdc1ac27b, []int{19}
+}
+func (m *QueryBlocklistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlocklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlocklistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBlocklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlocklistResponse.Merge(m, src)
+}
+func (m *QueryBlocklistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlocklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlocklistResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetAssetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryBlocklistResponse proto.InternalMessageInfo
+
+func (m *QueryBlocklistResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QueryBlocklistResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "sidechain.devearn.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "sidechain.devearn.QueryParamsResponse")
+	proto.RegisterType((*QueryDevEarnInfosRequest)(nil), "sidechain.devearn.QueryDevEarnInfosRequest")
+	proto.RegisterType((*QueryDevEarnInfosResponse)(nil), "sidechain.devearn.QueryDevEarnInfosResponse")
+	proto.RegisterType((*QueryDevEarnInfoRequest)(nil), "sidechain.devearn.QueryDevEarnInfoRequest")
+	proto.RegisterType((*QueryDevEarnInfoResponse)(nil), "sidechain.devearn.QueryDevEarnInfoResponse")
+	proto.RegisterType((*QueryGetAssetsRequest)(nil), "sidechain.devearn.QueryGetAssetsRequest")
+	proto.RegisterType((*QueryGetAssetsResponse)(nil), "sidechain.devearn.QueryGetAssetsResponse")
+	proto.RegisterType((*QueryAllAssetsRequest)(nil), "sidechain.devearn.QueryAllAssetsRequest")
+	proto.RegisterType((*QueryAllAssetsResponse)(nil), "sidechain.devearn.QueryAllAssetsResponse")
+	proto.RegisterType((*QueryPendingRewardsRequest)(nil), "sidechain.devearn.QueryPendingRewardsRequest")
+	proto.RegisterType((*QueryPendingRewardsResponse)(nil), "sidechain.devearn.QueryPendingRewardsResponse")
+	proto.RegisterType((*QueryContractPendingRewardsRequest)(nil), "sidechain.devearn.QueryContractPendingRewardsRequest")
+	proto.RegisterType((*QueryContractPendingRewardsResponse)(nil), "sidechain.devearn.QueryContractPendingRewardsResponse")
+	proto.RegisterType((*QueryEpochRewardsRequest)(nil), "sidechain.devearn.QueryEpochRewardsRequest")
+	proto.RegisterType((*QueryEpochRewardsResponse)(nil), "sidechain.devearn.QueryEpochRewardsResponse")
+	proto.RegisterType((*QueryContractRewardHistoryRequest)(nil), "sidechain.devearn.QueryContractRewardHistoryRequest")
+	proto.RegisterType((*QueryContractRewardHistoryResponse)(nil), "sidechain.devearn.QueryContractRewardHistoryResponse")
+	proto.RegisterType((*QueryBlocklistRequest)(nil), "sidechain.devearn.QueryBlocklistRequest")
+	proto.RegisterType((*QueryBlocklistResponse)(nil), "sidechain.devearn.QueryBlocklistResponse")
+}
+
+func init() { proto.RegisterFile("sidechain/devearn/query.proto", fileDescriptor_e958742cdc1ac27b) }
+
+var fileDescriptor_e958742cdc1ac27b = []byte{
+	// 1111 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0x80, 0x33, 0x09, 0x09, 0xf8, 0x25, 0xad, 0xc4, 0x90, 0x86, 0x64, 0x1b, 0x9c, 0x64, 0x53,
+	0x5a, 0x27, 0x4d, 0x76, 0xdb, 0x34, 0x29, 0x88, 0x03, 0xc2, 0x2e, 0x6d, 0x0a, 0x48, 0xa8, 0xf5,
+	0x91, 0x8b, 0xb5, 0xde, 0x1d, 0x9c, 0x55, 0x9d, 0x1d, 0x77, 0x77, 0x93, 0x36, 0x54, 0x11, 0x12,
+	0x48, 0xc0, 0xb1, 0x12, 0x9c, 0xca, 0x3f, 0x80, 0x0b, 0x27, 0x0e, 0x5c, 0x39, 0x50, 0x89, 0x4b,
+	0x25, 0x2e, 0x9c, 0x00, 0x25, 0xfc, 0x05, 0xee, 0xc8, 0x33, 0x6f, 0xec, 0x5d, 0x7b, 0xd6, 0xd9,
+	0x54, 0xbe, 0xf4, 0x94, 0xec, 0xcc, 0x7b, 0x6f, 0xbe, 0xf7, 0xe6, 0xbd, 0x79, 0xcf, 0xf0, 0x46,
+	0xe4, 0x7b, 0xcc, 0xdd, 0x71, 0xfc, 0xc0, 0xf6, 0xd8, 0x3e, 0x73, 0xc2, 0xc0, 0xbe, 0xbf, 0xc7,
+	0xc2, 0x03, 0xab, 0x15, 0xf2, 0x98, 0xd3, 0x57, 0x3b, 0xdb, 0x16, 0x6e, 0x1b, 0xd3, 0x0d, 0xde,
+	0xe0, 0x62, 0xd7, 0x6e, 0xff, 0x27, 0x05, 0x8d, 0xf9, 0x06, 0xe7, 0x8d, 0x26, 0xb3, 0x9d, 0x96,
+	0x6f, 0x3b, 0x41, 0xc0, 0x63, 0x27, 0xf6, 0x79, 0x10, 0xe1, 0xee, 0xaa, 0xcb, 0xa3, 0x5d, 0x1e,
+	0xd9, 0x75, 0x27, 0x62, 0xd2, 0xbe, 0xbd, 0x7f, 0xb5, 0xce, 0x62, 0xe7, 0xaa, 0xdd, 0x72, 0x1a,
+	0x7e, 0x20, 0x84, 0x51, 0xb6, 0xd8, 0x4f, 0xd4, 0x72, 0x42, 0x67, 0x37, 0xca, 0xde, 0x77, 0xa2,
+	0x88, 0xc5, 0x6a, 0x7f, 0xa1, 0x7f, 0x3f, 0x64, 0x0f, 0x9c, 0xd0, 0xeb, 0x18, 0x48, 0xc2, 0x28,
+	0x0c, 0x97, 0xfb, 0x08, 0x60, 0x4e, 0x03, 0xbd, 0xdb, 0x46, 0xbc, 0x23, 0x4e, 0xad, 0xb2, 0xfb,
+	0x7b, 0x2c, 0x8a, 0xcd, 0x8f, 0xe1, 0xb5, 0xd4, 0x6a, 0xd4, 0xe2, 0x41, 0xc4, 0xe8, 0x5b, 0x30,
+	0x21, 0xe9, 0x66, 0xc9, 0x22, 0x29, 0x4d, 0x6e, 0xcc, 0x59, 0x7d, 0x11, 0xb3, 0xa4, 0x4a, 0xe5,
+	0xa5, 0xa7, 0x7f, 0x2d, 0x8c, 0x54, 0x51, 0xdc, 0xac, 0xc3, 0xac, 0xb0, 0xf7, 0x3e, 0xdb, 0xbf,
+	0xe9, 0x84, 0xc1, 0x07, 0xc1, 0xa7, 0x5c, 0x9d, 0x45, 0x6f, 0x01, 0x74, 0xc3, 0x82, 0x86, 0x2f,
+	0x5a, 0x12, 0xdb, 0x6a, 0x63, 0x5b, 0xf2, 0x8e, 0x10, 0xde, 0xba, 0xe3, 0x34, 0x18, 0xea, 0x56,
+	0x13, 0x9a, 0xe6, 0x4f, 0x04, 0xe6, 0x34, 0x87, 0x20, 0xfa, 0x87, 0x70, 0xd6, 0x63, 0xfb, 0xb5,
+	0x36, 0x62, 0xcd, 0x6f, 0xef, 0xcc, 0x92, 0xc5, 0xb1, 0xd2, 0xe4, 0x46, 0x51, 0xe3, 0x42, 0xc2,
+	0x00, 0xfa, 0x31, 0xe5, 0x25, 0x6c, 0xd2, 0xed, 0x14, 0xf1, 0xa8, 0x20, 0xbe, 0x74, 0x22, 0xb1,
+	0x04, 0x49, 0x21, 0x6f, 0xc1, 0xeb, 0xbd, 0xc4, 0x2a, 0x2a, 0x06, 0xbc, 0xe2, 0xf2, 0x20, 0x0e,
+	0x1d, 0x37, 0x16, 0x31, 0x29, 0x54, 0x3b, 0xdf, 0xa6, 0xd7, 0x1f, 0xcd, 0x8e, 0x9f, 0xb7, 0xe1,
+	0x4c, 0xca, 0x4f, 0x0c, 0x68, 0x3e, 0x37, 0x27, 0x13, 0x6e, 0x9a, 0xeb, 0x70, 0x4e, 0x9c, 0xb2,
+	0xcd, 0xe2, 0xb2, 0x48, 0x39, 0x85, 0x36, 0x0d, 0xe3, 0x1e, 0x0b, 0xf8, 0x2e, 0x72, 0xc9, 0x0f,
+	0xf3, 0x2e, 0xcc, 0xf4, 0x8a, 0x77, 0xb3, 0x46, 0xae, 0x0c, 0xc8, 0x1a, 0x29, 0xa0, 0xb2, 0x46,
+	0x7e, 0x99, 0x35, 0x24, 0x28, 0x37, 0x9b, 0x69, 0x82, 0x61, 0xa5, 0xcc, 0x13, 0x02, 0x33, 0xbd,
+	0x27, 0x68, 0xa0, 0xc7, 0x4e, 0x01, 0x3d, 0xbc, 0xe4, 0x28, 0x83, 0x21, 0x6b, 0x90, 0x05, 0x9e,
+	0x1f, 0x34, 0xaa, 0xb2, 0xac, 0x55, 0x08, 0x96, 0xe1, 0x0c, 0x7f, 0x10, 0xb0, 0xb0, 0xe6, 0x78,
+	0x5e, 0xc8, 0xa2, 0x08, 0x2f, 0x63, 0x4a, 0x2c, 0x96, 0xe5, 0x9a, 0xf9, 0x2b, 0x81, 0xf3, 0x5a,
+	0x1b, 0xe8, 0x64, 0x19, 0x5e, 0xc6, 0xd7, 0x02, 0xbd, 0x5c, 0xd2, 0x79, 0xe9, 0xba, 0xe1, 0x1e,
+	0xf3, 0x50, 0x17, 0xbd, 0x55, 0x7a, 0xd4, 0x81, 0xf1, 0x98, 0xc7, 0x4e, 0x73, 0x76, 0x14, 0xc3,
+	0x94, 0xf4, 0x54, 0xf9, 0x78, 0x83, 0xfb, 0x41, 0xe5, 0x4a, 0x5b, 0xf1, 0x87, 0xbf, 0x17, 0x4a,
+	0x0d, 0x3f, 0xde, 0xd9, 0xab, 0x5b, 0x2e, 0xdf, 0xb5, 0xf1, 0x71, 0x92, 0x7f, 0xd6, 0x23, 0xef,
+	0x9e, 0x1d, 0x1f, 0xb4, 0x58, 0x24, 0x14, 0xa2, 0xaa, 0xb4, 0x6c, 0xbe, 0x07, 0xa6, 0x70, 0xe2,
+	0x06, 0xe6, 0xbf, 0x3e, 0x20, 0x83, 0x0a, 0xe6, 0x77, 0x02, 0xcb, 0x03, 0x4d, 0xbc, 0x50, 0xf1,
+	0x78, 0x88, 0xe5, 0x7f, 0xb3, 0xc5, 0xdd, 0x9d, 0x9e, 0x28, 0x4c, 0xc3, 0x38, 0x6b, 0x2f, 0x8b,
+	0x10, 0x8c, 0x55, 0xe5, 0x07, 0xbd, 0xa5, 0xc9, 0xc9, 0xe7, 0xa9, 0x97, 0xff, 0xd4, 0x13, 0x9b,
+	0x3e, 0x1a, 0xa3, 0x57, 0x81, 0x89, 0x90, 0xb9, 0x3c, 0xf4, 0xb0, 0x22, 0x2f, 0x68, 0x82, 0x97,
+	0x50, 0xac, 0x0a, 0x59, 0x55, 0x3d, 0x52, 0x93, 0x7e, 0x04, 0x05, 0x75, 0x6b, 0x11, 0x86, 0xf0,
+	0x92, 0xc6, 0x8c, 0xba, 0x47, 0x8d, 0xa5, 0xae, 0x7e, 0x4f, 0x29, 0x8e, 0x3d, 0x7f, 0x29, 0x7e,
+	0x4d, 0x60, 0x29, 0x95, 0x3f, 0xf2, 0xdc, 0xdb, 0x7e, 0x14, 0xf3, 0xf0, 0x20, 0x47, 0x06, 0x0e,
+	0xed, 0x06, 0x7e, 0x26, 0x60, 0x0e, 0x22, 0xc1, 0xab, 0xd8, 0x6e, 0x27, 0xb2, 0xcb, 0xbb, 0x89,
+	0x7c, 0xca, 0x20, 0x2a, 0xed, 0xe1, 0xbd, 0x66, 0xea, 0x2d, 0xaf, 0x34, 0xb9, 0x7b, 0xaf, 0xe9,
+	0x47, 0xf1, 0xb0, 0xdf, 0xf2, 0xcf, 0x61, 0xa6, 0xf7, 0x00, 0x0c, 0xc6, 0x3c, 0x14, 0xf0, 0x91,
+	0x64, 0x32, 0x1c, 0x85, 0x6a, 0x77, 0x61, 0x68, 0x1e, 0x6e, 0x3c, 0x99, 0x82, 0x71, 0x41, 0x40,
+	0x3f, 0x83, 0x09, 0x39, 0x05, 0xd1, 0x37, 0x35, 0x61, 0xef, 0x1f, 0xb7, 0x8c, 0x8b, 0x27, 0x89,
+	0xc9, 0xe3, 0xcc, 0xa5, 0x2f, 0xfe, 0xf8, 0xf7, 0xdb, 0xd1, 0xf3, 0x74, 0xce, 0xce, 0x1a, 0x1b,
+	0xe9, 0x77, 0x04, 0xa6, 0x92, 0x03, 0x10, 0xbd, 0x9c, 0x65, 0x5b, 0x33, 0x8b, 0x19, 0x6b, 0xf9,
+	0x84, 0x11, 0x67, 0x45, 0xe0, 0x2c, 0xd3, 0x25, 0x0d, 0x4e, 0x7a, 0xd8, 0xa2, 0x8f, 0x09, 0x4c,
+	0x26, 0x6c, 0xd0, 0xd5, 0x1c, 0x07, 0x29, 0xa8, 0xcb, 0xb9, 0x64, 0x91, 0xa9, 0x24, 0x98, 0x4c,
+	0xba, 0x78, 0x12, 0x13, 0xfd, 0x86, 0xa8, 0x16, 0x4f, 0x4b, 0x59, 0x27, 0xf4, 0xce, 0x3e, 0xc6,
+	0x4a, 0x0e, 0xc9, 0x1c, 0xd1, 0x91, 0x33, 0xbc, 0xfd, 0x48, 0x8c, 0x4e, 0x87, 0xf4, 0x4b, 0x02,
+	0x05, 0xa9, 0x5d, 0x6e, 0x36, 0xb3, 0x69, 0x7a, 0xe7, 0x20, 0x63, 0x25, 0x87, 0x64, 0x8e, 0xd4,
+	0x91, 0x34, 0xf4, 0x47, 0x02, 0x67, 0xd3, 0x8d, 0x91, 0xae, 0x67, 0x26, 0xa6, 0xae, 0x07, 0x1b,
+	0x56, 0x5e, 0x71, 0x84, 0x7a, 0x47, 0x40, 0x6d, 0xd2, 0x0d, 0x5d, 0x3e, 0x4b, 0x95, 0x1a, 0x36,
+	0x56, 0xfb, 0x51, 0x6a, 0xdc, 0x39, 0xa4, 0xbf, 0x11, 0x98, 0xd1, 0xb7, 0x73, 0xba, 0x95, 0x85,
+	0x31, 0x70, 0x82, 0x30, 0xae, 0x9f, 0x56, 0x0d, 0xbd, 0x78, 0x57, 0x78, 0xf1, 0x36, 0xbd, 0xae,
+	0xf1, 0x42, 0x35, 0x80, 0x5a, 0x9f, 0x3b, 0x6a, 0xe7, 0x90, 0x7e, 0x4f, 0x60, 0x2a, 0xd9, 0x50,
+	0xb3, 0x4b, 0x56, 0xd3, 0xf1, 0x8d, 0xb5, 0x7c, 0xc2, 0xc8, 0x7a, 0x45, 0xb0, 0xae, 0xd2, 0x92,
+	0x86, 0x55, 0xcc, 0x0a, 0x5d, 0x40, 0xf1, 0x79, 0x48, 0x7f, 0x21, 0x70, 0x4e, 0xdb, 0x6c, 0xe8,
+	0xe6, 0x49, 0xf1, 0xd2, 0x75, 0x49, 0x63, 0xeb, 0x94, 0x5a, 0x08, 0xbe, 0x29, 0xc0, 0x2d, 0xba,
+	0x66, 0x67, 0xfd, 0xe2, 0xad, 0xed, 0x48, 0x95, 0x64, 0x68, 0xbf, 0x22, 0x50, 0xe8, 0x34, 0x84,
+	0xec, 0xc2, 0xea, 0x6d, 0x4a, 0xc6, 0x4a, 0x0e, 0x49, 0x04, 0xbb, 0x20, 0xc0, 0x8a, 0x74, 0x5e,
+	0x03, 0x56, 0x57, 0xd2, 0x95, 0x6b, 0x4f, 0x8f, 0x8a, 0xe4, 0xd9, 0x51, 0x91, 0xfc, 0x73, 0x54,
+	0x24, 0x8f, 0x8f, 0x8b, 0x23, 0xcf, 0x8e, 0x8b, 0x23, 0x7f, 0x1e, 0x17, 0x47, 0x3e, 0x99, 0xeb,
+	0xaa, 0x3d, 0xec, 0x28, 0x8a, 0xa9, 0xaf, 0x3e, 0x21, 0x7e, 0xa2, 0x5f, 0xfb, 0x7f, 0x00, 0x2a,
+	0x27, 0x99, 0x67, 0xb7, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of DevEarnInfos items.
+	DevEarnInfos(ctx context.Context, in *QueryDevEarnInfosRequest, opts ...grpc.CallOption) (*QueryDevEarnInfosResponse, error)
+	// Queries a list of DevEarnInfo items.
+	DevEarnInfo(ctx context.Context, in *QueryDevEarnInfoRequest, opts ...grpc.CallOption) (*QueryDevEarnInfoResponse, error)
+	// Queries a list of Assets items.
+	Assets(ctx context.Context, in *QueryGetAssetsRequest, opts ...grpc.CallOption) (*QueryGetAssetsResponse, error)
+	AssetsAll(ctx context.Context, in *QueryAllAssetsRequest, opts ...grpc.CallOption) (*QueryAllAssetsResponse, error)
+	// PendingRewards queries the unclaimed rewards of an owner
+	PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error)
+	// ContractPendingRewards queries the unclaimed rewards earned by a contract
+	ContractPendingRewards(ctx context.Context, in *QueryContractPendingRewardsRequest, opts ...grpc.CallOption) (*QueryContractPendingRewardsResponse, error)
+	// EpochRewards queries the rewards distributed at the end of an epoch
+	EpochRewards(ctx context.Context, in *QueryEpochRewardsRequest, opts ...grpc.CallOption) (*QueryEpochRewardsResponse, error)
+	// ContractRewardHistory queries the rewards earned by a contract per epoch
+	ContractRewardHistory(ctx context.Context, in *QueryContractRewardHistoryRequest, opts ...grpc.CallOption) (*QueryContractRewardHistoryResponse, error)
+	// Blocklist queries the contract and deployer addresses excluded from dev earn
+	Blocklist(ctx context.Context, in *QueryBlocklistRequest, opts ...grpc.CallOption) (*QueryBlocklistResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DevEarnInfos(ctx context.Context, in *QueryDevEarnInfosRequest, opts ...grpc.CallOption) (*QueryDevEarnInfosResponse, error) {
+	out := new(QueryDevEarnInfosResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/DevEarnInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) DevEarnInfo(ctx context.Context, in *QueryDevEarnInfoRequest, opts ...grpc.CallOption) (*QueryDevEarnInfoResponse, error) {
+	out := new(QueryDevEarnInfoResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/DevEarnInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllAssetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Assets(ctx context.Context, in *QueryGetAssetsRequest, opts ...grpc.CallOption) (*QueryGetAssetsResponse, error) {
+	out := new(QueryGetAssetsResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/Assets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AssetsAll(ctx context.Context, in *QueryAllAssetsRequest, opts ...grpc.CallOption) (*QueryAllAssetsResponse, error) {
+	out := new(QueryAllAssetsResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/AssetsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error) {
+	out := new(QueryPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/PendingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllAssetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContractPendingRewards(ctx context.Context, in *QueryContractPendingRewardsRequest, opts ...grpc.CallOption) (*QueryContractPendingRewardsResponse, error) {
+	out := new(QueryContractPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/ContractPendingRewards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EpochRewards(ctx context.Context, in *QueryEpochRewardsRequest, opts ...grpc.CallOption) (*QueryEpochRewardsResponse, error) {
+	out := new(QueryEpochRewardsResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/EpochRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) ContractRewardHistory(ctx context.Context, in *QueryContractRewardHistoryRequest, opts ...grpc.CallOption) (*QueryContractRewardHistoryResponse, error) {
+	out := new(QueryContractRewardHistoryResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/ContractRewardHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Assets) > 0 {
-		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) Blocklist(ctx context.Context, in *QueryBlocklistRequest, opts ...grpc.CallOption) (*QueryBlocklistResponse, error) {
+	out := new(QueryBlocklistResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Query/Blocklist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of DevEarnInfos items.
+	DevEarnInfos(context.Context, *QueryDevEarnInfosRequest) (*QueryDevEarnInfosResponse, error)
+	// Queries a list of DevEarnInfo items.
+	DevEarnInfo(context.Context, *QueryDevEarnInfoRequest) (*QueryDevEarnInfoResponse, error)
+	// Queries a list of Assets items.
+	Assets(context.Context, *QueryGetAssetsRequest) (*QueryGetAssetsResponse, error)
+	AssetsAll(context.Context, *QueryAllAssetsRequest) (*QueryAllAssetsResponse, error)
+	// PendingRewards queries the unclaimed rewards of an owner
+	PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error)
+	// ContractPendingRewards queries the unclaimed rewards earned by a contract
+	ContractPendingRewards(context.Context, *QueryContractPendingRewardsRequest) (*QueryContractPendingRewardsResponse, error)
+	// EpochRewards queries the rewards distributed at the end of an epoch
+	EpochRewards(context.Context, *QueryEpochRewardsRequest) (*QueryEpochRewardsResponse, error)
+	// ContractRewardHistory queries the rewards earned by a contract per epoch
+	ContractRewardHistory(context.Context, *QueryContractRewardHistoryRequest) (*QueryContractRewardHistoryResponse, error)
+	// Blocklist queries the contract and deployer addresses excluded from dev earn
+	Blocklist(context.Context, *QueryBlocklistRequest) (*QueryBlocklistResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DevEarnInfos(ctx context.Context, req *QueryDevEarnInfosRequest) (*QueryDevEarnInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DevEarnInfos not implemented")
+}
+func (*UnimplementedQueryServer) DevEarnInfo(ctx context.Context, req *QueryDevEarnInfoRequest) (*QueryDevEarnInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DevEarnInfo not implemented")
+}
+func (*UnimplementedQueryServer) Assets(ctx context.Context, req *QueryGetAssetsRequest) (*QueryGetAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Assets not implemented")
+}
+func (*UnimplementedQueryServer) AssetsAll(ctx context.Context, req *QueryAllAssetsRequest) (*QueryAllAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetsAll not implemented")
+}
+func (*UnimplementedQueryServer) PendingRewards(ctx context.Context, req *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
+func (*UnimplementedQueryServer) ContractPendingRewards(ctx context.Context, req *QueryContractPendingRewardsRequest) (*QueryContractPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractPendingRewards not implemented")
+}
+func (*UnimplementedQueryServer) EpochRewards(ctx context.Context, req *QueryEpochRewardsRequest) (*QueryEpochRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochRewards not implemented")
+}
+func (*UnimplementedQueryServer) ContractRewardHistory(ctx context.Context, req *QueryContractRewardHistoryRequest) (*QueryContractRewardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractRewardHistory not implemented")
+}
+func (*UnimplementedQueryServer) Blocklist(ctx context.Context, req *QueryBlocklistRequest) (*QueryBlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Blocklist not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Query_DevEarnInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDevEarnInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).DevEarnInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Query/DevEarnInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DevEarnInfos(ctx, req.(*QueryDevEarnInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_DevEarnInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDevEarnInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).DevEarnInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Query/DevEarnInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DevEarnInfo(ctx, req.(*QueryDevEarnInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDevEarnInfosRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Assets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Assets(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Query/Assets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Assets(ctx, req.(*QueryGetAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDevEarnInfosResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AssetsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.DevEarnInfos) > 0 {
-		for _, e := range m.DevEarnInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetsAll(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Query/AssetsAll",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetsAll(ctx, req.(*QueryAllAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDevEarnInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRewards(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Query/PendingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRewards(ctx, req.(*QueryPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDevEarnInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ContractPendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.DevEarnInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).ContractPendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Query/ContractPendingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractPendingRewards(ctx, req.(*QueryContractPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAssetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EpochRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).EpochRewards(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Query/EpochRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochRewards(ctx, req.(*QueryEpochRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAssetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ContractRewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractRewardHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractRewardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Query/ContractRewardHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractRewardHistory(ctx, req.(*QueryContractRewardHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Blocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlocklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Blocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Query/Blocklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Blocklist(ctx, req.(*QueryBlocklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sidechain.devearn.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DevEarnInfos",
+			Handler:    _Query_DevEarnInfos_Handler,
+		},
+		{
+			MethodName: "DevEarnInfo",
+			Handler:    _Query_DevEarnInfo_Handler,
+		},
+		{
+			MethodName: "Assets",
+			Handler:    _Query_Assets_Handler,
+		},
+		{
+			MethodName: "AssetsAll",
+			Handler:    _Query_AssetsAll_Handler,
+		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _Query_PendingRewards_Handler,
+		},
+		{
+			MethodName: "ContractPendingRewards",
+			Handler:    _Query_ContractPendingRewards_Handler,
+		},
+		{
+			MethodName: "EpochRewards",
+			Handler:    _Query_EpochRewards_Handler,
+		},
+		{
+			MethodName: "ContractRewardHistory",
+			Handler:    _Query_ContractRewardHistory_Handler,
+		},
+		{
+			MethodName: "Blocklist",
+			Handler:    _Query_Blocklist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sidechain/devearn/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDevEarnInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDevEarnInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDevEarnInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDevEarnInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDevEarnInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDevEarnInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DevEarnInfos) > 0 {
+		for iNdEx := len(m.DevEarnInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DevEarnInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDevEarnInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDevEarnInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDevEarnInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDevEarnInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDevEarnInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDevEarnInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DevEarnInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAssetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAssetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAssetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAssetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractPendingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractPendingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractPendingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractPendingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractPendingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractPendingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractRewardHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractRewardHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractRewardHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractRewardHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractRewardHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractRewardHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlocklistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlocklistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlocklistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlocklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlocklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlocklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDevEarnInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDevEarnInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DevEarnInfos) > 0 {
+		for _, e := range m.DevEarnInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDevEarnInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDevEarnInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DevEarnInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetAssetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Assets.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAssetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryContractPendingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractPendingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEpochRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractRewardHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractRewardHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlocklistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlocklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDevEarnInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDevEarnInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDevEarnInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDevEarnInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDevEarnInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDevEarnInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEarnInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevEarnInfos = append(m.DevEarnInfos, DevEarnInfo{})
+			if err := m.DevEarnInfos[len(m.DevEarnInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDevEarnInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDevEarnInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDevEarnInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDevEarnInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDevEarnInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDevEarnInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEarnInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevEarnInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAssetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAssetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAssetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAssetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAssetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAssetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Assets.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QueryAllAssetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryAllAssetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Assets) > 0 {
-		for _, e := range m.Assets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllAssetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAssetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, Assets{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1296,12 +3530,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1323,7 +3589,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1346,15 +3612,49 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, AccruedRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1381,7 +3681,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1406,7 +3707,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDevEarnInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractPendingRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1429,17 +3730,17 @@ func (m *QueryDevEarnInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDevEarnInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractPendingRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDevEarnInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractPendingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1449,27 +3750,23 @@ func (m *QueryDevEarnInfosRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1492,7 +3789,7 @@ func (m *QueryDevEarnInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDevEarnInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractPendingRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1515,15 +3812,15 @@ func (m *QueryDevEarnInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDevEarnInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractPendingRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDevEarnInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractPendingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevEarnInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1550,14 +3847,14 @@ func (m *QueryDevEarnInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DevEarnInfos = append(m.DevEarnInfos, DevEarnInfo{})
-			if err := m.DevEarnInfos[len(m.DevEarnInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, AccruedRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1584,10 +3881,8 @@ func (m *QueryDevEarnInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1612,7 +3907,7 @@ func (m *QueryDevEarnInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDevEarnInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1635,17 +3930,36 @@ func (m *QueryDevEarnInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDevEarnInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDevEarnInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1655,23 +3969,27 @@ func (m *QueryDevEarnInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1694,7 +4012,7 @@ func (m *QueryDevEarnInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDevEarnInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1717,15 +4035,15 @@ func (m *QueryDevEarnInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDevEarnInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDevEarnInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevEarnInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1752,7 +4070,77 @@ func (m *QueryDevEarnInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DevEarnInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, ContractRewardRecord{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1777,7 +4165,7 @@ func (m *QueryDevEarnInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAssetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractRewardHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1800,15 +4188,15 @@ func (m *QueryGetAssetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAssetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractRewardHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractRewardHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1836,7 +4224,43 @@ func (m *QueryGetAssetsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1859,7 +4283,7 @@ func (m *QueryGetAssetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAssetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractRewardHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1882,15 +4306,15 @@ func (m *QueryGetAssetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAssetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractRewardHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractRewardHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1917,7 +4341,44 @@ func (m *QueryGetAssetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, ContractRewardRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1942,7 +4403,7 @@ func (m *QueryGetAssetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAssetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlocklistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1965,10 +4426,10 @@ func (m *QueryAllAssetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAssetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlocklistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlocklistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2028,7 +4489,7 @@ func (m *QueryAllAssetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAssetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlocklistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2051,17 +4512,17 @@ func (m *QueryAllAssetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAssetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlocklistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlocklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2071,25 +4532,23 @@ func (m *QueryAllAssetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = append(m.Assets, Assets{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
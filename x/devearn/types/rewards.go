@@ -0,0 +1,155 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	sidetypes "sidechain/types"
+)
+
+// Validate performs a stateless validation of AccruedRewards
+func (a AccruedRewards) Validate() error {
+	if err := sidetypes.ValidateAddress(a.OwnerAddress); err != nil {
+		return err
+	}
+
+	if err := sidetypes.ValidateAddress(a.Contract); err != nil {
+		return err
+	}
+
+	return a.Rewards.Validate()
+}
+
+// Validate performs a stateless validation of an EpochRewardRecord
+func (r EpochRewardRecord) Validate() error {
+	if r.Epoch <= 0 {
+		return fmt.Errorf("invalid epoch number %d", r.Epoch)
+	}
+
+	return r.Rewards.Validate()
+}
+
+// Validate performs a stateless validation of a ContractRewardRecord
+func (r ContractRewardRecord) Validate() error {
+	if r.Epoch <= 0 {
+		return fmt.Errorf("invalid epoch number %d", r.Epoch)
+	}
+
+	if err := sidetypes.ValidateAddress(r.Contract); err != nil {
+		return err
+	}
+
+	if err := sidetypes.ValidateAddress(r.Receiver); err != nil {
+		return err
+	}
+
+	if r.GasShare.IsNil() || r.GasShare.IsNegative() || r.GasShare.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid gas share %s", r.GasShare)
+	}
+
+	if r.TvlRatio.IsNil() || r.TvlRatio.IsNegative() {
+		return fmt.Errorf("invalid tvl ratio %s", r.TvlRatio)
+	}
+
+	return r.Rewards.Validate()
+}
+
+// Validate performs a stateless validation of a DistributionProgress
+func (p DistributionProgress) Validate() error {
+	if p.Epoch <= 0 {
+		return fmt.Errorf("invalid epoch number %d", p.Epoch)
+	}
+
+	if len(p.Cursor) != 0 && len(p.Cursor) != common.AddressLength {
+		return fmt.Errorf("invalid cursor length %d", len(p.Cursor))
+	}
+
+	if err := p.RewardPool.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.Distributed.Validate(); err != nil {
+		return err
+	}
+
+	if !p.RewardPool.IsAllGTE(p.Distributed) {
+		return fmt.Errorf("distributed rewards %s exceed the reward pool %s", p.Distributed, p.RewardPool)
+	}
+
+	if err := p.Allocated.Validate(); err != nil {
+		return err
+	}
+
+	if p.TotalTvl.IsNil() || p.TotalTvl.IsNegative() {
+		return fmt.Errorf("invalid total tvl %s", p.TotalTvl)
+	}
+
+	return nil
+}
+
+// NewTvlAccumulator returns a TvlAccumulator holding a first sample
+func NewTvlAccumulator(tvl sdk.Dec, sampleTime time.Time) TvlAccumulator {
+	return TvlAccumulator{
+		WeightedTvl: sdk.ZeroDec(),
+		LastTvl:     tvl,
+		LastTime:    sampleTime,
+	}
+}
+
+// Validate performs a stateless validation of a TvlAccumulator
+func (a TvlAccumulator) Validate() error {
+	if a.WeightedTvl.IsNil() || a.WeightedTvl.IsNegative() {
+		return fmt.Errorf("invalid weighted tvl %s", a.WeightedTvl)
+	}
+
+	if a.Duration < 0 {
+		return fmt.Errorf("invalid tvl sample duration %d", a.Duration)
+	}
+
+	if a.LastTvl.IsNil() || a.LastTvl.IsNegative() {
+		return fmt.Errorf("invalid last tvl %s", a.LastTvl)
+	}
+
+	return nil
+}
+
+// Sample adds a TVL sample taken at sampleTime. The previous sample is
+// weighted by the number of whole seconds elapsed since it was taken. The
+// remaining fraction of a second is carried over to the new sample, so that
+// samples taken less than a second apart don't drop the time between them.
+func (a *TvlAccumulator) Sample(tvl sdk.Dec, sampleTime time.Time) {
+	if elapsed := int64(sampleTime.Sub(a.LastTime).Seconds()); elapsed > 0 {
+		a.WeightedTvl = a.WeightedTvl.Add(a.LastTvl.MulInt64(elapsed))
+		a.Duration += elapsed
+		a.LastTime = a.LastTime.Add(time.Duration(elapsed) * time.Second)
+	}
+	a.LastTvl = tvl
+}
+
+// Average returns the time-weighted average of the samples until endTime. The
+// last sample is held until endTime.
+func (a TvlAccumulator) Average(endTime time.Time) sdk.Dec {
+	weighted := a.WeightedTvl
+	duration := a.Duration
+	if elapsed := int64(endTime.Sub(a.LastTime).Seconds()); elapsed > 0 {
+		weighted = weighted.Add(a.LastTvl.MulInt64(elapsed))
+		duration += elapsed
+	}
+	if duration == 0 {
+		return a.LastTvl
+	}
+	return weighted.QuoInt64(duration)
+}
+
+// StartEpoch clears the samples of the epoch ending at endTime. The last
+// sample is kept, as it's still held at the start of the next epoch.
+func (a *TvlAccumulator) StartEpoch(endTime time.Time) {
+	a.WeightedTvl = sdk.ZeroDec()
+	a.Duration = 0
+	if endTime.After(a.LastTime) {
+		a.LastTime = endTime
+	}
+}
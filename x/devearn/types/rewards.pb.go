@@ -0,0 +1,2553 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sidechain/devearn/rewards.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccruedRewards defines the rewards earned by a contract that have not been
+// claimed by its owner yet
+type AccruedRewards struct {
+	// owner_address is the hex address the rewards are accrued to
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// contract is the hex address of the contract that earned the rewards
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// rewards are the unclaimed rewards
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *AccruedRewards) Reset()         { *m = AccruedRewards{} }
+func (m *AccruedRewards) String() string { return proto.CompactTextString(m) }
+func (*AccruedRewards) ProtoMessage()    {}
+func (*AccruedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe266b0f26cdbd75, []int{0}
+}
+func (m *AccruedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccruedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccruedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccruedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccruedRewards.Merge(m, src)
+}
+func (m *AccruedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccruedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccruedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccruedRewards proto.InternalMessageInfo
+
+func (m *AccruedRewards) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *AccruedRewards) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *AccruedRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// EpochRewardRecord defines the rewards distributed at the end of an epoch
+type EpochRewardRecord struct {
+	// epoch is the number of the epoch
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// time is the block time of the distribution
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// total_gas is the gas used by all the registered contracts in the epoch
+	TotalGas uint64 `protobuf:"varint,3,opt,name=total_gas,json=totalGas,proto3" json:"total_gas,omitempty"`
+	// rewards are the rewards distributed to the contracts
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *EpochRewardRecord) Reset()         { *m = EpochRewardRecord{} }
+func (m *EpochRewardRecord) String() string { return proto.CompactTextString(m) }
+func (*EpochRewardRecord) ProtoMessage()    {}
+func (*EpochRewardRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe266b0f26cdbd75, []int{1}
+}
+func (m *EpochRewardRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochRewardRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochRewardRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochRewardRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochRewardRecord.Merge(m, src)
+}
+func (m *EpochRewardRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochRewardRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochRewardRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochRewardRecord proto.InternalMessageInfo
+
+func (m *EpochRewardRecord) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochRewardRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *EpochRewardRecord) GetTotalGas() uint64 {
+	if m != nil {
+		return m.TotalGas
+	}
+	return 0
+}
+
+func (m *EpochRewardRecord) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// ContractRewardRecord defines the rewards earned by a contract in an epoch
+type ContractRewardRecord struct {
+	// epoch is the number of the epoch
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// contract is the hex address of the contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// receiver is the hex address the rewards were accrued to
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// gas_used is the gas used by the contract in the epoch
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_share is the share of the epoch's gas used by the contract
+	GasShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=gas_share,json=gasShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_share"`
+	// tvl_ratio is the share of the total value locked held by the contract
+	TvlRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=tvl_ratio,json=tvlRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tvl_ratio"`
+	// rewards are the rewards paid to the contract
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *ContractRewardRecord) Reset()         { *m = ContractRewardRecord{} }
+func (m *ContractRewardRecord) String() string { return proto.CompactTextString(m) }
+func (*ContractRewardRecord) ProtoMessage()    {}
+func (*ContractRewardRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe266b0f26cdbd75, []int{2}
+}
+func (m *ContractRewardRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractRewardRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractRewardRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractRewardRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractRewardRecord.Merge(m, src)
+}
+func (m *ContractRewardRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractRewardRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractRewardRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractRewardRecord proto.InternalMessageInfo
+
+func (m *ContractRewardRecord) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ContractRewardRecord) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ContractRewardRecord) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *ContractRewardRecord) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ContractRewardRecord) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// DistributionProgress tracks a reward distribution that is settled over
+// several blocks
+type DistributionProgress struct {
+	// epoch is the number of the epoch being distributed
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// time is the block time the distribution started at
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// height is the block height contracts were last settled at
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// total_gas is the gas used by all the registered contracts in the epoch
+	TotalGas uint64 `protobuf:"varint,4,opt,name=total_gas,json=totalGas,proto3" json:"total_gas,omitempty"`
+	// reward_pool is the amount distributed in the epoch
+	RewardPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=reward_pool,json=rewardPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_pool"`
+	// cursor is the address of the next contract to settle
+	Cursor []byte `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// allocated is the untruncated sum of the rewards settled so far
+	Allocated github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,7,rep,name=allocated,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"allocated"`
+	// distributed is the sum of the rewards accrued so far
+	Distributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed"`
+	// total_tvl is the time-weighted average of the total value locked during
+	// the epoch. The TVL at the end of the epoch is used when zero.
+	TotalTvl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=total_tvl,json=totalTvl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_tvl"`
+}
+
+func (m *DistributionProgress) Reset()         { *m = DistributionProgress{} }
+func (m *DistributionProgress) String() string { return proto.CompactTextString(m) }
+func (*DistributionProgress) ProtoMessage()    {}
+func (*DistributionProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe266b0f26cdbd75, []int{3}
+}
+func (m *DistributionProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionProgress.Merge(m, src)
+}
+func (m *DistributionProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionProgress proto.InternalMessageInfo
+
+func (m *DistributionProgress) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *DistributionProgress) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *DistributionProgress) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DistributionProgress) GetTotalGas() uint64 {
+	if m != nil {
+		return m.TotalGas
+	}
+	return 0
+}
+
+func (m *DistributionProgress) GetRewardPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardPool
+	}
+	return nil
+}
+
+func (m *DistributionProgress) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *DistributionProgress) GetAllocated() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Allocated
+	}
+	return nil
+}
+
+func (m *DistributionProgress) GetDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Distributed
+	}
+	return nil
+}
+
+// PendingGasMeter defines the gas used by a contract since the epoch ended and
+// before its rewards were settled
+type PendingGasMeter struct {
+	// contract is the hex address of the contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// gas_meter is the gas used by the contract
+	GasMeter uint64 `protobuf:"varint,2,opt,name=gas_meter,json=gasMeter,proto3" json:"gas_meter,omitempty"`
+}
+
+func (m *PendingGasMeter) Reset()         { *m = PendingGasMeter{} }
+func (m *PendingGasMeter) String() string { return proto.CompactTextString(m) }
+func (*PendingGasMeter) ProtoMessage()    {}
+func (*PendingGasMeter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe266b0f26cdbd75, []int{4}
+}
+func (m *PendingGasMeter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingGasMeter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingGasMeter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingGasMeter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingGasMeter.Merge(m, src)
+}
+func (m *PendingGasMeter) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingGasMeter) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingGasMeter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingGasMeter proto.InternalMessageInfo
+
+func (m *PendingGasMeter) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *PendingGasMeter) GetGasMeter() uint64 {
+	if m != nil {
+		return m.GasMeter
+	}
+	return 0
+}
+
+// TvlAccumulator accumulates the TVL samples taken during an epoch to compute
+// their time-weighted average
+type TvlAccumulator struct {
+	// weighted_tvl is the sum of the sampled TVL weighted by the number of
+	// seconds each sample was held for
+	WeightedTvl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=weighted_tvl,json=weightedTvl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weighted_tvl"`
+	// duration is the number of seconds covered by weighted_tvl
+	Duration int64 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// last_tvl is the TVL of the last sample
+	LastTvl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=last_tvl,json=lastTvl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_tvl"`
+	// last_time is the block time of the last sample
+	LastTime time.Time `protobuf:"bytes,4,opt,name=last_time,json=lastTime,proto3,stdtime" json:"last_time"`
+}
+
+func (m *TvlAccumulator) Reset()         { *m = TvlAccumulator{} }
+func (m *TvlAccumulator) String() string { return proto.CompactTextString(m) }
+func (*TvlAccumulator) ProtoMessage()    {}
+func (*TvlAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe266b0f26cdbd75, []int{5}
+}
+func (m *TvlAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TvlAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TvlAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TvlAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TvlAccumulator.Merge(m, src)
+}
+func (m *TvlAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *TvlAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TvlAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TvlAccumulator proto.InternalMessageInfo
+
+func (m *TvlAccumulator) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *TvlAccumulator) GetLastTime() time.Time {
+	if m != nil {
+		return m.LastTime
+	}
+	return time.Time{}
+}
+
+// ContractTvlAccumulator defines the TVL samples of a contract
+type ContractTvlAccumulator struct {
+	// contract is the hex address of the contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// accumulator is the samples of the TVL held in the contract
+	Accumulator TvlAccumulator `protobuf:"bytes,2,opt,name=accumulator,proto3" json:"accumulator"`
+}
+
+func (m *ContractTvlAccumulator) Reset()         { *m = ContractTvlAccumulator{} }
+func (m *ContractTvlAccumulator) String() string { return proto.CompactTextString(m) }
+func (*ContractTvlAccumulator) ProtoMessage()    {}
+func (*ContractTvlAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe266b0f26cdbd75, []int{6}
+}
+func (m *ContractTvlAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractTvlAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractTvlAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractTvlAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractTvlAccumulator.Merge(m, src)
+}
+func (m *ContractTvlAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractTvlAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractTvlAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractTvlAccumulator proto.InternalMessageInfo
+
+func (m *ContractTvlAccumulator) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ContractTvlAccumulator) GetAccumulator() TvlAccumulator {
+	if m != nil {
+		return m.Accumulator
+	}
+	return TvlAccumulator{}
+}
+
+func init() {
+	proto.RegisterType((*AccruedRewards)(nil), "sidechain.devearn.AccruedRewards")
+	proto.RegisterType((*EpochRewardRecord)(nil), "sidechain.devearn.EpochRewardRecord")
+	proto.RegisterType((*ContractRewardRecord)(nil), "sidechain.devearn.ContractRewardRecord")
+	proto.RegisterType((*DistributionProgress)(nil), "sidechain.devearn.DistributionProgress")
+	proto.RegisterType((*PendingGasMeter)(nil), "sidechain.devearn.PendingGasMeter")
+	proto.RegisterType((*TvlAccumulator)(nil), "sidechain.devearn.TvlAccumulator")
+	proto.RegisterType((*ContractTvlAccumulator)(nil), "sidechain.devearn.ContractTvlAccumulator")
+}
+
+func init() { proto.RegisterFile("sidechain/devearn/rewards.proto", fileDescriptor_fe266b0f26cdbd75) }
+
+var fileDescriptor_fe266b0f26cdbd75 = []byte{
+	// 763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x4f, 0xdb, 0x48,
+	0x14, 0x8f, 0x89, 0x21, 0xc9, 0x84, 0x65, 0x85, 0x15, 0x21, 0x13, 0x56, 0x09, 0x9b, 0x95, 0x56,
+	0x91, 0x56, 0x6b, 0x2f, 0x70, 0xd9, 0x6b, 0x02, 0x2b, 0xc4, 0x56, 0x95, 0xa8, 0x9b, 0x5e, 0x7a,
+	0x89, 0x26, 0x33, 0xaf, 0x8e, 0xd5, 0x89, 0x27, 0x9a, 0x19, 0x9b, 0xf6, 0xd4, 0x7e, 0x81, 0x4a,
+	0x7c, 0x8e, 0x7e, 0x82, 0x7e, 0x04, 0x4e, 0x15, 0xc7, 0xaa, 0x07, 0xa8, 0xe0, 0xd0, 0xaf, 0x51,
+	0xcd, 0xd8, 0x09, 0x81, 0x56, 0x88, 0x46, 0x70, 0x4a, 0xde, 0xbc, 0xf7, 0x7e, 0xf3, 0xfb, 0xbd,
+	0x3f, 0x1e, 0xd4, 0x94, 0x11, 0x05, 0x32, 0xc4, 0x51, 0xec, 0x53, 0x48, 0x01, 0x8b, 0xd8, 0x17,
+	0x70, 0x84, 0x05, 0x95, 0xde, 0x58, 0x70, 0xc5, 0x9d, 0xd5, 0x69, 0x80, 0x97, 0x07, 0xd4, 0x6b,
+	0x21, 0x0f, 0xb9, 0xf1, 0xfa, 0xfa, 0x5f, 0x16, 0x58, 0x6f, 0x10, 0x2e, 0x47, 0x5c, 0xfa, 0x03,
+	0x2c, 0xc1, 0x4f, 0xb7, 0x06, 0xa0, 0xf0, 0x96, 0x4f, 0x78, 0x14, 0xe7, 0xfe, 0x66, 0xc8, 0x79,
+	0xc8, 0xc0, 0x37, 0xd6, 0x20, 0x79, 0xe1, 0xab, 0x68, 0x04, 0x52, 0xe1, 0xd1, 0x38, 0x0b, 0x68,
+	0x7d, 0xb0, 0xd0, 0x4a, 0x87, 0x10, 0x91, 0x00, 0x0d, 0x32, 0x0a, 0xce, 0x1f, 0xe8, 0x17, 0x7e,
+	0x14, 0x83, 0xe8, 0x63, 0x4a, 0x05, 0x48, 0xe9, 0x5a, 0x9b, 0x56, 0xbb, 0x12, 0x2c, 0x9b, 0xc3,
+	0x4e, 0x76, 0xe6, 0xd4, 0x51, 0x99, 0xf0, 0x58, 0x09, 0x4c, 0x94, 0xbb, 0x60, 0xfc, 0x53, 0xdb,
+	0x01, 0x54, 0xca, 0xe5, 0xb8, 0xc5, 0xcd, 0x62, 0xbb, 0xba, 0xbd, 0xee, 0x65, 0x34, 0x3d, 0x4d,
+	0xd3, 0xcb, 0x69, 0x7a, 0xbb, 0x3c, 0x8a, 0xbb, 0xff, 0x9c, 0x9c, 0x35, 0x0b, 0xef, 0xcf, 0x9b,
+	0xed, 0x30, 0x52, 0xc3, 0x64, 0xe0, 0x11, 0x3e, 0xf2, 0x73, 0x4d, 0xd9, 0xcf, 0xdf, 0x92, 0xbe,
+	0xf4, 0xd5, 0xeb, 0x31, 0x48, 0x93, 0x20, 0x83, 0x09, 0x76, 0xeb, 0xab, 0x85, 0x56, 0xff, 0x1b,
+	0x73, 0x32, 0xcc, 0x88, 0x07, 0x40, 0xb8, 0xa0, 0x4e, 0x0d, 0x2d, 0x82, 0x3e, 0x34, 0xac, 0x8b,
+	0x41, 0x66, 0x38, 0xff, 0x22, 0x5b, 0x2b, 0x37, 0x54, 0xab, 0xdb, 0x75, 0x2f, 0x2b, 0x8b, 0x37,
+	0x29, 0x8b, 0xd7, 0x9b, 0x94, 0xa5, 0x5b, 0xd6, 0x84, 0x8e, 0xcf, 0x9b, 0x56, 0x60, 0x32, 0x9c,
+	0x0d, 0x54, 0x51, 0x5c, 0x61, 0xd6, 0x0f, 0xb1, 0x96, 0x63, 0xb5, 0xed, 0xa0, 0x6c, 0x0e, 0xf6,
+	0xb1, 0x9c, 0x55, 0x6a, 0x3f, 0xa0, 0xd2, 0xb7, 0x45, 0x54, 0xdb, 0xcd, 0xab, 0x7b, 0x07, 0xb1,
+	0xb7, 0xf5, 0xa6, 0x8e, 0xca, 0x02, 0x08, 0x44, 0x29, 0x08, 0xa3, 0xa6, 0x12, 0x4c, 0x6d, 0x67,
+	0x1d, 0x95, 0x43, 0x2c, 0xfb, 0x89, 0x04, 0xea, 0xda, 0x46, 0x69, 0x29, 0xc4, 0xf2, 0x99, 0x04,
+	0xea, 0x3c, 0x42, 0x15, 0xed, 0x92, 0x43, 0x2c, 0xc0, 0x5d, 0xd4, 0x79, 0x5d, 0x4f, 0xeb, 0xf9,
+	0x7c, 0xd6, 0xfc, 0xf3, 0x0e, 0x7a, 0xf6, 0x80, 0x04, 0x1a, 0xfb, 0xa9, 0xce, 0xd7, 0x60, 0x2a,
+	0x65, 0x7d, 0x81, 0x55, 0xc4, 0xdd, 0xa5, 0xf9, 0xc0, 0x54, 0xca, 0x02, 0x9d, 0x3f, 0xdb, 0x82,
+	0xd2, 0x03, 0xb6, 0xe0, 0xa3, 0x8d, 0x6a, 0x7b, 0x91, 0x54, 0x22, 0x1a, 0x24, 0x2a, 0xe2, 0xf1,
+	0xa1, 0xe0, 0xa1, 0x59, 0x84, 0xfb, 0x9e, 0xb7, 0x35, 0xb4, 0x34, 0x84, 0x28, 0x1c, 0x2a, 0xd3,
+	0x9e, 0x62, 0x90, 0x5b, 0xd7, 0xe7, 0xd0, 0xbe, 0x31, 0x87, 0x0c, 0x55, 0x33, 0xa2, 0xfd, 0x31,
+	0xe7, 0xcc, 0x5d, 0xbc, 0xff, 0x42, 0xa0, 0x0c, 0xff, 0x90, 0x73, 0xa6, 0x29, 0x92, 0x44, 0x48,
+	0x2e, 0x4c, 0xf3, 0x96, 0x83, 0xdc, 0x72, 0x38, 0xaa, 0x60, 0xc6, 0x38, 0xc1, 0x0a, 0x68, 0xde,
+	0x8c, 0xdf, 0x7e, 0xc8, 0x61, 0x0f, 0x88, 0xa1, 0xb1, 0x93, 0xd3, 0xf8, 0xeb, 0x6e, 0x5d, 0xcf,
+	0x98, 0x5c, 0xdd, 0xe1, 0x8c, 0x50, 0x95, 0x4e, 0x7a, 0x02, 0xd4, 0x2d, 0xdf, 0xbf, 0xec, 0x59,
+	0x7c, 0x33, 0xb7, 0xa6, 0x05, 0x2a, 0x65, 0x6e, 0x65, 0xce, 0xb9, 0xd5, 0x00, 0xbd, 0x94, 0xb5,
+	0xfe, 0x47, 0xbf, 0x1e, 0x42, 0x4c, 0xa3, 0x38, 0xdc, 0xc7, 0xf2, 0x31, 0x28, 0x10, 0xd7, 0xf6,
+	0xd6, 0xba, 0xb1, 0xb7, 0x1b, 0xd9, 0x02, 0x8e, 0x74, 0xa0, 0x99, 0x2a, 0xdb, 0x2c, 0x94, 0x49,
+	0x6c, 0xbd, 0x5b, 0x40, 0x2b, 0xbd, 0x94, 0x75, 0x08, 0x49, 0x46, 0x09, 0xc3, 0x8a, 0x0b, 0xe7,
+	0x09, 0x5a, 0x3e, 0x32, 0x83, 0x03, 0xd4, 0xd0, 0xb5, 0xe6, 0xa2, 0x5b, 0x9d, 0x60, 0xf4, 0x52,
+	0xa6, 0xe9, 0xd1, 0xc4, 0x2c, 0x6d, 0x6c, 0x18, 0x14, 0x83, 0xa9, 0xed, 0x1c, 0xa0, 0x32, 0xc3,
+	0x52, 0x99, 0xab, 0x8a, 0x73, 0x5d, 0x55, 0xd2, 0xf9, 0xfa, 0x9a, 0x0e, 0xaa, 0x64, 0x50, 0x7a,
+	0x7f, 0xec, 0x9f, 0xd8, 0x1f, 0xc3, 0x40, 0x3b, 0x5a, 0x6f, 0xd0, 0xda, 0xe4, 0x73, 0x79, 0xa3,
+	0x2c, 0xb7, 0x95, 0xf8, 0x00, 0x55, 0xf1, 0x55, 0x68, 0xbe, 0xba, 0xbf, 0x7b, 0xdf, 0x3d, 0xc5,
+	0xde, 0x75, 0xcc, 0xae, 0xad, 0x19, 0x04, 0xb3, 0xb9, 0xdd, 0x9d, 0x93, 0x8b, 0x86, 0x75, 0x7a,
+	0xd1, 0xb0, 0xbe, 0x5c, 0x34, 0xac, 0xe3, 0xcb, 0x46, 0xe1, 0xf4, 0xb2, 0x51, 0xf8, 0x74, 0xd9,
+	0x28, 0x3c, 0x5f, 0xbf, 0x7a, 0xfa, 0x5f, 0x4d, 0x1f, 0x7f, 0x53, 0x85, 0xc1, 0x92, 0x51, 0xb7,
+	0xf3, 0x6d, 0x00, 0x30, 0xf0, 0x05, 0x1d, 0x1e, 0x08, 0x00, 0x00,
+}
+
+func (m *AccruedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccruedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccruedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochRewardRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochRewardRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochRewardRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TotalGas != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.TotalGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRewards(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractRewardRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractRewardRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractRewardRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.TvlRatio.Size()
+		i -= size
+		if _, err := m.TvlRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.GasShare.Size()
+		i -= size
+		if _, err := m.GasShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.GasUsed != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalTvl.Size()
+		i -= size
+		if _, err := m.TotalTvl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Allocated) > 0 {
+		for iNdEx := len(m.Allocated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TotalGas != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.TotalGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRewards(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingGasMeter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingGasMeter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingGasMeter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasMeter != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.GasMeter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TvlAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TvlAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TvlAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintRewards(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LastTvl.Size()
+		i -= size
+		if _, err := m.LastTvl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Duration != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.WeightedTvl.Size()
+		i -= size
+		if _, err := m.WeightedTvl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractTvlAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractTvlAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractTvlAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Accumulator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewards(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewards(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccruedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochRewardRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovRewards(uint64(m.Epoch))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovRewards(uint64(l))
+	if m.TotalGas != 0 {
+		n += 1 + sovRewards(uint64(m.TotalGas))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractRewardRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovRewards(uint64(m.Epoch))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovRewards(uint64(m.GasUsed))
+	}
+	l = m.GasShare.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	l = m.TvlRatio.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovRewards(uint64(m.Epoch))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovRewards(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovRewards(uint64(m.Height))
+	}
+	if m.TotalGas != 0 {
+		n += 1 + sovRewards(uint64(m.TotalGas))
+	}
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.Allocated) > 0 {
+		for _, e := range m.Allocated {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	l = m.TotalTvl.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *PendingGasMeter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if m.GasMeter != 0 {
+		n += 1 + sovRewards(uint64(m.GasMeter))
+	}
+	return n
+}
+
+func (m *TvlAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WeightedTvl.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	if m.Duration != 0 {
+		n += 1 + sovRewards(uint64(m.Duration))
+	}
+	l = m.LastTvl.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastTime)
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *ContractTvlAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = m.Accumulator.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func sovRewards(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewards(x uint64) (n int) {
+	return sovRewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccruedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccruedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccruedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochRewardRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochRewardRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochRewardRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
+			}
+			m.TotalGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractRewardRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractRewardRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractRewardRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TvlRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TvlRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
+			}
+			m.TotalGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPool = append(m.RewardPool, types.Coin{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocated = append(m.Allocated, types.DecCoin{})
+			if err := m.Allocated[len(m.Allocated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = append(m.Distributed, types.Coin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTvl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalTvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingGasMeter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingGasMeter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingGasMeter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMeter", wireType)
+			}
+			m.GasMeter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasMeter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TvlAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TvlAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TvlAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedTvl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightedTvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTvl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastTvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractTvlAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractTvlAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractTvlAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRewards(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRewards
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRewards
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRewards
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRewards        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRewards          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRewards = fmt.Errorf("proto: unexpected end of group")
+)
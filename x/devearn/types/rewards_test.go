@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"sidechain/x/devearn/types"
+)
+
+func TestTvlAccumulatorAverage(t *testing.T) {
+	start := time.Unix(1000, 0)
+	accumulator := types.NewTvlAccumulator(sdk.NewDec(100), start)
+
+	// a single sample is held until the end of the epoch
+	require.Equal(t, sdk.NewDec(100), accumulator.Average(start))
+	require.Equal(t, sdk.NewDec(100), accumulator.Average(start.Add(time.Hour)))
+
+	// 100 for 30s, 400 for 10s
+	accumulator.Sample(sdk.NewDec(400), start.Add(30*time.Second))
+	require.Equal(t, sdk.NewDec(175), accumulator.Average(start.Add(40*time.Second)))
+
+	// a deposit right before the end of the epoch barely moves the average
+	accumulator.Sample(sdk.NewDec(1000000), start.Add(40*time.Second))
+	require.Equal(t, sdk.NewDec(175), accumulator.Average(start.Add(40*time.Second)))
+
+	// the last sample is held at the start of the next epoch
+	end := start.Add(40 * time.Second)
+	accumulator.StartEpoch(end)
+	require.Equal(t, int64(0), accumulator.Duration)
+	require.Equal(t, sdk.NewDec(1000000), accumulator.Average(end.Add(10*time.Second)))
+
+	accumulator.Sample(sdk.ZeroDec(), end.Add(10*time.Second))
+	require.Equal(t, sdk.NewDec(500000), accumulator.Average(end.Add(20*time.Second)))
+}
+
+func TestTvlAccumulatorSubSecondSamples(t *testing.T) {
+	start := time.Unix(1000, 0)
+	accumulator := types.NewTvlAccumulator(sdk.NewDec(100), start)
+
+	// samples taken every 600ms still add up to the elapsed time
+	for i := 1; i <= 10; i++ {
+		accumulator.Sample(sdk.NewDec(100), start.Add(time.Duration(i)*600*time.Millisecond))
+	}
+	require.Equal(t, int64(6), accumulator.Duration)
+	require.Equal(t, start.Add(6*time.Second), accumulator.LastTime)
+	require.Equal(t, sdk.NewDec(100), accumulator.Average(start.Add(6*time.Second)))
+}
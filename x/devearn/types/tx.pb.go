@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -124,225 +126,5027 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "sidechain.devearn.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sidechain.devearn.MsgUpdateParamsResponse")
+// MsgRegisterDevEarn defines a message that registers a contract for dev earn
+// rewards on behalf of its deployer.
+type MsgRegisterDevEarn struct {
+	// deployer_address is the bech32 address of the contract deployer
+	DeployerAddress string `protobuf:"bytes,1,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
+	// contract is the hex address of the contract to register
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// nonces is the path of account nonces used to derive the contract address
+	// from the deployer. The first entry is the deployer nonce of the creation
+	// tx, any further entries are the nonces of the intermediate factories.
+	Nonces []uint64 `protobuf:"varint,3,rep,packed,name=nonces,proto3" json:"nonces,omitempty"`
+	// owner_address is the hex address that receives the rewards. It defaults to
+	// the deployer address when omitted.
+	OwnerAddress string `protobuf:"bytes,4,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// epochs is the number of epochs the contract is eligible for rewards
+	Epochs uint32 `protobuf:"varint,5,opt,name=epochs,proto3" json:"epochs,omitempty"`
 }
 
-func init() { proto.RegisterFile("sidechain/devearn/tx.proto", fileDescriptor_8d6b6c4577450382) }
+func (m *MsgRegisterDevEarn) Reset()         { *m = MsgRegisterDevEarn{} }
+func (m *MsgRegisterDevEarn) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDevEarn) ProtoMessage()    {}
+func (*MsgRegisterDevEarn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{2}
+}
+func (m *MsgRegisterDevEarn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDevEarn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDevEarn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterDevEarn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDevEarn.Merge(m, src)
+}
+func (m *MsgRegisterDevEarn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDevEarn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDevEarn.DiscardUnknown(m)
+}
 
-var fileDescriptor_8d6b6c4577450382 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2a, 0xce, 0x4c, 0x49,
-	0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0x49, 0x2d, 0x4b, 0x4d, 0x2c, 0xca, 0xd3, 0x2f, 0xa9,
-	0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xcb, 0xe9, 0x41, 0xe5, 0xa4, 0xc4, 0x93,
-	0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x73, 0x8b, 0xd3, 0xf5, 0xcb, 0x0c, 0x41, 0x14, 0x44, 0xad,
-	0x94, 0x24, 0x44, 0x22, 0x1e, 0xcc, 0xd3, 0x87, 0x70, 0xa0, 0x52, 0xf2, 0x98, 0x56, 0xa4, 0xa7,
-	0xe6, 0xa5, 0x16, 0x67, 0xc2, 0x14, 0x88, 0xa4, 0xe7, 0xa7, 0xe7, 0x43, 0x34, 0x82, 0x58, 0x50,
-	0x51, 0x39, 0x4c, 0x6d, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x5d, 0x4a, 0x93, 0x18, 0xb9, 0xf8,
-	0x7d, 0x8b, 0xd3, 0x43, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x03, 0xc0, 0x32, 0x42, 0x66, 0x5c, 0x9c,
-	0x89, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e,
-	0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0xdd, 0xe3, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x1c, 0x5c,
-	0x52, 0x94, 0x99, 0x97, 0x1e, 0x84, 0x50, 0x2a, 0x64, 0xce, 0xc5, 0x06, 0x31, 0x5b, 0x82, 0x49,
-	0x81, 0x51, 0x83, 0xdb, 0x48, 0x52, 0x0f, 0xc3, 0xeb, 0x7a, 0x10, 0x2b, 0x9c, 0x58, 0x4e, 0xdc,
-	0x93, 0x67, 0x08, 0x82, 0x2a, 0xb7, 0xe2, 0x6b, 0x7a, 0xbe, 0x41, 0x0b, 0x61, 0x90, 0x92, 0x24,
-	0x97, 0x38, 0x9a, 0x9b, 0x82, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x8d, 0x52, 0xb9, 0x98,
-	0x7d, 0x8b, 0xd3, 0x85, 0xe2, 0xb8, 0x78, 0x50, 0x9c, 0xac, 0x84, 0xc5, 0x2a, 0x34, 0x23, 0xa4,
-	0xb4, 0x08, 0xab, 0x81, 0x59, 0xe3, 0x64, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
-	0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72,
-	0x0c, 0x51, 0x92, 0x88, 0x00, 0xad, 0x40, 0x44, 0x76, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38,
-	0x48, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x98, 0x2d, 0x70, 0xe6, 0x0e, 0x02, 0x00, 0x00,
+var xxx_messageInfo_MsgRegisterDevEarn proto.InternalMessageInfo
+
+func (m *MsgRegisterDevEarn) GetDeployerAddress() string {
+	if m != nil {
+		return m.DeployerAddress
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgRegisterDevEarn) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgRegisterDevEarn) GetNonces() []uint64 {
+	if m != nil {
+		return m.Nonces
+	}
+	return nil
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defined a governance operation for updating the x/incentives module parameters.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgRegisterDevEarn) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterDevEarn) GetEpochs() uint32 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgRegisterDevEarnResponse defines the MsgRegisterDevEarn response type
+type MsgRegisterDevEarnResponse struct {
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/sidechain.devearn.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterDevEarnResponse) Reset()         { *m = MsgRegisterDevEarnResponse{} }
+func (m *MsgRegisterDevEarnResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDevEarnResponse) ProtoMessage()    {}
+func (*MsgRegisterDevEarnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{3}
+}
+func (m *MsgRegisterDevEarnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDevEarnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDevEarnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRegisterDevEarnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDevEarnResponse.Merge(m, src)
+}
+func (m *MsgRegisterDevEarnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDevEarnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDevEarnResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defined a governance operation for updating the x/incentives module parameters.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+var xxx_messageInfo_MsgRegisterDevEarnResponse proto.InternalMessageInfo
+
+// MsgCancelDevEarn defines a message that cancels a self-registered contract
+type MsgCancelDevEarn struct {
+	// deployer_address is the bech32 address of the contract deployer
+	DeployerAddress string `protobuf:"bytes,1,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
+	// contract is the hex address of the registered contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgCancelDevEarn) Reset()         { *m = MsgCancelDevEarn{} }
+func (m *MsgCancelDevEarn) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDevEarn) ProtoMessage()    {}
+func (*MsgCancelDevEarn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{4}
+}
+func (m *MsgCancelDevEarn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDevEarn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDevEarn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDevEarn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDevEarn.Merge(m, src)
+}
+func (m *MsgCancelDevEarn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDevEarn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDevEarn.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+var xxx_messageInfo_MsgCancelDevEarn proto.InternalMessageInfo
+
+func (m *MsgCancelDevEarn) GetDeployerAddress() string {
+	if m != nil {
+		return m.DeployerAddress
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgCancelDevEarn) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgCancelDevEarnResponse defines the MsgCancelDevEarn response type
+type MsgCancelDevEarnResponse struct {
+}
+
+func (m *MsgCancelDevEarnResponse) Reset()         { *m = MsgCancelDevEarnResponse{} }
+func (m *MsgCancelDevEarnResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDevEarnResponse) ProtoMessage()    {}
+func (*MsgCancelDevEarnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{5}
+}
+func (m *MsgCancelDevEarnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDevEarnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDevEarnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+}
+func (m *MsgCancelDevEarnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDevEarnResponse.Merge(m, src)
+}
+func (m *MsgCancelDevEarnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDevEarnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDevEarnResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDevEarnResponse proto.InternalMessageInfo
+
+// MsgClaimDevEarnRewards defines a message that claims the rewards accrued to
+// an owner, or to the withdraw address of a contract owned by the signer
+type MsgClaimDevEarnRewards struct {
+	// owner_address is the bech32 address of the rewards owner
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// contract is the optional hex address of the contract to claim the rewards
+	// of. The rewards of every contract are claimed when empty.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// withdraw_address is the optional hex address the rewards of the contract
+	// were accrued to. When set, the owner of the contract claims them on behalf
+	// of the withdraw address, which receives the funds, so that contracts and
+	// multisigs without a signing key can collect. It requires the contract.
+	WithdrawAddress string `protobuf:"bytes,3,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *MsgClaimDevEarnRewards) Reset()         { *m = MsgClaimDevEarnRewards{} }
+func (m *MsgClaimDevEarnRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimDevEarnRewards) ProtoMessage()    {}
+func (*MsgClaimDevEarnRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{6}
+}
+func (m *MsgClaimDevEarnRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimDevEarnRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimDevEarnRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sidechain.devearn.Msg/UpdateParams",
+}
+func (m *MsgClaimDevEarnRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimDevEarnRewards.Merge(m, src)
+}
+func (m *MsgClaimDevEarnRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimDevEarnRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimDevEarnRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimDevEarnRewards proto.InternalMessageInfo
+
+func (m *MsgClaimDevEarnRewards) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return ""
+}
+
+func (m *MsgClaimDevEarnRewards) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sidechain.devearn.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sidechain/devearn/tx.proto",
+func (m *MsgClaimDevEarnRewards) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgClaimDevEarnRewardsResponse defines the MsgClaimDevEarnRewards response type
+type MsgClaimDevEarnRewardsResponse struct {
+	// amount is the claimed amount
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimDevEarnRewardsResponse) Reset()         { *m = MsgClaimDevEarnRewardsResponse{} }
+func (m *MsgClaimDevEarnRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimDevEarnRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimDevEarnRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{7}
+}
+func (m *MsgClaimDevEarnRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimDevEarnRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimDevEarnRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgClaimDevEarnRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimDevEarnRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimDevEarnRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimDevEarnRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimDevEarnRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClaimDevEarnRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimDevEarnRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// MsgUpdateDevEarnOwner defines a message that transfers the ownership of a
+// registered contract
+type MsgUpdateDevEarnOwner struct {
+	// owner_address is the bech32 address of the current owner
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// contract is the hex address of the registered contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// new_owner_address is the hex address of the new owner
+	NewOwnerAddress string `protobuf:"bytes,3,opt,name=new_owner_address,json=newOwnerAddress,proto3" json:"new_owner_address,omitempty"`
+}
+
+func (m *MsgUpdateDevEarnOwner) Reset()         { *m = MsgUpdateDevEarnOwner{} }
+func (m *MsgUpdateDevEarnOwner) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDevEarnOwner) ProtoMessage()    {}
+func (*MsgUpdateDevEarnOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{8}
+}
+func (m *MsgUpdateDevEarnOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDevEarnOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDevEarnOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDevEarnOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDevEarnOwner.Merge(m, src)
+}
+func (m *MsgUpdateDevEarnOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDevEarnOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDevEarnOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDevEarnOwner proto.InternalMessageInfo
+
+func (m *MsgUpdateDevEarnOwner) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateDevEarnOwner) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgUpdateDevEarnOwner) GetNewOwnerAddress() string {
+	if m != nil {
+		return m.NewOwnerAddress
+	}
+	return ""
+}
+
+// MsgUpdateDevEarnOwnerResponse defines the MsgUpdateDevEarnOwner response type
+type MsgUpdateDevEarnOwnerResponse struct {
+}
+
+func (m *MsgUpdateDevEarnOwnerResponse) Reset()         { *m = MsgUpdateDevEarnOwnerResponse{} }
+func (m *MsgUpdateDevEarnOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDevEarnOwnerResponse) ProtoMessage()    {}
+func (*MsgUpdateDevEarnOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{9}
+}
+func (m *MsgUpdateDevEarnOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDevEarnOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDevEarnOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDevEarnOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDevEarnOwnerResponse.Merge(m, src)
+}
+func (m *MsgUpdateDevEarnOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDevEarnOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDevEarnOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDevEarnOwnerResponse proto.InternalMessageInfo
+
+// MsgSetDevEarnWithdrawAddress defines a message that sets the address the
+// rewards of a registered contract are accrued to
+type MsgSetDevEarnWithdrawAddress struct {
+	// owner_address is the bech32 address of the current owner
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// contract is the hex address of the registered contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// withdraw_address is the hex address the rewards are accrued to. The
+	// withdraw address is reset to the owner when empty.
+	WithdrawAddress string `protobuf:"bytes,3,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *MsgSetDevEarnWithdrawAddress) Reset()         { *m = MsgSetDevEarnWithdrawAddress{} }
+func (m *MsgSetDevEarnWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDevEarnWithdrawAddress) ProtoMessage()    {}
+func (*MsgSetDevEarnWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{10}
+}
+func (m *MsgSetDevEarnWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDevEarnWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDevEarnWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDevEarnWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDevEarnWithdrawAddress.Merge(m, src)
+}
+func (m *MsgSetDevEarnWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDevEarnWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDevEarnWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDevEarnWithdrawAddress proto.InternalMessageInfo
+
+func (m *MsgSetDevEarnWithdrawAddress) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *MsgSetDevEarnWithdrawAddress) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgSetDevEarnWithdrawAddress) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+// MsgSetDevEarnWithdrawAddressResponse defines the MsgSetDevEarnWithdrawAddress
+// response type
+type MsgSetDevEarnWithdrawAddressResponse struct {
+}
+
+func (m *MsgSetDevEarnWithdrawAddressResponse) Reset()         { *m = MsgSetDevEarnWithdrawAddressResponse{} }
+func (m *MsgSetDevEarnWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDevEarnWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgSetDevEarnWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{11}
+}
+func (m *MsgSetDevEarnWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDevEarnWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDevEarnWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDevEarnWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDevEarnWithdrawAddressResponse.Merge(m, src)
+}
+func (m *MsgSetDevEarnWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDevEarnWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDevEarnWithdrawAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDevEarnWithdrawAddressResponse proto.InternalMessageInfo
+
+// MsgAddToBlocklist defines a Msg for adding contract or deployer addresses to
+// the dev earn blocklist.
+type MsgAddToBlocklist struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// addresses are the hex addresses of the contracts or deployers to block
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *MsgAddToBlocklist) Reset()         { *m = MsgAddToBlocklist{} }
+func (m *MsgAddToBlocklist) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToBlocklist) ProtoMessage()    {}
+func (*MsgAddToBlocklist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{12}
+}
+func (m *MsgAddToBlocklist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddToBlocklist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddToBlocklist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddToBlocklist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToBlocklist.Merge(m, src)
+}
+func (m *MsgAddToBlocklist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddToBlocklist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToBlocklist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddToBlocklist proto.InternalMessageInfo
+
+func (m *MsgAddToBlocklist) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddToBlocklist) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// MsgAddToBlocklistResponse defines the MsgAddToBlocklist response type
+type MsgAddToBlocklistResponse struct {
+}
+
+func (m *MsgAddToBlocklistResponse) Reset()         { *m = MsgAddToBlocklistResponse{} }
+func (m *MsgAddToBlocklistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToBlocklistResponse) ProtoMessage()    {}
+func (*MsgAddToBlocklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{13}
+}
+func (m *MsgAddToBlocklistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddToBlocklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddToBlocklistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddToBlocklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToBlocklistResponse.Merge(m, src)
+}
+func (m *MsgAddToBlocklistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddToBlocklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToBlocklistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddToBlocklistResponse proto.InternalMessageInfo
+
+// MsgRemoveFromBlocklist defines a Msg for removing contract or deployer
+// addresses from the dev earn blocklist.
+type MsgRemoveFromBlocklist struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// addresses are the hex addresses of the contracts or deployers to unblock
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *MsgRemoveFromBlocklist) Reset()         { *m = MsgRemoveFromBlocklist{} }
+func (m *MsgRemoveFromBlocklist) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFromBlocklist) ProtoMessage()    {}
+func (*MsgRemoveFromBlocklist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{14}
+}
+func (m *MsgRemoveFromBlocklist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFromBlocklist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFromBlocklist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFromBlocklist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFromBlocklist.Merge(m, src)
+}
+func (m *MsgRemoveFromBlocklist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFromBlocklist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFromBlocklist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFromBlocklist proto.InternalMessageInfo
+
+func (m *MsgRemoveFromBlocklist) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveFromBlocklist) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// MsgRemoveFromBlocklistResponse defines the MsgRemoveFromBlocklist response
+// type
+type MsgRemoveFromBlocklistResponse struct {
+}
+
+func (m *MsgRemoveFromBlocklistResponse) Reset()         { *m = MsgRemoveFromBlocklistResponse{} }
+func (m *MsgRemoveFromBlocklistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFromBlocklistResponse) ProtoMessage()    {}
+func (*MsgRemoveFromBlocklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{15}
+}
+func (m *MsgRemoveFromBlocklistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFromBlocklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFromBlocklistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFromBlocklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFromBlocklistResponse.Merge(m, src)
+}
+func (m *MsgRemoveFromBlocklistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFromBlocklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFromBlocklistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFromBlocklistResponse proto.InternalMessageInfo
+
+// MsgRegisterDevEarnInfo defines a Msg for registering a contract for dev earn
+// rewards through governance. It replaces the RegisterDevEarnInfoProposal.
+type MsgRegisterDevEarnInfo struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract is the hex address of the contract to register
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// owner_address is the hex address that receives the rewards
+	OwnerAddress string `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// epochs is the number of epochs the contract is eligible for rewards
+	Epochs uint32 `protobuf:"varint,4,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *MsgRegisterDevEarnInfo) Reset()         { *m = MsgRegisterDevEarnInfo{} }
+func (m *MsgRegisterDevEarnInfo) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDevEarnInfo) ProtoMessage()    {}
+func (*MsgRegisterDevEarnInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{16}
+}
+func (m *MsgRegisterDevEarnInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDevEarnInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDevEarnInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterDevEarnInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDevEarnInfo.Merge(m, src)
+}
+func (m *MsgRegisterDevEarnInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDevEarnInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDevEarnInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterDevEarnInfo proto.InternalMessageInfo
+
+func (m *MsgRegisterDevEarnInfo) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRegisterDevEarnInfo) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgRegisterDevEarnInfo) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterDevEarnInfo) GetEpochs() uint32 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+// MsgRegisterDevEarnInfoResponse defines the MsgRegisterDevEarnInfo response
+// type
+type MsgRegisterDevEarnInfoResponse struct {
+}
+
+func (m *MsgRegisterDevEarnInfoResponse) Reset()         { *m = MsgRegisterDevEarnInfoResponse{} }
+func (m *MsgRegisterDevEarnInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDevEarnInfoResponse) ProtoMessage()    {}
+func (*MsgRegisterDevEarnInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{17}
+}
+func (m *MsgRegisterDevEarnInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDevEarnInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDevEarnInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterDevEarnInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDevEarnInfoResponse.Merge(m, src)
+}
+func (m *MsgRegisterDevEarnInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDevEarnInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDevEarnInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterDevEarnInfoResponse proto.InternalMessageInfo
+
+// MsgCancelDevEarnInfo defines a Msg for cancelling the dev earn registration
+// of a contract through governance. It replaces the CancelDevEarnInfoProposal.
+type MsgCancelDevEarnInfo struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract is the hex address of the registered contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *MsgCancelDevEarnInfo) Reset()         { *m = MsgCancelDevEarnInfo{} }
+func (m *MsgCancelDevEarnInfo) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDevEarnInfo) ProtoMessage()    {}
+func (*MsgCancelDevEarnInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{18}
+}
+func (m *MsgCancelDevEarnInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDevEarnInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDevEarnInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDevEarnInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDevEarnInfo.Merge(m, src)
+}
+func (m *MsgCancelDevEarnInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDevEarnInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDevEarnInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDevEarnInfo proto.InternalMessageInfo
+
+func (m *MsgCancelDevEarnInfo) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelDevEarnInfo) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// MsgCancelDevEarnInfoResponse defines the MsgCancelDevEarnInfo response type
+type MsgCancelDevEarnInfoResponse struct {
+}
+
+func (m *MsgCancelDevEarnInfoResponse) Reset()         { *m = MsgCancelDevEarnInfoResponse{} }
+func (m *MsgCancelDevEarnInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDevEarnInfoResponse) ProtoMessage()    {}
+func (*MsgCancelDevEarnInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{19}
+}
+func (m *MsgCancelDevEarnInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDevEarnInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDevEarnInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDevEarnInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDevEarnInfoResponse.Merge(m, src)
+}
+func (m *MsgCancelDevEarnInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDevEarnInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDevEarnInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDevEarnInfoResponse proto.InternalMessageInfo
+
+// MsgAddAsset defines a Msg for adding an asset to the TVL whitelist through
+// governance. It replaces the AddAssetToWhitelistProposal.
+type MsgAddAsset struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the denomination of the asset, which must have an erc20 token
+	// pair
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgAddAsset) Reset()         { *m = MsgAddAsset{} }
+func (m *MsgAddAsset) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAsset) ProtoMessage()    {}
+func (*MsgAddAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{20}
+}
+func (m *MsgAddAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAsset.Merge(m, src)
+}
+func (m *MsgAddAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAsset proto.InternalMessageInfo
+
+func (m *MsgAddAsset) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddAsset) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgAddAssetResponse defines the MsgAddAsset response type
+type MsgAddAssetResponse struct {
+}
+
+func (m *MsgAddAssetResponse) Reset()         { *m = MsgAddAssetResponse{} }
+func (m *MsgAddAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAssetResponse) ProtoMessage()    {}
+func (*MsgAddAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{21}
+}
+func (m *MsgAddAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAssetResponse.Merge(m, src)
+}
+func (m *MsgAddAssetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAssetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAssetResponse proto.InternalMessageInfo
+
+// MsgRemoveAsset defines a Msg for removing an asset from the TVL whitelist
+// through governance. It replaces the RemoveAssetFromWhitelistProposal.
+type MsgRemoveAsset struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the denomination of the whitelisted asset
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveAsset) Reset()         { *m = MsgRemoveAsset{} }
+func (m *MsgRemoveAsset) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAsset) ProtoMessage()    {}
+func (*MsgRemoveAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{22}
+}
+func (m *MsgRemoveAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAsset.Merge(m, src)
+}
+func (m *MsgRemoveAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAsset proto.InternalMessageInfo
+
+func (m *MsgRemoveAsset) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveAsset) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgRemoveAssetResponse defines the MsgRemoveAsset response type
+type MsgRemoveAssetResponse struct {
+}
+
+func (m *MsgRemoveAssetResponse) Reset()         { *m = MsgRemoveAssetResponse{} }
+func (m *MsgRemoveAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAssetResponse) ProtoMessage()    {}
+func (*MsgRemoveAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d6b6c4577450382, []int{23}
+}
+func (m *MsgRemoveAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAssetResponse.Merge(m, src)
+}
+func (m *MsgRemoveAssetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAssetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAssetResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "sidechain.devearn.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sidechain.devearn.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterDevEarn)(nil), "sidechain.devearn.MsgRegisterDevEarn")
+	proto.RegisterType((*MsgRegisterDevEarnResponse)(nil), "sidechain.devearn.MsgRegisterDevEarnResponse")
+	proto.RegisterType((*MsgCancelDevEarn)(nil), "sidechain.devearn.MsgCancelDevEarn")
+	proto.RegisterType((*MsgCancelDevEarnResponse)(nil), "sidechain.devearn.MsgCancelDevEarnResponse")
+	proto.RegisterType((*MsgClaimDevEarnRewards)(nil), "sidechain.devearn.MsgClaimDevEarnRewards")
+	proto.RegisterType((*MsgClaimDevEarnRewardsResponse)(nil), "sidechain.devearn.MsgClaimDevEarnRewardsResponse")
+	proto.RegisterType((*MsgUpdateDevEarnOwner)(nil), "sidechain.devearn.MsgUpdateDevEarnOwner")
+	proto.RegisterType((*MsgUpdateDevEarnOwnerResponse)(nil), "sidechain.devearn.MsgUpdateDevEarnOwnerResponse")
+	proto.RegisterType((*MsgSetDevEarnWithdrawAddress)(nil), "sidechain.devearn.MsgSetDevEarnWithdrawAddress")
+	proto.RegisterType((*MsgSetDevEarnWithdrawAddressResponse)(nil), "sidechain.devearn.MsgSetDevEarnWithdrawAddressResponse")
+	proto.RegisterType((*MsgAddToBlocklist)(nil), "sidechain.devearn.MsgAddToBlocklist")
+	proto.RegisterType((*MsgAddToBlocklistResponse)(nil), "sidechain.devearn.MsgAddToBlocklistResponse")
+	proto.RegisterType((*MsgRemoveFromBlocklist)(nil), "sidechain.devearn.MsgRemoveFromBlocklist")
+	proto.RegisterType((*MsgRemoveFromBlocklistResponse)(nil), "sidechain.devearn.MsgRemoveFromBlocklistResponse")
+	proto.RegisterType((*MsgRegisterDevEarnInfo)(nil), "sidechain.devearn.MsgRegisterDevEarnInfo")
+	proto.RegisterType((*MsgRegisterDevEarnInfoResponse)(nil), "sidechain.devearn.MsgRegisterDevEarnInfoResponse")
+	proto.RegisterType((*MsgCancelDevEarnInfo)(nil), "sidechain.devearn.MsgCancelDevEarnInfo")
+	proto.RegisterType((*MsgCancelDevEarnInfoResponse)(nil), "sidechain.devearn.MsgCancelDevEarnInfoResponse")
+	proto.RegisterType((*MsgAddAsset)(nil), "sidechain.devearn.MsgAddAsset")
+	proto.RegisterType((*MsgAddAssetResponse)(nil), "sidechain.devearn.MsgAddAssetResponse")
+	proto.RegisterType((*MsgRemoveAsset)(nil), "sidechain.devearn.MsgRemoveAsset")
+	proto.RegisterType((*MsgRemoveAssetResponse)(nil), "sidechain.devearn.MsgRemoveAssetResponse")
+}
+
+func init() { proto.RegisterFile("sidechain/devearn/tx.proto", fileDescriptor_8d6b6c4577450382) }
+
+var fileDescriptor_8d6b6c4577450382 = []byte{
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xe2, 0xd4, 0xaa, 0x5f, 0x9a, 0x7f, 0xdb, 0xc4, 0xb5, 0x97, 0xb0, 0x31, 0x6e, 0x29,
+	0x76, 0x20, 0xde, 0xba, 0x95, 0xa8, 0x14, 0x89, 0x43, 0x1c, 0x40, 0xe2, 0x60, 0x15, 0x6d, 0x41,
+	0x48, 0x20, 0x11, 0x4d, 0x76, 0x87, 0xf5, 0x2a, 0xde, 0x19, 0x6b, 0x67, 0x63, 0x37, 0x1c, 0x38,
+	0x20, 0xf5, 0xc2, 0xa9, 0xe2, 0x63, 0x70, 0x42, 0x88, 0x03, 0x7c, 0x83, 0x1e, 0x2b, 0x4e, 0x9c,
+	0xf8, 0x93, 0x1c, 0xf8, 0x1a, 0x68, 0x77, 0xc7, 0x63, 0xef, 0xce, 0xda, 0x59, 0x22, 0x35, 0xea,
+	0xc9, 0x9e, 0x79, 0xbf, 0xf7, 0x7e, 0xbf, 0x37, 0xf3, 0xe6, 0xcd, 0x2c, 0x68, 0xcc, 0xb5, 0xb1,
+	0xd5, 0x43, 0x2e, 0x31, 0x6c, 0x3c, 0xc4, 0xc8, 0x27, 0x46, 0xf0, 0xa4, 0x35, 0xf0, 0x69, 0x40,
+	0xd5, 0x75, 0x61, 0x6b, 0x71, 0x9b, 0x76, 0xcb, 0xa2, 0xcc, 0xa3, 0xcc, 0xf0, 0x98, 0x63, 0x0c,
+	0xdb, 0xe1, 0x4f, 0x8c, 0xd5, 0xaa, 0xb1, 0xe1, 0x30, 0x1a, 0x19, 0xf1, 0x80, 0x9b, 0xb6, 0x65,
+	0x0a, 0x07, 0x13, 0xcc, 0xdc, 0x31, 0x60, 0xc3, 0xa1, 0x0e, 0x8d, 0x1d, 0xc3, 0x7f, 0x7c, 0x56,
+	0x97, 0xdd, 0x06, 0xc8, 0x47, 0xde, 0xd8, 0x4b, 0xe7, 0x52, 0x8e, 0x10, 0xc3, 0xc6, 0xb0, 0x7d,
+	0x84, 0x03, 0xd4, 0x36, 0x2c, 0xea, 0x92, 0xd8, 0x5e, 0xff, 0x41, 0x81, 0xd5, 0x2e, 0x73, 0x3e,
+	0x1b, 0xd8, 0x28, 0xc0, 0x9f, 0x44, 0x9e, 0xea, 0x7b, 0x50, 0x42, 0x27, 0x41, 0x8f, 0xfa, 0x6e,
+	0x70, 0x5a, 0x51, 0x6a, 0x4a, 0xa3, 0xd4, 0xa9, 0xfc, 0xfe, 0xcb, 0xee, 0x06, 0xd7, 0xbb, 0x6f,
+	0xdb, 0x3e, 0x66, 0xec, 0x71, 0xe0, 0xbb, 0xc4, 0x31, 0x27, 0x50, 0xf5, 0x21, 0x14, 0x63, 0xee,
+	0xca, 0x6b, 0x35, 0xa5, 0xb1, 0x74, 0xbf, 0xda, 0x92, 0x96, 0xa6, 0x15, 0x53, 0x74, 0x16, 0x9f,
+	0xff, 0xb9, 0xbd, 0x60, 0x72, 0xf8, 0xde, 0xca, 0x77, 0xff, 0xfe, 0xb4, 0x33, 0x09, 0x54, 0xaf,
+	0xc2, 0xad, 0x94, 0x26, 0x13, 0xb3, 0x01, 0x25, 0x0c, 0xd7, 0xff, 0x51, 0x40, 0xed, 0x32, 0xc7,
+	0xc4, 0x8e, 0xcb, 0x02, 0xec, 0x7f, 0x80, 0x87, 0x1f, 0x22, 0x9f, 0xa8, 0x07, 0xb0, 0x66, 0xe3,
+	0x41, 0x9f, 0x9e, 0x62, 0xff, 0x10, 0xc5, 0xfa, 0x2e, 0x54, 0xbe, 0x3a, 0xf6, 0xe0, 0xd3, 0xaa,
+	0x06, 0xd7, 0x2d, 0x4a, 0x02, 0x1f, 0x59, 0x41, 0x94, 0x41, 0xc9, 0x14, 0x63, 0xb5, 0x0c, 0x45,
+	0x42, 0x89, 0x85, 0x59, 0xa5, 0x50, 0x2b, 0x34, 0x16, 0x4d, 0x3e, 0x52, 0x6f, 0xc3, 0x32, 0x1d,
+	0x91, 0x29, 0xd6, 0xc5, 0xc8, 0xf1, 0x46, 0x34, 0x39, 0x0e, 0x5c, 0x86, 0x22, 0x1e, 0x50, 0xab,
+	0xc7, 0x2a, 0xd7, 0x6a, 0x4a, 0x63, 0xd9, 0xe4, 0xa3, 0xbd, 0xcd, 0x30, 0x6f, 0x49, 0x78, 0x7d,
+	0x0b, 0x34, 0x39, 0x45, 0xb1, 0x02, 0xdf, 0x2b, 0xb0, 0xd6, 0x65, 0xce, 0x01, 0x22, 0x16, 0xee,
+	0x5f, 0x55, 0xfe, 0xb3, 0xa4, 0x6a, 0x50, 0x49, 0x6b, 0x11, 0x42, 0x7f, 0x56, 0xa0, 0x1c, 0x1a,
+	0xfb, 0xc8, 0xf5, 0x84, 0x6d, 0x84, 0x7c, 0x9b, 0xa9, 0xef, 0xa7, 0x57, 0xed, 0x22, 0xad, 0xc9,
+	0xf5, 0x9c, 0xb7, 0x51, 0x4d, 0x58, 0x1b, 0xb9, 0x41, 0xcf, 0xf6, 0xd1, 0x48, 0x44, 0x2f, 0x44,
+	0x98, 0xd5, 0xf1, 0x3c, 0x0f, 0xb3, 0xa7, 0x86, 0x39, 0x25, 0x85, 0xd4, 0x9f, 0x2a, 0xa0, 0x67,
+	0x8b, 0x1e, 0xe7, 0xa5, 0x5a, 0x50, 0x44, 0x1e, 0x3d, 0x21, 0x41, 0x45, 0xa9, 0x15, 0xa2, 0x32,
+	0xe7, 0x92, 0xc3, 0x33, 0xd6, 0xe2, 0x67, 0xac, 0x75, 0x40, 0x5d, 0xd2, 0xb9, 0x17, 0x96, 0xf9,
+	0x8f, 0x7f, 0x6d, 0x37, 0x1c, 0x37, 0xe8, 0x9d, 0x1c, 0xb5, 0x2c, 0xea, 0xf1, 0x53, 0xcf, 0x7f,
+	0x76, 0x99, 0x7d, 0x6c, 0x04, 0xa7, 0x03, 0xcc, 0x22, 0x07, 0x66, 0xf2, 0xd0, 0xe1, 0xe2, 0x6d,
+	0x8a, 0x33, 0xc0, 0x85, 0x3c, 0x0a, 0x95, 0xbe, 0xcc, 0xb5, 0xdb, 0x81, 0x75, 0x82, 0x47, 0x87,
+	0xc9, 0xf0, 0x7c, 0xf1, 0x08, 0x1e, 0x3d, 0x9a, 0x8a, 0x93, 0xb9, 0x78, 0xdb, 0xf0, 0x46, 0xa6,
+	0x66, 0x51, 0x12, 0xbf, 0x2a, 0xb0, 0xd5, 0x65, 0xce, 0x63, 0x1c, 0x70, 0xf3, 0xe7, 0xc9, 0x2d,
+	0x79, 0x85, 0x0b, 0xe3, 0x2e, 0xdc, 0x99, 0xa7, 0x5c, 0xa4, 0x78, 0x0a, 0xeb, 0x5d, 0xe6, 0xec,
+	0xdb, 0xf6, 0xa7, 0xb4, 0xd3, 0xa7, 0xd6, 0x71, 0xdf, 0x65, 0xc1, 0xa5, 0x3b, 0xea, 0x16, 0x94,
+	0x38, 0x3f, 0x0e, 0x9b, 0x6a, 0xa1, 0x51, 0x32, 0x27, 0x13, 0x52, 0xdb, 0x7c, 0x1d, 0xaa, 0x12,
+	0xb5, 0xd0, 0xf5, 0x6d, 0x74, 0x18, 0x4d, 0xec, 0xd1, 0x21, 0xfe, 0xc8, 0xa7, 0xde, 0x55, 0x8b,
+	0xab, 0x81, 0x9e, 0xcd, 0x2f, 0x14, 0xfe, 0xa6, 0x40, 0x59, 0xee, 0x7b, 0x1f, 0x93, 0xaf, 0xe9,
+	0xa5, 0x25, 0xce, 0xab, 0x07, 0xa9, 0x73, 0x17, 0xe6, 0x76, 0xee, 0xc5, 0x44, 0xe7, 0x9e, 0x95,
+	0x9d, 0x24, 0x5d, 0x64, 0xf7, 0x0d, 0x6c, 0xa4, 0x3b, 0xe5, 0xcb, 0x4a, 0x4d, 0x52, 0xa7, 0xc3,
+	0x56, 0x16, 0xb7, 0xd0, 0x76, 0x0c, 0x4b, 0x71, 0xe1, 0xec, 0x33, 0x86, 0x2f, 0x5f, 0x10, 0x1b,
+	0x70, 0xcd, 0xc6, 0x84, 0x7a, 0x5c, 0x4f, 0x3c, 0x90, 0xc4, 0x6c, 0xc2, 0xcd, 0x29, 0x32, 0xa1,
+	0x81, 0xc0, 0x8a, 0xa8, 0x8f, 0xab, 0x90, 0x51, 0x81, 0x72, 0x92, 0x6f, 0xac, 0xe4, 0xfe, 0x33,
+	0x80, 0x42, 0x97, 0x39, 0xea, 0x57, 0x70, 0x23, 0xf1, 0x2c, 0xaa, 0x67, 0x3c, 0x67, 0x52, 0xcf,
+	0x14, 0x6d, 0xe7, 0x62, 0x8c, 0xb8, 0x47, 0x1c, 0x58, 0x4d, 0x3f, 0x63, 0xde, 0xca, 0x76, 0x4f,
+	0xc1, 0xb4, 0xdd, 0x5c, 0x30, 0x41, 0x84, 0x60, 0x39, 0xf9, 0x5a, 0xb8, 0x9d, 0xed, 0x9f, 0x00,
+	0x69, 0xef, 0xe4, 0x00, 0x09, 0x0a, 0x06, 0x37, 0xb3, 0xee, 0xf9, 0xe6, 0x8c, 0x18, 0x32, 0x54,
+	0x6b, 0xe7, 0x86, 0x0a, 0xd2, 0x01, 0xa8, 0x19, 0xf7, 0x63, 0x63, 0xde, 0x16, 0x4c, 0x23, 0xb5,
+	0x7b, 0x79, 0x91, 0x82, 0xf1, 0xa9, 0x02, 0xd5, 0xd9, 0x97, 0x97, 0x91, 0x1d, 0x6f, 0xa6, 0x83,
+	0xf6, 0xf0, 0x7f, 0x3a, 0x08, 0x1d, 0x36, 0xac, 0xa4, 0x6e, 0x98, 0x3b, 0xd9, 0xa1, 0x92, 0x28,
+	0xed, 0xdd, 0x3c, 0xa8, 0xe9, 0x4d, 0xcd, 0xba, 0x2f, 0x9a, 0xb3, 0xaa, 0x4f, 0x82, 0x6a, 0xed,
+	0xdc, 0xd0, 0x24, 0xa9, 0x7c, 0x03, 0x34, 0x73, 0x95, 0x7c, 0x08, 0xd5, 0xda, 0xb9, 0xa1, 0x82,
+	0xd4, 0x83, 0x75, 0xb9, 0x33, 0xbf, 0x9d, 0xe3, 0x00, 0x44, 0x84, 0x46, 0x4e, 0xa0, 0xa0, 0x33,
+	0xe1, 0xba, 0x68, 0xb6, 0xfa, 0xcc, 0x2d, 0x89, 0xec, 0xda, 0xdd, 0xf9, 0x76, 0x11, 0xf3, 0x4b,
+	0x58, 0x9a, 0x6e, 0x9e, 0x6f, 0xce, 0x5b, 0xf9, 0x38, 0x72, 0xf3, 0x42, 0xc8, 0x38, 0x78, 0xe7,
+	0xc1, 0xf3, 0x33, 0x5d, 0x79, 0x71, 0xa6, 0x2b, 0x7f, 0x9f, 0xe9, 0xca, 0xb3, 0x73, 0x7d, 0xe1,
+	0xc5, 0xb9, 0xbe, 0xf0, 0xc7, 0xb9, 0xbe, 0xf0, 0x45, 0x75, 0xf2, 0xfd, 0xf9, 0x64, 0xf2, 0x6d,
+	0x1c, 0x3e, 0x68, 0x8f, 0x8a, 0xd1, 0x17, 0xe6, 0x83, 0xff, 0x06, 0x00, 0x5c, 0x8e, 0x81, 0x02,
+	0x3d, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defined a governance operation for updating the x/incentives module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterDevEarn lets the deployer of a contract register it for dev earn
+	// rewards without going through governance.
+	RegisterDevEarn(ctx context.Context, in *MsgRegisterDevEarn, opts ...grpc.CallOption) (*MsgRegisterDevEarnResponse, error)
+	// CancelDevEarn lets the deployer of a self-registered contract cancel its
+	// dev earn registration.
+	CancelDevEarn(ctx context.Context, in *MsgCancelDevEarn, opts ...grpc.CallOption) (*MsgCancelDevEarnResponse, error)
+	// ClaimDevEarnRewards transfers the accrued rewards of an owner.
+	ClaimDevEarnRewards(ctx context.Context, in *MsgClaimDevEarnRewards, opts ...grpc.CallOption) (*MsgClaimDevEarnRewardsResponse, error)
+	// UpdateDevEarnOwner transfers the ownership of a registered contract and
+	// clears its withdraw address.
+	UpdateDevEarnOwner(ctx context.Context, in *MsgUpdateDevEarnOwner, opts ...grpc.CallOption) (*MsgUpdateDevEarnOwnerResponse, error)
+	// SetDevEarnWithdrawAddress sets the address the rewards of a registered
+	// contract are accrued to.
+	SetDevEarnWithdrawAddress(ctx context.Context, in *MsgSetDevEarnWithdrawAddress, opts ...grpc.CallOption) (*MsgSetDevEarnWithdrawAddressResponse, error)
+	// AddToBlocklist defines a governance operation for blocking contract and
+	// deployer addresses from dev earn.
+	AddToBlocklist(ctx context.Context, in *MsgAddToBlocklist, opts ...grpc.CallOption) (*MsgAddToBlocklistResponse, error)
+	// RemoveFromBlocklist defines a governance operation for unblocking contract
+	// and deployer addresses.
+	RemoveFromBlocklist(ctx context.Context, in *MsgRemoveFromBlocklist, opts ...grpc.CallOption) (*MsgRemoveFromBlocklistResponse, error)
+	// RegisterDevEarnInfo defines a governance operation for registering a
+	// contract for dev earn rewards.
+	RegisterDevEarnInfo(ctx context.Context, in *MsgRegisterDevEarnInfo, opts ...grpc.CallOption) (*MsgRegisterDevEarnInfoResponse, error)
+	// CancelDevEarnInfo defines a governance operation for cancelling the dev
+	// earn registration of a contract.
+	CancelDevEarnInfo(ctx context.Context, in *MsgCancelDevEarnInfo, opts ...grpc.CallOption) (*MsgCancelDevEarnInfoResponse, error)
+	// AddAsset defines a governance operation for adding an asset to the TVL
+	// whitelist.
+	AddAsset(ctx context.Context, in *MsgAddAsset, opts ...grpc.CallOption) (*MsgAddAssetResponse, error)
+	// RemoveAsset defines a governance operation for removing an asset from the
+	// TVL whitelist.
+	RemoveAsset(ctx context.Context, in *MsgRemoveAsset, opts ...grpc.CallOption) (*MsgRemoveAssetResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterDevEarn(ctx context.Context, in *MsgRegisterDevEarn, opts ...grpc.CallOption) (*MsgRegisterDevEarnResponse, error) {
+	out := new(MsgRegisterDevEarnResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Msg/RegisterDevEarn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDevEarn(ctx context.Context, in *MsgCancelDevEarn, opts ...grpc.CallOption) (*MsgCancelDevEarnResponse, error) {
+	out := new(MsgCancelDevEarnResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Msg/CancelDevEarn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimDevEarnRewards(ctx context.Context, in *MsgClaimDevEarnRewards, opts ...grpc.CallOption) (*MsgClaimDevEarnRewardsResponse, error) {
+	out := new(MsgClaimDevEarnRewardsResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Msg/ClaimDevEarnRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDevEarnOwner(ctx context.Context, in *MsgUpdateDevEarnOwner, opts ...grpc.CallOption) (*MsgUpdateDevEarnOwnerResponse, error) {
+	out := new(MsgUpdateDevEarnOwnerResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Msg/UpdateDevEarnOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDevEarnWithdrawAddress(ctx context.Context, in *MsgSetDevEarnWithdrawAddress, opts ...grpc.CallOption) (*MsgSetDevEarnWithdrawAddressResponse, error) {
+	out := new(MsgSetDevEarnWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Msg/SetDevEarnWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddToBlocklist(ctx context.Context, in *MsgAddToBlocklist, opts ...grpc.CallOption) (*MsgAddToBlocklistResponse, error) {
+	out := new(MsgAddToBlocklistResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Msg/AddToBlocklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFromBlocklist(ctx context.Context, in *MsgRemoveFromBlocklist, opts ...grpc.CallOption) (*MsgRemoveFromBlocklistResponse, error) {
+	out := new(MsgRemoveFromBlocklistResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Msg/RemoveFromBlocklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterDevEarnInfo(ctx context.Context, in *MsgRegisterDevEarnInfo, opts ...grpc.CallOption) (*MsgRegisterDevEarnInfoResponse, error) {
+	out := new(MsgRegisterDevEarnInfoResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Msg/RegisterDevEarnInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDevEarnInfo(ctx context.Context, in *MsgCancelDevEarnInfo, opts ...grpc.CallOption) (*MsgCancelDevEarnInfoResponse, error) {
+	out := new(MsgCancelDevEarnInfoResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Msg/CancelDevEarnInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAsset(ctx context.Context, in *MsgAddAsset, opts ...grpc.CallOption) (*MsgAddAssetResponse, error) {
+	out := new(MsgAddAssetResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Msg/AddAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAsset(ctx context.Context, in *MsgRemoveAsset, opts ...grpc.CallOption) (*MsgRemoveAssetResponse, error) {
+	out := new(MsgRemoveAssetResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.devearn.Msg/RemoveAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defined a governance operation for updating the x/incentives module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterDevEarn lets the deployer of a contract register it for dev earn
+	// rewards without going through governance.
+	RegisterDevEarn(context.Context, *MsgRegisterDevEarn) (*MsgRegisterDevEarnResponse, error)
+	// CancelDevEarn lets the deployer of a self-registered contract cancel its
+	// dev earn registration.
+	CancelDevEarn(context.Context, *MsgCancelDevEarn) (*MsgCancelDevEarnResponse, error)
+	// ClaimDevEarnRewards transfers the accrued rewards of an owner.
+	ClaimDevEarnRewards(context.Context, *MsgClaimDevEarnRewards) (*MsgClaimDevEarnRewardsResponse, error)
+	// UpdateDevEarnOwner transfers the ownership of a registered contract and
+	// clears its withdraw address.
+	UpdateDevEarnOwner(context.Context, *MsgUpdateDevEarnOwner) (*MsgUpdateDevEarnOwnerResponse, error)
+	// SetDevEarnWithdrawAddress sets the address the rewards of a registered
+	// contract are accrued to.
+	SetDevEarnWithdrawAddress(context.Context, *MsgSetDevEarnWithdrawAddress) (*MsgSetDevEarnWithdrawAddressResponse, error)
+	// AddToBlocklist defines a governance operation for blocking contract and
+	// deployer addresses from dev earn.
+	AddToBlocklist(context.Context, *MsgAddToBlocklist) (*MsgAddToBlocklistResponse, error)
+	// RemoveFromBlocklist defines a governance operation for unblocking contract
+	// and deployer addresses.
+	RemoveFromBlocklist(context.Context, *MsgRemoveFromBlocklist) (*MsgRemoveFromBlocklistResponse, error)
+	// RegisterDevEarnInfo defines a governance operation for registering a
+	// contract for dev earn rewards.
+	RegisterDevEarnInfo(context.Context, *MsgRegisterDevEarnInfo) (*MsgRegisterDevEarnInfoResponse, error)
+	// CancelDevEarnInfo defines a governance operation for cancelling the dev
+	// earn registration of a contract.
+	CancelDevEarnInfo(context.Context, *MsgCancelDevEarnInfo) (*MsgCancelDevEarnInfoResponse, error)
+	// AddAsset defines a governance operation for adding an asset to the TVL
+	// whitelist.
+	AddAsset(context.Context, *MsgAddAsset) (*MsgAddAssetResponse, error)
+	// RemoveAsset defines a governance operation for removing an asset from the
+	// TVL whitelist.
+	RemoveAsset(context.Context, *MsgRemoveAsset) (*MsgRemoveAssetResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterDevEarn(ctx context.Context, req *MsgRegisterDevEarn) (*MsgRegisterDevEarnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDevEarn not implemented")
+}
+func (*UnimplementedMsgServer) CancelDevEarn(ctx context.Context, req *MsgCancelDevEarn) (*MsgCancelDevEarnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDevEarn not implemented")
+}
+func (*UnimplementedMsgServer) ClaimDevEarnRewards(ctx context.Context, req *MsgClaimDevEarnRewards) (*MsgClaimDevEarnRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimDevEarnRewards not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDevEarnOwner(ctx context.Context, req *MsgUpdateDevEarnOwner) (*MsgUpdateDevEarnOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDevEarnOwner not implemented")
+}
+func (*UnimplementedMsgServer) SetDevEarnWithdrawAddress(ctx context.Context, req *MsgSetDevEarnWithdrawAddress) (*MsgSetDevEarnWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDevEarnWithdrawAddress not implemented")
+}
+func (*UnimplementedMsgServer) AddToBlocklist(ctx context.Context, req *MsgAddToBlocklist) (*MsgAddToBlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToBlocklist not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFromBlocklist(ctx context.Context, req *MsgRemoveFromBlocklist) (*MsgRemoveFromBlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromBlocklist not implemented")
+}
+func (*UnimplementedMsgServer) RegisterDevEarnInfo(ctx context.Context, req *MsgRegisterDevEarnInfo) (*MsgRegisterDevEarnInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDevEarnInfo not implemented")
+}
+func (*UnimplementedMsgServer) CancelDevEarnInfo(ctx context.Context, req *MsgCancelDevEarnInfo) (*MsgCancelDevEarnInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDevEarnInfo not implemented")
+}
+func (*UnimplementedMsgServer) AddAsset(ctx context.Context, req *MsgAddAsset) (*MsgAddAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAsset not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAsset(ctx context.Context, req *MsgRemoveAsset) (*MsgRemoveAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAsset not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterDevEarn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDevEarn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDevEarn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Msg/RegisterDevEarn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDevEarn(ctx, req.(*MsgRegisterDevEarn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDevEarn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDevEarn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDevEarn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Msg/CancelDevEarn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDevEarn(ctx, req.(*MsgCancelDevEarn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimDevEarnRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimDevEarnRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimDevEarnRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Msg/ClaimDevEarnRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimDevEarnRewards(ctx, req.(*MsgClaimDevEarnRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDevEarnOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDevEarnOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDevEarnOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Msg/UpdateDevEarnOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDevEarnOwner(ctx, req.(*MsgUpdateDevEarnOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDevEarnWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDevEarnWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDevEarnWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Msg/SetDevEarnWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDevEarnWithdrawAddress(ctx, req.(*MsgSetDevEarnWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddToBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToBlocklist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddToBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Msg/AddToBlocklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToBlocklist(ctx, req.(*MsgAddToBlocklist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFromBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFromBlocklist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Msg/RemoveFromBlocklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromBlocklist(ctx, req.(*MsgRemoveFromBlocklist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterDevEarnInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDevEarnInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDevEarnInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Msg/RegisterDevEarnInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDevEarnInfo(ctx, req.(*MsgRegisterDevEarnInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDevEarnInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDevEarnInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDevEarnInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Msg/CancelDevEarnInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDevEarnInfo(ctx, req.(*MsgCancelDevEarnInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAsset)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Msg/AddAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAsset(ctx, req.(*MsgAddAsset))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAsset)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.devearn.Msg/RemoveAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAsset(ctx, req.(*MsgRemoveAsset))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sidechain.devearn.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterDevEarn",
+			Handler:    _Msg_RegisterDevEarn_Handler,
+		},
+		{
+			MethodName: "CancelDevEarn",
+			Handler:    _Msg_CancelDevEarn_Handler,
+		},
+		{
+			MethodName: "ClaimDevEarnRewards",
+			Handler:    _Msg_ClaimDevEarnRewards_Handler,
+		},
+		{
+			MethodName: "UpdateDevEarnOwner",
+			Handler:    _Msg_UpdateDevEarnOwner_Handler,
+		},
+		{
+			MethodName: "SetDevEarnWithdrawAddress",
+			Handler:    _Msg_SetDevEarnWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "AddToBlocklist",
+			Handler:    _Msg_AddToBlocklist_Handler,
+		},
+		{
+			MethodName: "RemoveFromBlocklist",
+			Handler:    _Msg_RemoveFromBlocklist_Handler,
+		},
+		{
+			MethodName: "RegisterDevEarnInfo",
+			Handler:    _Msg_RegisterDevEarnInfo_Handler,
+		},
+		{
+			MethodName: "CancelDevEarnInfo",
+			Handler:    _Msg_CancelDevEarnInfo_Handler,
+		},
+		{
+			MethodName: "AddAsset",
+			Handler:    _Msg_AddAsset_Handler,
+		},
+		{
+			MethodName: "RemoveAsset",
+			Handler:    _Msg_RemoveAsset_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sidechain/devearn/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDevEarn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDevEarn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDevEarn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Nonces) > 0 {
+		dAtA3 := make([]byte, len(m.Nonces)*10)
+		var j2 int
+		for _, num := range m.Nonces {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DeployerAddress) > 0 {
+		i -= len(m.DeployerAddress)
+		copy(dAtA[i:], m.DeployerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DeployerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDevEarnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDevEarnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDevEarnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDevEarn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDevEarn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDevEarn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DeployerAddress) > 0 {
+		i -= len(m.DeployerAddress)
+		copy(dAtA[i:], m.DeployerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DeployerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDevEarnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDevEarnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDevEarnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimDevEarnRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimDevEarnRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDevEarnRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimDevEarnRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimDevEarnRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDevEarnRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDevEarnOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDevEarnOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDevEarnOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwnerAddress) > 0 {
+		i -= len(m.NewOwnerAddress)
+		copy(dAtA[i:], m.NewOwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwnerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDevEarnOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDevEarnOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDevEarnOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDevEarnWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDevEarnWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDevEarnWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDevEarnWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDevEarnWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDevEarnWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddToBlocklist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToBlocklist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToBlocklist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddToBlocklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToBlocklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToBlocklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFromBlocklist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFromBlocklist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFromBlocklist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFromBlocklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFromBlocklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFromBlocklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDevEarnInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDevEarnInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDevEarnInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDevEarnInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDevEarnInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDevEarnInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDevEarnInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDevEarnInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDevEarnInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDevEarnInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDevEarnInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDevEarnInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterDevEarn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeployerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovTx(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *MsgRegisterDevEarnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelDevEarn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeployerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelDevEarnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimDevEarnRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimDevEarnRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateDevEarnOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDevEarnOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDevEarnWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDevEarnWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddToBlocklist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddToBlocklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFromBlocklist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveFromBlocklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterDevEarnInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovTx(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *MsgRegisterDevEarnInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelDevEarnInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelDevEarnInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDevEarn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDevEarn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDevEarn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nonces = append(m.Nonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nonces) == 0 {
+					m.Nonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nonces = append(m.Nonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDevEarnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDevEarnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDevEarnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDevEarn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDevEarn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDevEarn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDevEarnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDevEarnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDevEarnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimDevEarnRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimDevEarnRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimDevEarnRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimDevEarnRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimDevEarnRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimDevEarnRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDevEarnOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDevEarnOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDevEarnOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDevEarnOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDevEarnOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDevEarnOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDevEarnWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDevEarnWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDevEarnWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDevEarnWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDevEarnWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDevEarnWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddToBlocklist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddToBlocklist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddToBlocklist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddToBlocklistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddToBlocklistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddToBlocklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFromBlocklist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFromBlocklist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFromBlocklist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFromBlocklistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFromBlocklistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFromBlocklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDevEarnInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDevEarnInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDevEarnInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDevEarnInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDevEarnInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDevEarnInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDevEarnInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDevEarnInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDevEarnInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDevEarnInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDevEarnInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDevEarnInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return nil
 }
+func (m *MsgAddAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAddAssetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAssetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAsset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -365,10 +5169,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAsset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAsset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -405,9 +5209,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -417,24 +5221,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -457,7 +5260,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAssetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -480,10 +5283,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAssetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
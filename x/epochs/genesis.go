@@ -22,7 +22,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			epoch.StartTime = ctx.BlockTime()
 		}
 
-		epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+		// keep the start height of the running epochs of an exported genesis
+		if !epoch.EpochCountingStarted {
+			epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+		}
 
 		k.SetEpochInfo(ctx, epoch)
 	}
@@ -0,0 +1,30 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"sidechain/x/epochs/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding epochs type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixEpoch):
+			var epochA, epochB types.EpochInfo
+			cdc.MustUnmarshal(kvA.Value, &epochA)
+			cdc.MustUnmarshal(kvB.Value, &epochB)
+			return fmt.Sprintf("%v\n%v", epochA, epochB)
+		default:
+			panic(fmt.Sprintf("invalid epochs key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
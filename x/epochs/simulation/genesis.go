@@ -0,0 +1,92 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"sidechain/x/epochs/types"
+)
+
+// Simulation parameter constants
+const (
+	weekEpochDurationKey = "week_epoch_duration"
+	dayEpochDurationKey  = "day_epoch_duration"
+	hourEpochDurationKey = "hour_epoch_duration"
+)
+
+// GenWeekEpochDuration randomized duration of the week epoch, between a day and
+// a week
+func GenWeekEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 24, 7*24+1)) * time.Hour
+}
+
+// GenDayEpochDuration randomized duration of the day epoch, between an hour and
+// a day
+func GenDayEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 25)) * time.Hour
+}
+
+// GenHourEpochDuration randomized duration of the hour epoch, between a minute
+// and an hour
+func GenHourEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 61)) * time.Minute
+}
+
+// RandomizedGenState generates a random GenesisState for epochs. The epoch
+// identifiers are kept, as other modules are hooked to them, while their
+// durations are randomized.
+func RandomizedGenState(simState *module.SimulationState) {
+	var weekEpochDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, weekEpochDurationKey, &weekEpochDuration, simState.Rand,
+		func(r *rand.Rand) { weekEpochDuration = GenWeekEpochDuration(r) },
+	)
+
+	var dayEpochDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, dayEpochDurationKey, &dayEpochDuration, simState.Rand,
+		func(r *rand.Rand) { dayEpochDuration = GenDayEpochDuration(r) },
+	)
+
+	var hourEpochDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, hourEpochDurationKey, &hourEpochDuration, simState.Rand,
+		func(r *rand.Rand) { hourEpochDuration = GenHourEpochDuration(r) },
+	)
+
+	epochsGenesis := types.NewGenesisState([]types.EpochInfo{
+		newEpochInfo(types.WeekEpochID, weekEpochDuration),
+		newEpochInfo(types.DayEpochID, dayEpochDuration),
+		newEpochInfo(types.HourEpochID, hourEpochDuration),
+	})
+
+	bz, err := json.MarshalIndent(&epochsGenesis.Epochs, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated epochs:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(epochsGenesis)
+}
+
+// newEpochInfo returns an epoch that starts counting at genesis
+func newEpochInfo(identifier string, duration time.Duration) types.EpochInfo {
+	return types.EpochInfo{
+		Identifier:              identifier,
+		StartTime:               time.Time{},
+		Duration:                duration,
+		CurrentEpoch:            0,
+		CurrentEpochStartHeight: 0,
+		CurrentEpochStartTime:   time.Time{},
+		EpochCountingStarted:    false,
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"sidechain/x/epochs/simulation"
+	"sidechain/x/epochs/types"
+)
+
+// TestRandomizedGenState tests that RandomizedGenState keeps the epoch
+// identifiers and randomizes their durations.
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	r := rand.New(rand.NewSource(1))
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: sdkmath.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var epochsGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &epochsGenesis)
+
+	require.NoError(t, epochsGenesis.Validate())
+	require.Len(t, epochsGenesis.Epochs, 3)
+
+	bounds := map[string][2]time.Duration{
+		types.WeekEpochID: {24 * time.Hour, 7 * 24 * time.Hour},
+		types.DayEpochID:  {time.Hour, 24 * time.Hour},
+		types.HourEpochID: {time.Minute, time.Hour},
+	}
+	for _, epoch := range epochsGenesis.Epochs {
+		bound, ok := bounds[epoch.Identifier]
+		require.True(t, ok, epoch.Identifier)
+		require.GreaterOrEqual(t, epoch.Duration, bound[0], epoch.Identifier)
+		require.LessOrEqual(t, epoch.Duration, bound[1], epoch.Identifier)
+	}
+}
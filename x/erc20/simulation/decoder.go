@@ -0,0 +1,48 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"sidechain/x/erc20/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding erc20 type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.ParamStoreKeyEnableErc20),
+			bytes.Equal(kvA.Key, types.ParamStoreKeyEnableEVMHook):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixTokenPair):
+			var pairA, pairB types.TokenPair
+			cdc.MustUnmarshal(kvA.Value, &pairA)
+			cdc.MustUnmarshal(kvB.Value, &pairB)
+			return fmt.Sprintf("%v\n%v", pairA, pairB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixTokenPairByERC20),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixTokenPairByDenom):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		default:
+			panic(fmt.Sprintf("invalid erc20 key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"sidechain/x/erc20/simulation"
+	"sidechain/x/erc20/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	erc20 := common.HexToAddress("0x2000000000000000000000000000000000000001")
+	pair := types.NewTokenPair(erc20, "aside", true, types.OWNER_MODULE)
+	id := pair.GetID()
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamStoreKeyEnableErc20, Value: []byte("0x01")},
+			{Key: append(types.KeyPrefixTokenPair, id...), Value: cdc.MustMarshal(&pair)},
+			{Key: append(types.KeyPrefixTokenPairByERC20, erc20.Bytes()...), Value: id},
+			{Key: append(types.KeyPrefixTokenPairByDenom, []byte(pair.Denom)...), Value: id},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"EnableErc20", "0x01\n0x01"},
+		{"TokenPair", fmt.Sprintf("%v\n%v", pair, pair)},
+		{"TokenPairByERC20", fmt.Sprintf("%X\n%X", id, id)},
+		{"TokenPairByDenom", fmt.Sprintf("%X\n%X", id, id)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"sidechain/x/erc20/types"
+)
+
+// Simulation parameter constants
+const (
+	enableErc20Key   = "enable_erc20"
+	enableEVMHookKey = "enable_evm_hook"
+)
+
+// GenEnableErc20 randomized EnableErc20
+func GenEnableErc20(r *rand.Rand) bool {
+	return r.Intn(10) > 0
+}
+
+// GenEnableEVMHook randomized EnableEVMHook
+func GenEnableEVMHook(r *rand.Rand) bool {
+	return r.Intn(10) > 0
+}
+
+// RandomizedGenState generates a random GenesisState for erc20
+func RandomizedGenState(simState *module.SimulationState) {
+	var enableErc20 bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, enableErc20Key, &enableErc20, simState.Rand,
+		func(r *rand.Rand) { enableErc20 = GenEnableErc20(r) },
+	)
+
+	var enableEVMHook bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, enableEVMHookKey, &enableEVMHook, simState.Rand,
+		func(r *rand.Rand) { enableEVMHook = GenEnableEVMHook(r) },
+	)
+
+	// token pairs are registered through governance proposals during the
+	// simulation, as they require the ERC20 contracts to be deployed
+	erc20Genesis := types.NewGenesisState(
+		types.NewParams(enableErc20, enableEVMHook),
+		[]types.TokenPair{},
+	)
+
+	bz, err := json.MarshalIndent(&erc20Genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated erc20 parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&erc20Genesis)
+}
@@ -0,0 +1,302 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/big"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ethereum/go-ethereum/common"
+
+	"sidechain/contracts"
+	"sidechain/x/erc20/keeper"
+	"sidechain/x/erc20/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec //these aren't hard coded credentials
+const (
+	OpWeightMsgConvertCoin  = "op_weight_msg_convert_coin"
+	OpWeightMsgConvertERC20 = "op_weight_msg_convert_erc20"
+	OpWeightMsgUpdateParams = "op_weight_msg_update_params"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak types.AccountKeeper,
+	bk bankkeeper.Keeper,
+	gk types.GovKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgConvertCoin  int
+		weightMsgConvertERC20 int
+		weightMsgUpdateParams int
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgConvertCoin, &weightMsgConvertCoin, nil,
+		func(_ *rand.Rand) {
+			weightMsgConvertCoin = simappparams.DefaultWeightMsgSend
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgConvertERC20, &weightMsgConvertERC20, nil,
+		func(_ *rand.Rand) {
+			weightMsgConvertERC20 = simappparams.DefaultWeightMsgSend
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateParams, &weightMsgUpdateParams, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateParams = simappparams.DefaultWeightCommunitySpendProposal
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgConvertCoin,
+			SimulateMsgConvertCoin(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgConvertERC20,
+			SimulateMsgConvertERC20(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateParams,
+			SimulateMsgUpdateParams(ak, bk, gk),
+		),
+	}
+}
+
+// SimulateMsgConvertCoin generates a MsgConvertCoin that converts a random
+// amount of a registered coin held by a random account.
+func SimulateMsgConvertCoin(ak types.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+
+		var coins sdk.Coins
+		for _, pair := range k.GetTokenPairs(ctx) {
+			if amount := spendable.AmountOf(pair.Denom); pair.Enabled && amount.IsPositive() {
+				coins = append(coins, sdk.NewCoin(pair.Denom, amount))
+			}
+		}
+		if len(coins) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertCoin, "no registered coin to convert"), nil, nil
+		}
+
+		coin := coins[r.Intn(len(coins))]
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertCoin, "unable to generate amount"), nil, err
+		}
+		coin.Amount = amount
+
+		receiver, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgConvertCoin(coin, common.BytesToAddress(receiver.Address), simAccount.Address)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type(), sdk.NewCoins(coin))
+	}
+}
+
+// SimulateMsgConvertERC20 generates a MsgConvertERC20 that converts a random
+// amount of a registered ERC20 token held by a random account.
+func SimulateMsgConvertERC20(ak types.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		sender := common.BytesToAddress(simAccount.Address)
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+		var (
+			tokens   []common.Address
+			balances []*big.Int
+		)
+		for _, pair := range k.GetTokenPairs(ctx) {
+			if !pair.Enabled {
+				continue
+			}
+			balance := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), sender)
+			if balance != nil && balance.Sign() > 0 {
+				tokens = append(tokens, pair.GetERC20Contract())
+				balances = append(balances, balance)
+			}
+		}
+		if len(tokens) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertERC20, "no registered token to convert"), nil, nil
+		}
+
+		i := r.Intn(len(tokens))
+		amount, err := simtypes.RandPositiveInt(r, sdk.NewIntFromBigInt(balances[i]))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertERC20, "unable to generate amount"), nil, err
+		}
+
+		receiver, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgConvertERC20(amount, receiver.Address, tokens[i], sender)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type(), sdk.NewCoins())
+	}
+}
+
+// SimulateMsgUpdateParams submits a governance proposal that executes a
+// MsgUpdateParams with random parameters on behalf of the governance account.
+func SimulateMsgUpdateParams(ak types.AccountKeeper, bk bankkeeper.Keeper, gk types.GovKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgUpdateParams{
+			Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			Params:    GenParams(r),
+		}
+
+		return deliverProposal(r, app, ctx, accs, chainID, ak, bk, gk, msg)
+	}
+}
+
+// deliverTx signs the message with the simulation account and delivers it with
+// random fees
+func deliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk bankkeeper.Keeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+	msgType string,
+	spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: spent,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// deliverProposal submits a governance proposal that executes the message,
+// with the minimum deposit paid by a random account, and schedules YES votes on
+// it from random accounts within the voting period
+func deliverProposal(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	accs []simtypes.Account,
+	chainID string,
+	ak types.AccountKeeper,
+	bk bankkeeper.Keeper,
+	gk types.GovKeeper,
+	msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	proposer, _ := simtypes.RandomAcc(r, accs)
+	deposit := gk.GetDepositParams(ctx).MinDeposit
+
+	account := ak.GetAccount(ctx, proposer.Address)
+	spendable := bk.SpendableCoins(ctx, proposer.Address)
+	coins, hasNeg := spendable.SafeSub(deposit...)
+	if account == nil || hasNeg {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to pay the proposal deposit"), nil, nil
+	}
+
+	proposalID, err := gk.GetProposalID(ctx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to get the proposal id"), nil, err
+	}
+
+	submitMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, proposer.Address.String(), "")
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to build the proposal"), nil, err
+	}
+
+	fees, err := simtypes.RandomFees(r, ctx, coins)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to generate fees"), nil, err
+	}
+
+	// the module messages are not registered on the gov amino codec, so the
+	// proposal is signed in direct mode and delivered without amino sign bytes
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenSignedMockTx(
+		r,
+		txGen,
+		[]sdk.Msg{submitMsg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		proposer.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to generate mock tx"), nil, err
+	}
+
+	if _, _, err := app.SimDeliver(txGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to deliver tx"), nil, err
+	}
+
+	votingPeriod := gk.GetVotingParams(ctx).VotingPeriod
+	numVotes := simtypes.RandIntBetween(r, 1, len(accs)+1)
+
+	fops := make([]simtypes.FutureOperation, 0, numVotes)
+	for _, i := range r.Perm(len(accs))[:numVotes] {
+		fops = append(fops, simtypes.FutureOperation{
+			BlockTime: ctx.BlockHeader().Time.Add(time.Duration(r.Int63n(int64(*votingPeriod)))),
+			Op:        simulateMsgVote(ak, bk, accs[i], proposalID),
+		})
+	}
+
+	return simtypes.NewOperationMsgBasic(govtypes.ModuleName, submitMsg.Type(), "", true, nil), fops, nil
+}
+
+// simulateMsgVote votes YES on the proposal with the simulation account
+func simulateMsgVote(ak types.AccountKeeper, bk bankkeeper.Keeper, simAccount simtypes.Account, proposalID uint64) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := govv1.NewMsgVote(simAccount.Address, proposalID, govv1.OptionYes, "")
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type(), bk.SpendableCoins(ctx, simAccount.Address))
+	}
+}
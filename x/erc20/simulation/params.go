@@ -0,0 +1,32 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"sidechain/x/erc20/types"
+)
+
+// GenParams returns randomized erc20 parameters. The parameters are kept in the
+// module store, so they are changed with a MsgUpdateParams from the governance
+// account rather than with a param change proposal.
+func GenParams(r *rand.Rand) types.Params {
+	return types.NewParams(GenEnableErc20(r), GenEnableEVMHook(r))
+}
@@ -0,0 +1,124 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+	"strings"
+
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"sidechain/x/erc20/keeper"
+	"sidechain/x/erc20/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec //these aren't hard coded credentials
+const (
+	OpWeightRegisterCoinProposal          = "op_weight_register_coin_proposal"
+	OpWeightToggleTokenConversionProposal = "op_weight_toggle_token_conversion_proposal"
+)
+
+// ProposalContents returns the erc20 governance proposal contents with their
+// respective weights
+func ProposalContents(bk bankkeeper.Keeper, k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightRegisterCoinProposal,
+			simappparams.DefaultWeightTextProposal,
+			SimulateRegisterCoinProposal(bk, k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightToggleTokenConversionProposal,
+			simappparams.DefaultWeightTextProposal/2,
+			SimulateToggleTokenConversionProposal(k),
+		),
+	}
+}
+
+// SimulateRegisterCoinProposal generates a RegisterCoinProposal for a random
+// coin with a supply that isn't registered yet
+func SimulateRegisterCoinProposal(bk bankkeeper.Keeper, k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		if !k.IsERC20Enabled(ctx) {
+			return nil
+		}
+
+		supply, _, err := bk.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: query.MaxLimit})
+		if err != nil {
+			return nil
+		}
+
+		var denoms []string
+		for _, coin := range supply {
+			if !k.IsDenomRegistered(ctx, coin.Denom) && !strings.Contains(coin.Denom, "/") {
+				denoms = append(denoms, coin.Denom)
+			}
+		}
+		if len(denoms) == 0 {
+			return nil
+		}
+
+		denom := denoms[r.Intn(len(denoms))]
+		metadata, found := bk.GetDenomMetaData(ctx, denom)
+		if !found {
+			metadata = banktypes.Metadata{
+				Description: simtypes.RandStringOfLength(r, 20),
+				DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+				Base:        denom,
+				Display:     denom,
+				Name:        denom,
+				Symbol:      strings.ToUpper(denom),
+			}
+		}
+
+		return types.NewRegisterCoinProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			metadata,
+		)
+	}
+}
+
+// SimulateToggleTokenConversionProposal generates a
+// ToggleTokenConversionProposal for a random token pair
+func SimulateToggleTokenConversionProposal(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		if !k.IsERC20Enabled(ctx) {
+			return nil
+		}
+
+		pairs := k.GetTokenPairs(ctx)
+		if len(pairs) == 0 {
+			return nil
+		}
+
+		return types.NewToggleTokenConversionProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			pairs[r.Intn(len(pairs))].Denom,
+		)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -67,6 +68,14 @@ type EVMKeeper interface {
 	ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
 }
 
+// GovKeeper defines the expected gov keeper used to submit governance proposals
+// in simulations
+type GovKeeper interface {
+	GetProposalID(ctx sdk.Context) (uint64, error)
+	GetDepositParams(ctx sdk.Context) govv1.DepositParams
+	GetVotingParams(ctx sdk.Context) govv1.VotingParams
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
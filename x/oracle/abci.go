@@ -42,15 +42,10 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 			voteTargets = append(voteTargets, v.Name)
 		}
 
-		// Clear all exchange rates
-		k.IterateExchangeRates(ctx, func(denom string, _ sdk.Dec) (stop bool) {
-			k.DeleteExchangeRate(ctx, denom)
-			return false
-		})
-
 		// Organize votes to ballot by denom
 		voteMap := k.OrganizeBallotByDenom(ctx, validatorClaimMap)
 		// Iterate through ballots and update exchange rates; drop if not enough votes have been achieved.
+		talliedRates := make(map[string]sdk.Dec)
 		for denom, ballot := range voteMap {
 			totalBondedPower := sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx), k.StakingKeeper.PowerReduction(ctx))
 			voteThreshold := k.VoteThreshold(ctx)
@@ -58,16 +53,27 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 			ballotPower := sdk.NewInt(ballot.Power())
 
 			if !ballotPower.IsZero() && ballotPower.GTE(thresholdVotes) {
-				exchangeRate, err := Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+				exchangeRate, err := Tally(ctx, ballot, params.DenomAggregation(denom), params.DenomRewardBand(denom), validatorClaimMap)
 				if err != nil {
 					return err
 				}
+				talliedRates[denom] = exchangeRate
+
+				// Keep the previous exchange rate of a denom halted by the
+				// circuit breaker
+				if !k.CheckCircuitBreaker(ctx, params, denom, exchangeRate) {
+					continue
+				}
 
 				// Set the exchange rate, emit ABCI event
 				k.SetExchangeRateWithEvent(ctx, denom, exchangeRate)
 			}
 		}
 
+		// Keep the last exchange rate of the denoms without quorum until it
+		// is older than the max price age
+		k.PruneExpiredExchangeRates(ctx, params.Whitelist, params.MaxPriceAge)
+
 		//---------------------------
 		// Do miss counting & slashing
 		voteTargetsLen := len(voteTargets)
@@ -82,6 +88,13 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 			k.SetMissCounter(ctx, claim.Recipient, k.GetMissCounter(ctx, claim.Recipient)+1)
 		}
 
+		// Record the voting performance of the validators over this vote period
+		// and drop the vote periods out of the rolling window
+		for key, stats := range PeriodPerformances(voteMap, talliedRates, validatorClaimMap, voteTargetsLen) {
+			k.RecordValidatorPerformance(ctx, validatorClaimMap[key].Recipient, stats)
+		}
+		k.PruneValidatorPerformancePeriods(ctx, ctx.BlockHeight()-int64(params.PerformanceWindow))
+
 		// Distribute rewards to ballot winners
 		k.RewardBallotWinners(
 			ctx,
@@ -95,6 +108,11 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 		k.ClearBallots(ctx, params.VotePeriod)
 	}
 
+	// Stamp the current exchange rates into the historic prices
+	if IsPeriodLastBlock(ctx, params.HistoricStampPeriod) {
+		k.StampHistoricPrices(ctx, params)
+	}
+
 	// Do slash who did miss voting over threshold and
 	// reset miss counters of all validators at the last block of slash window
 	if IsPeriodLastBlock(ctx, params.SlashWindow) {
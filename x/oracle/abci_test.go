@@ -5,11 +5,16 @@ import (
 	"math"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/rand"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"sidechain/x/oracle"
@@ -101,9 +106,32 @@ func TestOracleThreshold(t *testing.T) {
 	require.NoError(t, err1)
 	require.NoError(t, err2)
 
-	oracle.EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(2), input.OracleKeeper)
+
+	// The last exchange rate is kept until it is older than the max price age
+	rate, age, err := input.OracleKeeper.GetExchangeRateWithAge(input.Ctx.WithBlockHeight(2), types.TestDenomD)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+	require.Zero(t, age)
+
+	exchangeRate, err := input.OracleKeeper.GetExchangeRateEntry(input.Ctx.WithBlockHeight(2), types.TestDenomD)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), exchangeRate.LastUpdateHeight)
+
+	// Case 4.
+	// No consensus until the max price age passes, exchange rate is removed
+	maxPriceAge := input.OracleKeeper.MaxPriceAge(input.Ctx)
+	ctx := input.Ctx.WithBlockHeight(3).WithBlockTime(input.Ctx.BlockTime().Add(maxPriceAge))
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+
+	_, age, err = input.OracleKeeper.GetExchangeRateWithAge(ctx, types.TestDenomD)
+	require.NoError(t, err)
+	require.Equal(t, maxPriceAge, age)
+
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(ctx.BlockTime().Add(time.Second))
+	oracle.EndBlocker(ctx, input.OracleKeeper)
 
-	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx.WithBlockHeight(1), types.TestDenomD)
+	_, err = input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomD)
 	require.Error(t, err)
 }
 
@@ -115,13 +143,87 @@ func TestOracleDrop(t *testing.T) {
 	// Account 1, DenomC
 	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 0)
 
+	// The last exchange rate is kept after an illiquid oracle vote
+	ctx := input.Ctx.WithBlockHeight(1)
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+
+	rate, err := input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomC)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+
+	// Swap halt once the last exchange rate is older than the max price age
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 0)
+
+	maxPriceAge := input.OracleKeeper.MaxPriceAge(input.Ctx)
+	ctx = input.Ctx.WithBlockHeight(2).WithBlockTime(input.Ctx.BlockTime().Add(maxPriceAge + time.Second))
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+
+	_, err = input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomC)
+	require.Error(t, err)
+}
+
+func TestOracleDropWithoutMaxPriceAge(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MaxPriceAge = 0
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
+
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomC, randomExchangeRate)
+
+	// Account 1, DenomC
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 0)
+
 	// Immediately swap halt after an illiquid oracle vote
-	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	ctx := input.Ctx.WithBlockHeight(1)
+	oracle.EndBlocker(ctx, input.OracleKeeper)
 
-	_, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+	_, err := input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomC)
 	require.Error(t, err)
 }
 
+func TestOracleDropRemovedFromWhitelist(t *testing.T) {
+	input, _ := setup(t)
+
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomC, randomExchangeRate)
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomB, randomExchangeRate)
+
+	ctx := input.Ctx.WithBlockHeight(1)
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+
+	_, err := input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomC)
+	require.NoError(t, err)
+	_, err = input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomB)
+	require.Error(t, err)
+}
+
+func TestOracleHistoricPrices(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.HistoricStampPeriod = 2
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
+
+	for i := range keeper.Addrs[:2] {
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomD, Amount: randomExchangeRate}}, i)
+	}
+
+	// not the last block of the historic stamp period
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	_, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomD)
+	require.NoError(t, err)
+	require.Empty(t, input.OracleKeeper.GetHistoricPrices(input.Ctx, types.TestDenomD, 0))
+
+	// the kept exchange rate is stamped at the end of the period
+	ctx := input.Ctx.WithBlockHeight(1)
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+
+	stamps := input.OracleKeeper.GetHistoricPrices(ctx, types.TestDenomD, 0)
+	require.Len(t, stamps, 1)
+	require.Equal(t, randomExchangeRate, stamps[0].ExchangeRate)
+	require.Equal(t, int64(1), stamps[0].BlockHeight)
+}
+
 func TestOracleTally(t *testing.T) {
 	input, _ := setup(t)
 
@@ -199,12 +301,116 @@ func TestOracleTally(t *testing.T) {
 		}
 	}
 
-	tallyMedian, _ := oracle.Tally(input.Ctx, ballot, input.OracleKeeper.RewardBand(input.Ctx), validatorClaimMap)
+	tallyMedian, _ := oracle.Tally(input.Ctx, ballot, types.AGGREGATION_METHOD_WEIGHTED_MEDIAN, input.OracleKeeper.RewardBand(input.Ctx), validatorClaimMap)
 
 	require.Equal(t, validatorClaimMap, expectedValidatorClaimMap)
 	require.Equal(t, tallyMedian.MulInt64(100).TruncateInt(), weightedMedian.MulInt64(100).TruncateInt())
 }
 
+func TestOracleTallyDenomRewardBand(t *testing.T) {
+	input, _ := setup(t)
+
+	ballot := types.ExchangeRateBallot{
+		types.NewVoteForTally(sdk.OneDec(), types.TestDenomD, keeper.ValAddrs[0], 10),
+		types.NewVoteForTally(sdk.OneDec(), types.TestDenomD, keeper.ValAddrs[1], 10),
+		types.NewVoteForTally(sdk.OneDec(), types.TestDenomD, keeper.ValAddrs[2], 10),
+		types.NewVoteForTally(sdk.NewDecWithPrec(105, 2), types.TestDenomD, keeper.ValAddrs[3], 10),
+	}
+
+	tally := func(rewardBand sdk.Dec) map[string]types.Claim {
+		claimMap := make(map[string]types.Claim)
+		for _, vote := range ballot {
+			claimMap[vote.Voter.String()] = types.NewClaim(vote.Power, 0, 0, vote.Voter)
+		}
+
+		exchangeRate, err := oracle.Tally(input.Ctx, ballot, types.AGGREGATION_METHOD_WEIGHTED_MEDIAN, rewardBand, claimMap)
+		require.NoError(t, err)
+		require.Equal(t, sdk.OneDec(), exchangeRate)
+
+		return claimMap
+	}
+
+	// the deviating vote misses the default reward band
+	claimMap := tally(types.DefaultRewardBand)
+	require.Equal(t, int64(1), claimMap[keeper.ValAddrs[0].String()].WinCount)
+	require.Equal(t, int64(0), claimMap[keeper.ValAddrs[3].String()].WinCount)
+
+	// and is rewarded with a wider reward band for the denom
+	rewardBand := sdk.NewDecWithPrec(2, 1)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomD, RewardBand: &rewardBand}}
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
+
+	claimMap = tally(input.OracleKeeper.GetParams(input.Ctx).DenomRewardBand(types.TestDenomD))
+	require.Equal(t, int64(1), claimMap[keeper.ValAddrs[0].String()].WinCount)
+	require.Equal(t, int64(1), claimMap[keeper.ValAddrs[3].String()].WinCount)
+}
+
+func TestOracleTallyAggregationSpread(t *testing.T) {
+	input, _ := setup(t)
+
+	testCases := []struct {
+		name         string
+		aggregation  types.AggregationMethod
+		rates        []string
+		exchangeRate sdk.Dec
+		winners      []bool
+	}{
+		{
+			// spread of 3.67 around 1
+			"weighted median",
+			types.AGGREGATION_METHOD_WEIGHTED_MEDIAN,
+			[]string{"1", "1", "1", "4", "7", "7"},
+			sdk.OneDec(),
+			[]bool{true, true, true, true, false, false},
+		},
+		{
+			// spread of 2.86 around 3.67, the spread around the median of 4.02
+			// would reward the votes at 7
+			"trimmed weighted mean",
+			types.AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN,
+			[]string{"1", "1", "1", "6", "7", "7"},
+			sdk.MustNewDecFromStr("3.666666666666666667"),
+			[]bool{true, true, true, true, false, false},
+		},
+		{
+			// spread of 22.27 around 2, the spread around the median of 21.83
+			// would not reward the vote at 24
+			"median mad",
+			types.AGGREGATION_METHOD_MEDIAN_MAD,
+			[]string{"1", "2", "2", "3", "3", "3", "24", "61"},
+			sdk.NewDec(2),
+			[]bool{true, true, true, true, true, true, true, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ballot := types.ExchangeRateBallot{}
+			claimMap := make(map[string]types.Claim)
+			valAddrs := make([]sdk.ValAddress, len(tc.rates))
+			for i, rate := range tc.rates {
+				valAddrs[i] = sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+				ballot = append(ballot, types.NewVoteForTally(sdk.MustNewDecFromStr(rate), types.TestDenomD, valAddrs[i], 10))
+				claimMap[valAddrs[i].String()] = types.NewClaim(10, 0, 0, valAddrs[i])
+			}
+
+			exchangeRate, err := oracle.Tally(input.Ctx, ballot, tc.aggregation, sdk.ZeroDec(), claimMap)
+			require.NoError(t, err)
+			require.Equal(t, tc.exchangeRate, exchangeRate)
+
+			for i, winner := range tc.winners {
+				claim := claimMap[valAddrs[i].String()]
+				if winner {
+					require.Equal(t, int64(1), claim.WinCount, "vote %s", tc.rates[i])
+				} else {
+					require.Zero(t, claim.WinCount, "vote %s", tc.rates[i])
+				}
+			}
+		})
+	}
+}
+
 func TestOracleTallyTiming(t *testing.T) {
 	input, h := setup(t)
 
@@ -215,7 +421,8 @@ func TestOracleTallyTiming(t *testing.T) {
 
 	params := input.OracleKeeper.GetParams(input.Ctx)
 	params.VotePeriod = 10 // set vote period to 10 for now, for convenience
-	input.OracleKeeper.SetParams(input.Ctx, params)
+	params.HistoricStampPeriod = params.VotePeriod
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
 	require.Equal(t, 0, int(input.Ctx.BlockHeight()))
 
 	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
@@ -256,7 +463,7 @@ func TestOracleRewardBand(t *testing.T) {
 	input, h := setup(t)
 	params := input.OracleKeeper.GetParams(input.Ctx)
 	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
-	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
 
 	rewardSpread := randomExchangeRate.Mul(input.OracleKeeper.RewardBand(input.Ctx).QuoInt64(2))
 
@@ -451,7 +658,7 @@ func TestInvalidVotesSlashing(t *testing.T) {
 	input, h := setup(t)
 	params := input.OracleKeeper.GetParams(input.Ctx)
 	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
-	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
 
 	votePeriodsPerWindow := sdk.NewDec(int64(input.OracleKeeper.SlashWindow(input.Ctx))).QuoInt64(int64(input.OracleKeeper.VotePeriod(input.Ctx))).TruncateInt64()
 	slashFraction := input.OracleKeeper.SlashFraction(input.Ctx)
@@ -492,6 +699,138 @@ func TestInvalidVotesSlashing(t *testing.T) {
 	require.Equal(t, sdk.OneDec().Sub(slashFraction).MulInt(stakingAmt).TruncateInt(), validator.GetBondedTokens())
 }
 
+func TestOracleCircuitBreaker(t *testing.T) {
+	input, h := setup(t)
+
+	maxDeviation := sdk.NewDecWithPrec(5, 1)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC, MaxDeviation: &maxDeviation}}
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
+
+	vote := func(height int64, exchangeRate sdk.Dec) sdk.Context {
+		for i := 0; i < 3; i++ {
+			makeAggregatePrevoteAndVote(t, input, h, height, sdk.DecCoins{{Denom: types.TestDenomC, Amount: exchangeRate}}, i)
+		}
+		ctx := input.Ctx.WithBlockHeight(height + 1).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, oracle.EndBlocker(ctx, input.OracleKeeper))
+		return ctx
+	}
+
+	ctx := vote(0, sdk.NewDec(100))
+	rate, err := input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomC)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(100), rate)
+
+	// a jump of 90% keeps the previous exchange rate and halts the price
+	ctx = vote(1, sdk.NewDec(190))
+	rate, err = input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomC)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(100), rate)
+	require.True(t, input.OracleKeeper.IsPriceHalted(ctx, types.TestDenomC))
+
+	var halted bool
+	for _, event := range ctx.EventManager().Events() {
+		halted = halted || event.Type == types.EventTypePriceHalt
+	}
+	require.True(t, halted)
+
+	// the exchange rate is updated again once governance clears the halt
+	_, err = h.ClearPriceHalt(sdk.WrapSDKContext(ctx), types.NewMsgClearPriceHalt(authtypes.NewModuleAddress(govtypes.ModuleName), types.TestDenomC))
+	require.NoError(t, err)
+
+	ctx = vote(2, sdk.NewDec(190))
+	rate, err = input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomC)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(190), rate)
+	require.False(t, input.OracleKeeper.IsPriceHalted(ctx, types.TestDenomC))
+}
+
+func TestOracleValidatorPerformance(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
+
+	// Account 1 and 3 vote, Account 2 abstains
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: sdk.ZeroDec()}}, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 2)
+	require.NoError(t, oracle.EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper))
+
+	// only Account 1 votes, the ballot fails to reach quorum
+	makeAggregatePrevoteAndVote(t, input, h, 1, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 0)
+	ctx := input.Ctx.WithBlockHeight(2)
+	require.NoError(t, oracle.EndBlocker(ctx, input.OracleKeeper))
+
+	performance := input.OracleKeeper.GetValidatorPerformance(ctx, keeper.ValAddrs[0])
+	require.Equal(t, uint64(2), performance.VotePeriods)
+	require.Equal(t, uint64(2), performance.PeriodsVoted)
+	require.Equal(t, uint64(1), performance.WinCount)
+	require.Equal(t, uint64(0), performance.AbstainCount)
+	require.Equal(t, uint64(1), performance.MissCount)
+	require.Equal(t, uint64(1), performance.DeviationCount)
+	require.Equal(t, sdk.ZeroDec(), performance.DeviationSum)
+
+	performance = input.OracleKeeper.GetValidatorPerformance(ctx, keeper.ValAddrs[1])
+	require.Equal(t, uint64(2), performance.VotePeriods)
+	require.Equal(t, uint64(1), performance.PeriodsVoted)
+	require.Equal(t, uint64(0), performance.WinCount)
+	require.Equal(t, uint64(1), performance.AbstainCount)
+	require.Equal(t, uint64(1), performance.MissCount)
+	require.Equal(t, uint64(0), performance.DeviationCount)
+
+	window := input.OracleKeeper.GetValidatorPerformanceWindow(ctx, keeper.ValAddrs[2], params.PerformanceWindow)
+	require.Equal(t, uint64(2), window.VotePeriods)
+	require.Equal(t, uint64(1), window.PeriodsVoted)
+	require.Equal(t, uint64(1), window.MissCount)
+}
+
+func TestPeriodPerformances(t *testing.T) {
+	ballots := map[string]types.ExchangeRateBallot{
+		types.TestDenomC: {
+			types.NewVoteForTally(sdk.NewDec(100), types.TestDenomC, keeper.ValAddrs[0], 10),
+			types.NewVoteForTally(sdk.NewDec(110), types.TestDenomC, keeper.ValAddrs[1], 10),
+			types.NewVoteForTally(sdk.ZeroDec(), types.TestDenomC, keeper.ValAddrs[2], 0),
+		},
+		types.TestDenomD: {
+			types.NewVoteForTally(sdk.NewDec(5), types.TestDenomD, keeper.ValAddrs[0], 10),
+		},
+	}
+	claimMap := map[string]types.Claim{
+		keeper.ValAddrs[0].String(): types.NewClaim(10, 10, 1, keeper.ValAddrs[0]),
+		keeper.ValAddrs[1].String(): types.NewClaim(10, 0, 0, keeper.ValAddrs[1]),
+		keeper.ValAddrs[2].String(): types.NewClaim(10, 0, 1, keeper.ValAddrs[2]),
+		keeper.ValAddrs[3].String(): types.NewClaim(10, 0, 0, keeper.ValAddrs[3]),
+	}
+
+	// only the ballot of denom C is tallied
+	performances := oracle.PeriodPerformances(ballots, map[string]sdk.Dec{types.TestDenomC: sdk.NewDec(100)}, claimMap, 1)
+	require.Len(t, performances, 4)
+
+	stats := performances[keeper.ValAddrs[0].String()]
+	require.Equal(t, uint64(1), stats.PeriodsVoted)
+	require.Equal(t, uint64(1), stats.WinCount)
+	require.Equal(t, uint64(0), stats.MissCount)
+	require.Equal(t, uint64(1), stats.DeviationCount)
+	require.Equal(t, sdk.ZeroDec(), stats.DeviationSum)
+
+	stats = performances[keeper.ValAddrs[1].String()]
+	require.Equal(t, uint64(0), stats.WinCount)
+	require.Equal(t, uint64(1), stats.MissCount)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), stats.AverageDeviation())
+
+	// abstaining is neither a win nor a miss
+	stats = performances[keeper.ValAddrs[2].String()]
+	require.Equal(t, uint64(0), stats.WinCount)
+	require.Equal(t, uint64(1), stats.AbstainCount)
+	require.Equal(t, uint64(0), stats.MissCount)
+
+	stats = performances[keeper.ValAddrs[3].String()]
+	require.Equal(t, uint64(1), stats.VotePeriods)
+	require.Equal(t, uint64(0), stats.PeriodsVoted)
+	require.Equal(t, uint64(1), stats.MissCount)
+}
+
 func TestWhitelistSlashing(t *testing.T) {
 	input, h := setup(t)
 
@@ -527,11 +866,109 @@ func TestWhitelistSlashing(t *testing.T) {
 	require.Equal(t, sdk.OneDec().Sub(slashFraction).MulInt(stakingAmt).TruncateInt(), validator.GetBondedTokens())
 }
 
+// setBondHeight sets the height the validator of the given index last bonded
+// at in its signing info
+func setBondHeight(input keeper.TestInput, idx int, height int64) {
+	consAddr := sdk.ConsAddress(keeper.ValPubKeys[idx].Address())
+	input.SlashingKeeper.SetValidatorSigningInfo(input.Ctx, consAddr,
+		slashingtypes.NewValidatorSigningInfo(consAddr, height, 0, time.Unix(0, 0), false, 0))
+}
+
+func TestSlashGracePeriod(t *testing.T) {
+	input, _ := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.SlashGracePeriod = 50
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
+
+	// Account 1 bonded 40 blocks before the end of the window and missed every vote since
+	setBondHeight(input, 0, 59)
+	input.OracleKeeper.SetMissCounter(input.Ctx, keeper.ValAddrs[0], 39)
+
+	ctx := input.Ctx.WithBlockHeight(int64(params.SlashWindow) - 1)
+	require.NoError(t, oracle.EndBlocker(ctx, input.OracleKeeper))
+
+	validator := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	require.Equal(t, stakingAmt, validator.GetBondedTokens())
+	require.False(t, validator.IsJailed())
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(ctx, keeper.ValAddrs[0]))
+
+	// and is penalized once the grace period is over
+	setBondHeight(input, 0, 49)
+	input.OracleKeeper.SetMissCounter(input.Ctx, keeper.ValAddrs[0], 49)
+	require.NoError(t, oracle.EndBlocker(ctx, input.OracleKeeper))
+
+	validator = input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	require.True(t, validator.IsJailed())
+}
+
+func TestSlashProratedWindow(t *testing.T) {
+	input, _ := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.SlashGracePeriod = 0
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
+	slashFraction := input.OracleKeeper.SlashFraction(input.Ctx)
+
+	// Accounts 1 and 2 bonded in the middle of the window, for 50 vote periods
+	setBondHeight(input, 0, 50)
+	setBondHeight(input, 1, 50)
+
+	// 3 valid votes out of 50 pass the 5% threshold, 2 valid votes do not,
+	// even though both would pass it over the 100 vote periods of the window
+	input.OracleKeeper.SetMissCounter(input.Ctx, keeper.ValAddrs[0], 46)
+	input.OracleKeeper.SetMissCounter(input.Ctx, keeper.ValAddrs[1], 47)
+
+	ctx := input.Ctx.WithBlockHeight(int64(params.SlashWindow) - 1)
+	require.NoError(t, oracle.EndBlocker(ctx, input.OracleKeeper))
+
+	validator := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	require.Equal(t, stakingAmt, validator.GetBondedTokens())
+	require.False(t, validator.IsJailed())
+
+	validator = input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1])
+	require.Equal(t, sdk.OneDec().Sub(slashFraction).MulInt(stakingAmt).TruncateInt(), validator.GetBondedTokens())
+	require.True(t, validator.IsJailed())
+
+	// Account 3 bonded before the window and is judged on the whole window
+	validator = input.StakingKeeper.Validator(ctx, keeper.ValAddrs[2])
+	require.Equal(t, stakingAmt, validator.GetBondedTokens())
+}
+
+func TestSlashWarningOnly(t *testing.T) {
+	input, _ := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.SlashWarningOnly = true
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
+
+	// Account 1 missed every vote of the window
+	input.OracleKeeper.SetMissCounter(input.Ctx, keeper.ValAddrs[0], params.SlashWindow-1)
+
+	ctx := input.Ctx.WithBlockHeight(int64(params.SlashWindow) - 1).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, oracle.EndBlocker(ctx, input.OracleKeeper))
+
+	validator := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	require.Equal(t, stakingAmt, validator.GetBondedTokens())
+	require.False(t, validator.IsJailed())
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(ctx, keeper.ValAddrs[0]))
+
+	var warned []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeSlashWarning {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyOperator {
+				warned = append(warned, string(attr.Value))
+			}
+		}
+	}
+	require.Equal(t, []string{keeper.ValAddrs[0].String()}, warned)
+}
+
 func TestNotPassedBallotSlashing(t *testing.T) {
 	input, h := setup(t)
 	params := input.OracleKeeper.GetParams(input.Ctx)
 	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
-	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
 
 	input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
 
@@ -548,7 +985,7 @@ func TestAbstainSlashing(t *testing.T) {
 	input, h := setup(t)
 	params := input.OracleKeeper.GetParams(input.Ctx)
 	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
-	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
 
 	votePeriodsPerWindow := sdk.NewDec(int64(input.OracleKeeper.SlashWindow(input.Ctx))).QuoInt64(int64(input.OracleKeeper.VotePeriod(input.Ctx))).TruncateInt64()
 	minValidPerWindow := input.OracleKeeper.MinValidPerWindow(input.Ctx)
@@ -577,7 +1014,7 @@ func TestVoteTargets(t *testing.T) {
 	input, h := setup(t)
 	params := input.OracleKeeper.GetParams(input.Ctx)
 	params.Whitelist = types.DenomList{{Name: types.TestDenomC}, {Name: types.TestDenomD}}
-	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
 
 	// DenomC
 	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 0)
@@ -593,7 +1030,7 @@ func TestVoteTargets(t *testing.T) {
 
 	// delete DenomD
 	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
-	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
 
 	// DenomC, missing
 	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{}, 0)
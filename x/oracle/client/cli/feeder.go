@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"sidechain/x/oracle/client/feeder"
+)
+
+// GetCmdFeeder runs the price feeder of a validator
+func GetCmdFeeder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeder [config-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Run a price feeder submitting the oracle prevotes and votes of a validator",
+		Long: strings.TrimSpace(`
+Run a price feeder fetching the exchange rates of the configured denoms from its
+providers, and submitting the aggregate prevote and vote of a validator at every
+vote period. The transactions are signed by the --from key, which must be the
+validator or the feeder delegated with "sidechaind tx oracle set-feeder".
+
+The median of the prices of the providers is voted for each denom. Providers are
+static, with the rates set in the configuration, file, reading a JSON object of
+denom to rate, or http, fetching such an object from an URL.
+
+$ sidechaind oracle feeder feeder.toml --from feeder
+
+validator     = "sidevaloper1..."
+denoms        = ["ATOM", "USDT"]
+poll_interval = "2s"
+
+[[providers]]
+name = "fixed"
+type = "static"
+[providers.rates]
+USDT = "1.0"
+
+[[providers]]
+name = "prices"
+type = "file"
+path = "prices.json"
+
+[[providers]]
+name    = "mock"
+type    = "http"
+url     = "http://localhost:8080/prices"
+timeout = "5s"
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := feeder.ReadConfig(args[0])
+			if err != nil {
+				return err
+			}
+
+			dir := filepath.Dir(args[0])
+			providers := make([]feeder.Provider, len(cfg.Providers))
+			for i, providerCfg := range cfg.Providers {
+				providers[i], err = feeder.NewProvider(providerCfg, dir)
+				if err != nil {
+					return err
+				}
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			logger := log.NewTMLogger(log.NewSyncWriter(cmd.OutOrStdout()))
+
+			f, err := feeder.NewFeeder(clientCtx, txf, cfg, providers, logger)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			return f.Run(ctx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -13,6 +15,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// FlagLookback is the lookback window of the historic price queries
+const FlagLookback = "lookback"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	oracleQueryCmd := &cobra.Command{
@@ -25,12 +30,18 @@ func GetQueryCmd() *cobra.Command {
 
 	oracleQueryCmd.AddCommand(
 		GetCmdQueryExchangeRates(),
+		GetCmdQueryCrossExchangeRate(),
 		GetCmdQueryActives(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeederDelegation(),
 		GetCmdQueryMissCounter(),
+		GetCmdQueryValidatorPerformance(),
 		GetCmdQueryAggregatePrevote(),
 		GetCmdQueryAggregateVote(),
+		GetCmdQueryHistoricPrices(),
+		GetCmdQueryTwapPrice(),
+		GetCmdQueryMedianPrice(),
+		GetCmdQueryMinMaxPrice(),
 	)
 
 	return oracleQueryCmd
@@ -85,6 +96,41 @@ $ sidechaind query oracle exchange-rates KUJI
 	return cmd
 }
 
+// GetCmdQueryCrossExchangeRate implements the query cross rate command.
+func GetCmdQueryCrossExchangeRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross-exchange-rate [base] [quote]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the current exchange rate of an asset in another asset",
+		Long: strings.TrimSpace(`
+Query the current price of one base asset in the quote asset, derived from
+their exchange rates in the quote denom of the oracle params.
+
+$ sidechaind query oracle cross-exchange-rate ATOM KUJI
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CrossExchangeRate(
+				context.Background(),
+				&types.QueryCrossExchangeRateRequest{Base: args[0], Quote: args[1]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryActives implements the query actives command.
 func GetCmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -182,6 +228,48 @@ $ sidechaind query oracle feeder sidevaloper...
 	return cmd
 }
 
+// GetCmdQueryValidatorPerformance implements the query voting performance of
+// the validator command
+func GetCmdQueryValidatorPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "performance [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the voting performance of a validator",
+		Long: strings.TrimSpace(`
+Query the lifetime and rolling window voting performance of a validator: vote
+periods voted, ballots won and abstained, vote periods missed and the average
+deviation of its votes from the tallied exchange rates.
+
+$ sidechaind query oracle performance sidevaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorPerformance(
+				context.Background(),
+				&types.QueryValidatorPerformanceRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryMissCounter implements the query miss counter of the validator command
 func GetCmdQueryMissCounter() *cobra.Command {
 	cmd := &cobra.Command{
@@ -333,3 +421,180 @@ $ sidechaind query oracle aggregate-votes sidevaloper...
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryHistoricPrices implements the query historic prices command.
+func GetCmdQueryHistoricPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historic-prices [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the historic price stamps of an asset",
+		Long: strings.TrimSpace(`
+Query the historic price stamps of an asset, oldest first. Every kept stamp
+is returned unless a lookback window is given.
+
+$ sidechaind query oracle historic-prices KUJI --lookback 1h
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lookback, err := lookbackSeconds(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HistoricPrices(
+				context.Background(),
+				&types.QueryHistoricPricesRequest{Denom: args[0], LookbackSeconds: lookback},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(FlagLookback, 0, "lookback window of the price stamps")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTwapPrice implements the query TWAP command.
+func GetCmdQueryTwapPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the time-weighted average exchange rate of an asset",
+		Long: strings.TrimSpace(`
+Query the time-weighted average exchange rate of an asset over its historic
+price stamps. Every kept stamp is used unless a lookback window is given.
+
+$ sidechaind query oracle twap KUJI --lookback 1h
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lookback, err := lookbackSeconds(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TwapPrice(
+				context.Background(),
+				&types.QueryTwapPriceRequest{Denom: args[0], LookbackSeconds: lookback},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(FlagLookback, 0, "lookback window of the price stamps")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryMedianPrice implements the query median price command.
+func GetCmdQueryMedianPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "median-price [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the median exchange rate of an asset",
+		Long: strings.TrimSpace(`
+Query the median exchange rate of an asset over its historic price stamps.
+Every kept stamp is used unless a lookback window is given.
+
+$ sidechaind query oracle median-price KUJI --lookback 1h
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lookback, err := lookbackSeconds(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MedianPrice(
+				context.Background(),
+				&types.QueryMedianPriceRequest{Denom: args[0], LookbackSeconds: lookback},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(FlagLookback, 0, "lookback window of the price stamps")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryMinMaxPrice implements the query min max price command.
+func GetCmdQueryMinMaxPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-max-price [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the lowest and highest exchange rates of an asset",
+		Long: strings.TrimSpace(`
+Query the lowest and highest exchange rates of an asset over its historic
+price stamps. Every kept stamp is used unless a lookback window is given.
+
+$ sidechaind query oracle min-max-price KUJI --lookback 1h
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lookback, err := lookbackSeconds(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MinMaxPrice(
+				context.Background(),
+				&types.QueryMinMaxPriceRequest{Denom: args[0], LookbackSeconds: lookback},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(FlagLookback, 0, "lookback window of the price stamps")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// lookbackSeconds returns the lookback window flag in seconds
+func lookbackSeconds(cmd *cobra.Command) (uint64, error) {
+	lookback, err := cmd.Flags().GetDuration(FlagLookback)
+	if err != nil {
+		return 0, err
+	}
+	if lookback < 0 {
+		return 0, fmt.Errorf("lookback window cannot be negative: %s", lookback)
+	}
+
+	return uint64(lookback / time.Second), nil
+}
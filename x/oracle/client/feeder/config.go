@@ -0,0 +1,147 @@
+package feeder
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	toml "github.com/pelletier/go-toml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Provider types
+const (
+	ProviderTypeStatic = "static"
+	ProviderTypeFile   = "file"
+	ProviderTypeHTTP   = "http"
+)
+
+// Default configuration values
+const (
+	DefaultPollInterval    = 2 * time.Second
+	DefaultProviderTimeout = 5 * time.Second
+)
+
+// Config is the configuration of the price feeder
+type Config struct {
+	// Validator is the operator address of the validator the feeder votes for
+	Validator string `toml:"validator"`
+	// Denoms are the denoms the exchange rates are voted for
+	Denoms []string `toml:"denoms"`
+	// PollInterval is the interval the feeder polls the latest block at
+	PollInterval time.Duration `toml:"poll_interval"`
+	// Providers are the price providers, the median of their prices is voted
+	Providers []ProviderConfig `toml:"providers"`
+}
+
+// ProviderConfig is the configuration of a price provider
+type ProviderConfig struct {
+	// Name identifies the provider in the logs
+	Name string `toml:"name"`
+	// Type is one of static, file or http
+	Type string `toml:"type"`
+	// Rates are the exchange rates per denom returned by a static provider
+	Rates map[string]string `toml:"rates"`
+	// Path is the JSON file read by a file provider
+	Path string `toml:"path"`
+	// URL is the JSON endpoint fetched by an http provider
+	URL string `toml:"url"`
+	// Timeout is the timeout of the requests of an http provider
+	Timeout time.Duration `toml:"timeout"`
+}
+
+// ReadConfig reads and validates the TOML configuration file at path
+func ReadConfig(path string) (Config, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	return ParseConfig(bz)
+}
+
+// ParseConfig parses and validates a TOML configuration
+func ParseConfig(bz []byte) (Config, error) {
+	var cfg Config
+	if err := toml.Unmarshal(bz, &cfg); err != nil {
+		return Config{}, err
+	}
+
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	for i := range cfg.Providers {
+		if cfg.Providers[i].Name == "" {
+			cfg.Providers[i].Name = cfg.Providers[i].Type
+		}
+		if cfg.Providers[i].Type == ProviderTypeHTTP && cfg.Providers[i].Timeout == 0 {
+			cfg.Providers[i].Timeout = DefaultProviderTimeout
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// Validate performs a basic validation of the configuration
+func (cfg Config) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(cfg.Validator); err != nil {
+		return fmt.Errorf("invalid validator address %s: %w", cfg.Validator, err)
+	}
+
+	if len(cfg.Denoms) == 0 {
+		return fmt.Errorf("no denom to vote for")
+	}
+	denoms := make(map[string]bool, len(cfg.Denoms))
+	for _, denom := range cfg.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if denoms[denom] {
+			return fmt.Errorf("duplicated denom %s", denom)
+		}
+		denoms[denom] = true
+	}
+
+	if cfg.PollInterval < 0 {
+		return fmt.Errorf("poll interval must not be negative: %s", cfg.PollInterval)
+	}
+
+	if len(cfg.Providers) == 0 {
+		return fmt.Errorf("no price provider")
+	}
+	for _, provider := range cfg.Providers {
+		if err := provider.Validate(); err != nil {
+			return fmt.Errorf("invalid provider %s: %w", provider.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the provider configuration
+func (cfg ProviderConfig) Validate() error {
+	switch cfg.Type {
+	case ProviderTypeStatic:
+		for denom, rate := range cfg.Rates {
+			if _, err := sdk.NewDecFromStr(rate); err != nil {
+				return fmt.Errorf("invalid rate %s of %s: %w", rate, denom, err)
+			}
+		}
+	case ProviderTypeFile:
+		if cfg.Path == "" {
+			return fmt.Errorf("path is required by file providers")
+		}
+	case ProviderTypeHTTP:
+		if cfg.URL == "" {
+			return fmt.Errorf("url is required by http providers")
+		}
+		if cfg.Timeout < 0 {
+			return fmt.Errorf("timeout must not be negative: %s", cfg.Timeout)
+		}
+	default:
+		return fmt.Errorf("unknown provider type %q", cfg.Type)
+	}
+
+	return nil
+}
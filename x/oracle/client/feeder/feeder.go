@@ -0,0 +1,281 @@
+package feeder
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sidechain/x/oracle/types"
+)
+
+// prevote is an aggregate prevote submitted by the feeder, revealed by the
+// vote of the next vote period
+type prevote struct {
+	salt          string
+	exchangeRates string
+	hash          types.AggregateVoteHash
+	period        uint64
+}
+
+// Feeder submits the aggregate prevotes and votes of a validator once per vote
+// period. At each vote period it reveals the prevote of the previous period
+// and prevotes the median of the prices fetched from its providers, both in
+// the same transaction signed by the feeder key of the client context.
+type Feeder struct {
+	clientCtx client.Context
+	txf       tx.Factory
+	cfg       Config
+	providers []Provider
+	validator sdk.ValAddress
+	logger    log.Logger
+
+	// period is the last vote period a transaction was submitted in
+	period  uint64
+	prevote *prevote
+}
+
+// NewFeeder returns a Feeder of the given configuration
+func NewFeeder(clientCtx client.Context, txf tx.Factory, cfg Config, providers []Provider, logger log.Logger) (*Feeder, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	validator, err := sdk.ValAddressFromBech32(cfg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Feeder{
+		clientCtx: clientCtx,
+		txf:       txf,
+		cfg:       cfg,
+		providers: providers,
+		validator: validator,
+		logger:    logger,
+	}, nil
+}
+
+// Run polls the latest block and submits the prevotes and votes until the
+// context is done
+func (f *Feeder) Run(ctx context.Context) error {
+	ticker := time.NewTicker(f.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := f.Tick(ctx); err != nil {
+			f.logger.Error("failed to feed prices", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick submits the prevote and the vote of the current vote period if they
+// have not been submitted yet
+func (f *Feeder) Tick(ctx context.Context) error {
+	status, err := f.clientCtx.Client.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	queryClient := types.NewQueryClient(f.clientCtx)
+	paramsRes, err := queryClient.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	// the transaction is included in the next block at the earliest
+	height := uint64(status.SyncInfo.LatestBlockHeight) + 1
+	period := height / paramsRes.Params.VotePeriod
+	if f.prevote != nil && period <= f.period {
+		return nil
+	}
+
+	msgs, next, err := f.voteMsgs(ctx, queryClient, period)
+	if err != nil {
+		return err
+	}
+
+	if err := f.broadcast(msgs...); err != nil {
+		return err
+	}
+
+	f.period = period
+	f.prevote = next
+	f.logger.Info("submitted oracle votes", "period", period, "msgs", len(msgs), "exchange_rates", next.exchangeRates)
+
+	return nil
+}
+
+// voteMsgs returns the vote revealing the prevote of the previous period, if
+// it is still on chain, and the prevote of the current period
+func (f *Feeder) voteMsgs(ctx context.Context, queryClient types.QueryClient, period uint64) ([]sdk.Msg, *prevote, error) {
+	feeder := f.clientCtx.GetFromAddress()
+
+	var msgs []sdk.Msg
+	if f.prevote != nil && f.prevote.period+1 == period {
+		res, err := queryClient.AggregatePrevote(ctx, &types.QueryAggregatePrevoteRequest{ValidatorAddr: f.validator.String()})
+		if err == nil && res.AggregatePrevote.Hash == f.prevote.hash.String() {
+			msgs = append(msgs, types.NewMsgAggregateExchangeRateVote(f.prevote.salt, f.prevote.exchangeRates, feeder, f.validator))
+		}
+	}
+
+	rates, err := f.FetchPrices(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next, err := newPrevote(rates, f.validator, period)
+	if err != nil {
+		return nil, nil, err
+	}
+	msgs = append(msgs, types.NewMsgAggregateExchangeRatePrevote(next.hash, feeder, f.validator))
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return msgs, next, nil
+}
+
+// FetchPrices returns the median of the prices of each denom fetched from the
+// providers. Providers failing to return prices are skipped.
+func (f *Feeder) FetchPrices(ctx context.Context) (map[string]sdk.Dec, error) {
+	prices := make(map[string][]sdk.Dec)
+	for _, provider := range f.providers {
+		rates, err := provider.GetPrices(ctx, f.cfg.Denoms)
+		if err != nil {
+			f.logger.Error("failed to fetch prices", "provider", provider.Name(), "err", err)
+			continue
+		}
+		for denom, rate := range rates {
+			prices[denom] = append(prices[denom], rate)
+		}
+	}
+
+	rates := make(map[string]sdk.Dec, len(prices))
+	for _, denom := range f.cfg.Denoms {
+		if len(prices[denom]) == 0 {
+			f.logger.Error("no price to vote", "denom", denom)
+			continue
+		}
+		rates[denom] = median(prices[denom])
+	}
+
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("no price fetched for %s", strings.Join(f.cfg.Denoms, ","))
+	}
+
+	return rates, nil
+}
+
+// broadcast signs and broadcasts a transaction of the messages, failing if it
+// is rejected by the node
+func (f *Feeder) broadcast(msgs ...sdk.Msg) error {
+	txf, err := f.txf.Prepare(f.clientCtx)
+	if err != nil {
+		return err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(f.clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Sign(txf, f.clientCtx.GetFromName(), txBuilder, true); err != nil {
+		return err
+	}
+
+	txBytes, err := f.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := f.clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		// fetch the account sequence again at the next transaction
+		f.txf = f.txf.WithSequence(0)
+		return err
+	}
+	if res.Code != 0 {
+		f.txf = f.txf.WithSequence(0)
+		return fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	f.txf = f.txf.WithAccountNumber(txf.AccountNumber()).WithSequence(txf.Sequence() + 1)
+	return nil
+}
+
+// newPrevote returns a prevote of the rates with a random salt
+func newPrevote(rates map[string]sdk.Dec, validator sdk.ValAddress, period uint64) (*prevote, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	p := &prevote{
+		salt:          hex.EncodeToString(salt),
+		exchangeRates: FormatExchangeRates(rates),
+		period:        period,
+	}
+	p.hash = types.GetAggregateVoteHash(p.salt, p.exchangeRates, validator)
+
+	return p, nil
+}
+
+// FormatExchangeRates formats the rates as the exchange rates of an aggregate
+// vote, sorted by denom
+func FormatExchangeRates(rates map[string]sdk.Dec) string {
+	denoms := make([]string, 0, len(rates))
+	for denom := range rates {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	tuples := make([]string, len(denoms))
+	for i, denom := range denoms {
+		tuples[i] = sdk.NewDecCoinFromDec(denom, rates[denom]).String()
+	}
+
+	return strings.Join(tuples, ",")
+}
+
+// median returns the median of the rates
+func median(rates []sdk.Dec) sdk.Dec {
+	sorted := make([]sdk.Dec, len(rates))
+	copy(sorted, rates)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return sorted[mid-1].Add(sorted[mid]).QuoInt64(2)
+	}
+
+	return sorted[mid]
+}
@@ -0,0 +1,185 @@
+package feeder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sidechain/x/oracle/types"
+)
+
+var testValidator = sdk.ValAddress([]byte("validator___________"))
+
+type failingProvider struct{}
+
+func (failingProvider) Name() string { return "failing" }
+
+func (failingProvider) GetPrices(context.Context, []string) (map[string]sdk.Dec, error) {
+	return nil, errors.New("unavailable")
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := ParseConfig([]byte(fmt.Sprintf(`
+validator = "%s"
+denoms    = ["ATOM", "USDT"]
+
+[[providers]]
+type = "static"
+[providers.rates]
+ATOM = "10.5"
+
+[[providers]]
+name = "mock"
+type = "http"
+url  = "http://localhost:8080/prices"
+`, testValidator)))
+	require.NoError(t, err)
+	require.Equal(t, []string{"ATOM", "USDT"}, cfg.Denoms)
+	require.Equal(t, DefaultPollInterval, cfg.PollInterval)
+	require.Len(t, cfg.Providers, 2)
+	require.Equal(t, ProviderTypeStatic, cfg.Providers[0].Name)
+	require.Equal(t, map[string]string{"ATOM": "10.5"}, cfg.Providers[0].Rates)
+	require.Equal(t, DefaultProviderTimeout, cfg.Providers[1].Timeout)
+
+	testCases := []struct {
+		name   string
+		config string
+	}{
+		{"invalid validator", `validator = "side1"`},
+		{"no denom", fmt.Sprintf(`validator = "%s"`, testValidator)},
+		{"duplicated denom", fmt.Sprintf(`
+validator = "%s"
+denoms    = ["ATOM", "ATOM"]
+[[providers]]
+type = "static"`, testValidator)},
+		{"no provider", fmt.Sprintf(`
+validator = "%s"
+denoms    = ["ATOM"]`, testValidator)},
+		{"unknown provider type", fmt.Sprintf(`
+validator = "%s"
+denoms    = ["ATOM"]
+[[providers]]
+type = "exchange"`, testValidator)},
+		{"file provider without path", fmt.Sprintf(`
+validator = "%s"
+denoms    = ["ATOM"]
+[[providers]]
+type = "file"`, testValidator)},
+		{"invalid static rate", fmt.Sprintf(`
+validator = "%s"
+denoms    = ["ATOM"]
+[[providers]]
+type = "static"
+[providers.rates]
+ATOM = "ten"`, testValidator)},
+	}
+
+	for _, tc := range testCases {
+		_, err := ParseConfig([]byte(tc.config))
+		require.Error(t, err, tc.name)
+	}
+}
+
+func TestProviders(t *testing.T) {
+	denoms := []string{"ATOM", "USDT"}
+	expected := map[string]sdk.Dec{"ATOM": sdk.NewDecWithPrec(105, 1)}
+
+	static, err := NewProvider(ProviderConfig{Name: "static", Type: ProviderTypeStatic, Rates: map[string]string{"ATOM": "10.5", "BTC": "30000"}}, "")
+	require.NoError(t, err)
+	rates, err := static.GetPrices(context.Background(), denoms)
+	require.NoError(t, err)
+	require.Equal(t, expected, rates)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "prices.json"), []byte(`{"ATOM": "10.5", "BTC": 30000}`), 0o600))
+	file, err := NewProvider(ProviderConfig{Name: "file", Type: ProviderTypeFile, Path: "prices.json"}, dir)
+	require.NoError(t, err)
+	rates, err = file.GetPrices(context.Background(), denoms)
+	require.NoError(t, err)
+	require.Equal(t, expected, rates)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/prices" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ATOM": 10.5, "BTC": "30000"}`))
+	}))
+	defer server.Close()
+
+	httpProvider, err := NewProvider(ProviderConfig{Name: "http", Type: ProviderTypeHTTP, URL: server.URL + "/prices", Timeout: DefaultProviderTimeout}, "")
+	require.NoError(t, err)
+	rates, err = httpProvider.GetPrices(context.Background(), denoms)
+	require.NoError(t, err)
+	require.Equal(t, expected, rates)
+
+	httpProvider, err = NewProvider(ProviderConfig{Name: "http", Type: ProviderTypeHTTP, URL: server.URL + "/missing", Timeout: DefaultProviderTimeout}, "")
+	require.NoError(t, err)
+	_, err = httpProvider.GetPrices(context.Background(), denoms)
+	require.Error(t, err)
+}
+
+func TestFetchPrices(t *testing.T) {
+	cfg := Config{
+		Validator:    testValidator.String(),
+		Denoms:       []string{"ATOM", "USDT", "BTC"},
+		PollInterval: DefaultPollInterval,
+		Providers:    []ProviderConfig{{Type: ProviderTypeStatic}},
+	}
+	providers := []Provider{
+		NewStaticProvider("a", map[string]sdk.Dec{"ATOM": sdk.NewDec(10), "USDT": sdk.OneDec()}),
+		NewStaticProvider("b", map[string]sdk.Dec{"ATOM": sdk.NewDec(12)}),
+		NewStaticProvider("c", map[string]sdk.Dec{"ATOM": sdk.NewDec(20)}),
+		failingProvider{},
+	}
+
+	f, err := NewFeeder(client.Context{}, tx.Factory{}, cfg, providers, log.NewNopLogger())
+	require.NoError(t, err)
+
+	rates, err := f.FetchPrices(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdk.Dec{"ATOM": sdk.NewDec(12), "USDT": sdk.OneDec()}, rates)
+
+	f.providers = providers[1:3]
+	rates, err = f.FetchPrices(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(16), rates["ATOM"])
+
+	f.providers = []Provider{failingProvider{}}
+	_, err = f.FetchPrices(context.Background())
+	require.Error(t, err)
+}
+
+func TestNewPrevote(t *testing.T) {
+	rates := map[string]sdk.Dec{"USDT": sdk.OneDec(), "ATOM": sdk.NewDecWithPrec(105, 1)}
+
+	p, err := newPrevote(rates, testValidator, 7)
+	require.NoError(t, err)
+	require.Equal(t, "10.500000000000000000ATOM,1.000000000000000000USDT", p.exchangeRates)
+	require.Equal(t, uint64(7), p.period)
+	require.Equal(t, types.GetAggregateVoteHash(p.salt, p.exchangeRates, testValidator), p.hash)
+
+	feeder := sdk.AccAddress(testValidator)
+	require.NoError(t, types.NewMsgAggregateExchangeRatePrevote(p.hash, feeder, testValidator).ValidateBasic())
+	require.NoError(t, types.NewMsgAggregateExchangeRateVote(p.salt, p.exchangeRates, feeder, testValidator).ValidateBasic())
+
+	tuples, err := types.ParseExchangeRateTuples(p.exchangeRates)
+	require.NoError(t, err)
+	require.Len(t, tuples, 2)
+
+	other, err := newPrevote(rates, testValidator, 7)
+	require.NoError(t, err)
+	require.NotEqual(t, p.salt, other.salt)
+}
@@ -0,0 +1,184 @@
+package feeder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Provider fetches the exchange rates of denoms. Denoms the provider has no
+// price for are left out of the returned rates.
+type Provider interface {
+	Name() string
+	GetPrices(ctx context.Context, denoms []string) (map[string]sdk.Dec, error)
+}
+
+var (
+	_ Provider = StaticProvider{}
+	_ Provider = FileProvider{}
+	_ Provider = HTTPProvider{}
+)
+
+// NewProvider returns the provider of the given configuration. Relative file
+// paths are resolved from dir.
+func NewProvider(cfg ProviderConfig, dir string) (Provider, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch cfg.Type {
+	case ProviderTypeStatic:
+		rates, err := parseRates(cfg.Rates)
+		if err != nil {
+			return nil, err
+		}
+		return StaticProvider{name: cfg.Name, rates: rates}, nil
+	case ProviderTypeFile:
+		path := cfg.Path
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		return FileProvider{name: cfg.Name, path: path}, nil
+	case ProviderTypeHTTP:
+		return HTTPProvider{name: cfg.Name, url: cfg.URL, client: &http.Client{Timeout: cfg.Timeout}}, nil
+	default:
+		return nil, fmt.Errorf("unknown provider type %q", cfg.Type)
+	}
+}
+
+// StaticProvider returns the exchange rates set in its configuration
+type StaticProvider struct {
+	name  string
+	rates map[string]sdk.Dec
+}
+
+// NewStaticProvider returns a StaticProvider of the given rates
+func NewStaticProvider(name string, rates map[string]sdk.Dec) StaticProvider {
+	return StaticProvider{name: name, rates: rates}
+}
+
+// Name implements Provider
+func (p StaticProvider) Name() string { return p.name }
+
+// GetPrices implements Provider
+func (p StaticProvider) GetPrices(_ context.Context, denoms []string) (map[string]sdk.Dec, error) {
+	return filterRates(p.rates, denoms), nil
+}
+
+// FileProvider reads the exchange rates from a JSON object of denom to rate,
+// e.g. {"ATOM": "10.5"}. The file is read again on every fetch, so it can be
+// updated while the feeder is running.
+type FileProvider struct {
+	name string
+	path string
+}
+
+// Name implements Provider
+func (p FileProvider) Name() string { return p.name }
+
+// GetPrices implements Provider
+func (p FileProvider) GetPrices(_ context.Context, denoms []string) (map[string]sdk.Dec, error) {
+	bz, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := decodeRates(bz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prices file %s: %w", p.path, err)
+	}
+
+	return filterRates(rates, denoms), nil
+}
+
+// HTTPProvider fetches the exchange rates from an endpoint returning a JSON
+// object of denom to rate, e.g. {"ATOM": "10.5"}
+type HTTPProvider struct {
+	name   string
+	url    string
+	client *http.Client
+}
+
+// Name implements Provider
+func (p HTTPProvider) Name() string { return p.name }
+
+// GetPrices implements Provider
+func (p HTTPProvider) GetPrices(ctx context.Context, denoms []string) (map[string]sdk.Dec, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s from %s", resp.Status, p.url)
+	}
+
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := decodeRates(bz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response from %s: %w", p.url, err)
+	}
+
+	return filterRates(rates, denoms), nil
+}
+
+// decodeRates decodes a JSON object of denom to rate, the rates being either
+// strings or numbers
+func decodeRates(bz []byte) (map[string]sdk.Dec, error) {
+	var raw map[string]json.Number
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, err
+	}
+
+	rates := make(map[string]string, len(raw))
+	for denom, rate := range raw {
+		rates[denom] = rate.String()
+	}
+
+	return parseRates(rates)
+}
+
+// parseRates parses the rates per denom
+func parseRates(raw map[string]string) (map[string]sdk.Dec, error) {
+	rates := make(map[string]sdk.Dec, len(raw))
+	for denom, rate := range raw {
+		dec, err := sdk.NewDecFromStr(rate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate %s of %s: %w", rate, denom, err)
+		}
+		if !dec.IsPositive() {
+			return nil, fmt.Errorf("rate of %s must be positive: %s", denom, rate)
+		}
+		rates[denom] = dec
+	}
+
+	return rates, nil
+}
+
+// filterRates returns the rates of the given denoms
+func filterRates(rates map[string]sdk.Dec, denoms []string) map[string]sdk.Dec {
+	filtered := make(map[string]sdk.Dec, len(denoms))
+	for _, denom := range denoms {
+		if rate, ok := rates[denom]; ok {
+			filtered[denom] = rate
+		}
+	}
+
+	return filtered
+}
@@ -27,7 +27,7 @@ func setupWithSmallVotingPower(t *testing.T) (keeper.TestInput, types.MsgServer)
 	params.VotePeriod = 1
 	params.SlashWindow = 100
 	params.RewardDistributionWindow = 100
-	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
 	h := keeper.NewMsgServerImpl(input.OracleKeeper)
 
 	sh := stakingkeeper.NewMsgServerImpl(input.StakingKeeper)
@@ -51,7 +51,7 @@ func setup(t *testing.T) (keeper.TestInput, types.MsgServer) {
 	params.SlashWindow = 100
 	params.RewardDistributionWindow = 100
 	params.Whitelist = types.DenomList{{Name: types.TestDenomA}, {Name: types.TestDenomC}, {Name: types.TestDenomD}}
-	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
 	h := keeper.NewMsgServerImpl(input.OracleKeeper)
 
 	sh := stakingkeeper.NewMsgServerImpl(input.StakingKeeper)
@@ -74,7 +74,7 @@ func setupVal5(t *testing.T) (keeper.TestInput, types.MsgServer) {
 	params.VotePeriod = 1
 	params.SlashWindow = 100
 	params.RewardDistributionWindow = 100
-	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
 	h := keeper.NewMsgServerImpl(input.OracleKeeper)
 
 	sh := stakingkeeper.NewMsgServerImpl(input.StakingKeeper)
@@ -26,6 +26,7 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetFeederDelegation(ctx, voter, feeder)
 	}
 
+	// the exchange rates are imported as updated at the genesis block
 	for _, ex := range data.ExchangeRates {
 		keeper.SetExchangeRate(ctx, ex.Denom, ex.ExchangeRate)
 	}
@@ -57,7 +58,35 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetAggregateExchangeRateVote(ctx, valAddr, av)
 	}
 
-	keeper.SetParams(ctx, data.Params)
+	for _, stamp := range data.HistoricPrices {
+		keeper.SetHistoricPrice(ctx, stamp)
+	}
+
+	for _, halt := range data.PriceHalts {
+		keeper.SetPriceHalt(ctx, halt)
+	}
+
+	for _, performance := range data.ValidatorPerformances {
+		operator, err := sdk.ValAddressFromBech32(performance.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetValidatorPerformance(ctx, operator, performance.Stats)
+	}
+
+	for _, performance := range data.ValidatorPerformancePeriods {
+		operator, err := sdk.ValAddressFromBech32(performance.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetValidatorPerformancePeriod(ctx, operator, performance)
+	}
+
+	if err := keeper.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
 
 	// check if the module account exists
 	moduleAcc := keeper.GetOracleAccount(ctx)
@@ -107,10 +136,38 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	historicPrices := []types.PriceStamp{}
+	keeper.IterateHistoricPrices(ctx, func(stamp types.PriceStamp) (stop bool) {
+		historicPrices = append(historicPrices, stamp)
+		return false
+	})
+
+	priceHalts := []types.PriceHalt{}
+	keeper.IteratePriceHalts(ctx, func(halt types.PriceHalt) (stop bool) {
+		priceHalts = append(priceHalts, halt)
+		return false
+	})
+
+	validatorPerformances := []types.ValidatorPerformance{}
+	keeper.IterateValidatorPerformances(ctx, func(performance types.ValidatorPerformance) (stop bool) {
+		validatorPerformances = append(validatorPerformances, performance)
+		return false
+	})
+
+	validatorPerformancePeriods := []types.ValidatorPerformance{}
+	keeper.IterateValidatorPerformancePeriods(ctx, func(performance types.ValidatorPerformance) (stop bool) {
+		validatorPerformancePeriods = append(validatorPerformancePeriods, performance)
+		return false
+	})
+
 	return types.NewGenesisState(params,
 		exchangeRates,
 		feederDelegations,
 		missCounters,
 		aggregateExchangeRatePrevotes,
-		aggregateExchangeRateVotes)
+		aggregateExchangeRateVotes,
+		historicPrices,
+		priceHalts,
+		validatorPerformances,
+		validatorPerformancePeriods)
 }
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"sidechain/x/oracle/types"
+)
+
+// SetHistoricPrice stores a historic price stamp of a denom and indexes it by
+// block height
+func (k Keeper) SetHistoricPrice(ctx sdk.Context, stamp types.PriceStamp) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&stamp)
+	store.Set(types.GetHistoricPriceKey(stamp.Denom, stamp.BlockHeight), bz)
+	store.Set(types.GetHistoricPriceHeightKey(stamp.BlockHeight, stamp.Denom), []byte{1})
+}
+
+// IterateHistoricPrices iterates over the historic price stamps of every
+// denom, by denom and oldest first
+func (k Keeper) IterateHistoricPrices(ctx sdk.Context, handler func(stamp types.PriceStamp) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.HistoricPriceKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stamp types.PriceStamp
+		k.cdc.MustUnmarshal(iter.Value(), &stamp)
+		if handler(stamp) {
+			break
+		}
+	}
+}
+
+// GetHistoricPrices returns the historic price stamps of a denom within the
+// lookback window, oldest first. Every kept price stamp is returned when the
+// lookback is zero.
+func (k Keeper) GetHistoricPrices(ctx sdk.Context, denom string, lookback time.Duration) types.PriceStamps {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStoreReversePrefixIterator(store, types.GetHistoricPriceDenomPrefix(denom))
+	defer iter.Close()
+
+	since := ctx.BlockTime().Add(-lookback)
+
+	var stamps types.PriceStamps
+	for ; iter.Valid(); iter.Next() {
+		var stamp types.PriceStamp
+		k.cdc.MustUnmarshal(iter.Value(), &stamp)
+		if lookback > 0 && stamp.BlockTime.Before(since) {
+			break
+		}
+		stamps = append(stamps, stamp)
+	}
+
+	// reverse the stamps so the oldest come first
+	for i, j := 0, len(stamps)-1; i < j; i, j = i+1, j-1 {
+		stamps[i], stamps[j] = stamps[j], stamps[i]
+	}
+
+	return stamps
+}
+
+// StampHistoricPrices stores a historic price stamp for the current exchange
+// rate of each denom and removes the stamps beyond the maximum price stamps
+func (k Keeper) StampHistoricPrices(ctx sdk.Context, params types.Params) {
+	if params.MaximumPriceStamps > 0 {
+		k.IterateExchangeRates(ctx, func(denom string, exchangeRate sdk.Dec) (stop bool) {
+			k.SetHistoricPrice(ctx, types.NewPriceStamp(denom, exchangeRate, ctx.BlockHeight(), ctx.BlockTime()))
+			return false
+		})
+	}
+
+	window := params.HistoricStampPeriod * params.MaximumPriceStamps
+	k.PruneHistoricPrices(ctx, ctx.BlockHeight()-int64(window))
+}
+
+// PruneHistoricPrices deletes the historic price stamps of every denom stamped
+// at or before the given block height
+func (k Keeper) PruneHistoricPrices(ctx sdk.Context, blockHeight int64) {
+	if blockHeight < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.HistoricPriceHeightKey, types.GetHistoricPriceHeightPrefix(blockHeight+1))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		height := int64(sdk.BigEndianToUint64(key[len(types.HistoricPriceHeightKey) : len(types.HistoricPriceHeightKey)+8]))
+		denom := string(key[len(types.HistoricPriceHeightKey)+8+1:])
+		keys = append(keys, key, types.GetHistoricPriceKey(denom, height))
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// DeleteHistoricPrices deletes every historic price stamp of a denom
+func (k Keeper) DeleteHistoricPrices(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetHistoricPriceDenomPrefix(denom))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		var stamp types.PriceStamp
+		k.cdc.MustUnmarshal(iter.Value(), &stamp)
+		keys = append(keys, iter.Key(), types.GetHistoricPriceHeightKey(stamp.BlockHeight, denom))
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetTWAPExchangeRate returns the time-weighted average exchange rate of a
+// denom over the lookback window
+func (k Keeper) GetTWAPExchangeRate(ctx sdk.Context, denom string, lookback time.Duration) (sdk.Dec, error) {
+	stamps, err := k.getHistoricPrices(ctx, denom, lookback)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return stamps.TWAP(ctx.BlockTime())
+}
+
+// GetMedianExchangeRate returns the median exchange rate of a denom over the
+// lookback window
+func (k Keeper) GetMedianExchangeRate(ctx sdk.Context, denom string, lookback time.Duration) (sdk.Dec, error) {
+	stamps, err := k.getHistoricPrices(ctx, denom, lookback)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return stamps.Median()
+}
+
+// GetMinMaxExchangeRate returns the lowest and the highest exchange rates of a
+// denom over the lookback window
+func (k Keeper) GetMinMaxExchangeRate(ctx sdk.Context, denom string, lookback time.Duration) (sdk.Dec, sdk.Dec, error) {
+	stamps, err := k.getHistoricPrices(ctx, denom, lookback)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+
+	return stamps.MinMax()
+}
+
+// getHistoricPrices returns the historic price stamps of a denom within the
+// lookback window, or an error when there is none
+func (k Keeper) getHistoricPrices(ctx sdk.Context, denom string, lookback time.Duration) (types.PriceStamps, error) {
+	stamps := k.GetHistoricPrices(ctx, denom, lookback)
+	if len(stamps) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrNoHistoricPrice, denom)
+	}
+
+	return stamps, nil
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sidechain/x/oracle/types"
+)
+
+// stampPrices stores one price stamp per minute for the given denom, the last
+// one at the current block
+func stampPrices(input TestInput, denom string, rates ...int64) {
+	now := input.Ctx.BlockTime()
+	for i, rate := range rates {
+		age := time.Duration(len(rates)-1-i) * time.Minute
+		input.OracleKeeper.SetHistoricPrice(input.Ctx, types.NewPriceStamp(denom, sdk.NewDec(rate), int64(i+1), now.Add(-age)))
+	}
+}
+
+func TestGetHistoricPrices(t *testing.T) {
+	input := CreateTestInput(t)
+
+	stampPrices(input, types.TestDenomA, 10, 20, 30, 40)
+	stampPrices(input, types.TestDenomB, 1, 2)
+
+	stamps := input.OracleKeeper.GetHistoricPrices(input.Ctx, types.TestDenomA, 0)
+	require.Len(t, stamps, 4)
+	for i, stamp := range stamps {
+		require.Equal(t, types.TestDenomA, stamp.Denom)
+		require.Equal(t, int64(i+1), stamp.BlockHeight)
+	}
+
+	// the stamps of the last two minutes
+	stamps = input.OracleKeeper.GetHistoricPrices(input.Ctx, types.TestDenomA, 2*time.Minute)
+	require.Len(t, stamps, 3)
+	require.Equal(t, sdk.NewDec(20), stamps[0].ExchangeRate)
+
+	stamps = input.OracleKeeper.GetHistoricPrices(input.Ctx, types.TestDenomB, 0)
+	require.Len(t, stamps, 2)
+
+	stamps = input.OracleKeeper.GetHistoricPrices(input.Ctx, types.TestDenomC, 0)
+	require.Empty(t, stamps)
+}
+
+func TestStampHistoricPrices(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.HistoricStampPeriod = 10
+	params.MaximumPriceStamps = 3
+
+	// stamps every period, only the last three are kept
+	for height := int64(10); height <= 50; height += 10 {
+		ctx := input.Ctx.WithBlockHeight(height).WithBlockTime(input.Ctx.BlockTime().Add(time.Duration(height) * time.Second))
+		input.OracleKeeper.SetExchangeRate(ctx, types.TestDenomA, sdk.NewDec(height))
+		input.OracleKeeper.StampHistoricPrices(ctx, params)
+	}
+
+	stamps := input.OracleKeeper.GetHistoricPrices(input.Ctx, types.TestDenomA, 0)
+	require.Len(t, stamps, 3)
+	require.Equal(t, int64(30), stamps[0].BlockHeight)
+	require.Equal(t, int64(50), stamps[2].BlockHeight)
+
+	// the history of the denoms without exchange rate is pruned as well
+	input.OracleKeeper.DeleteExchangeRate(input.Ctx, types.TestDenomA)
+	for height := int64(60); height <= 80; height += 10 {
+		input.OracleKeeper.StampHistoricPrices(input.Ctx.WithBlockHeight(height), params)
+	}
+
+	stamps = input.OracleKeeper.GetHistoricPrices(input.Ctx, types.TestDenomA, 0)
+	require.Empty(t, stamps)
+
+	// no price is stamped without maximum price stamps
+	params.MaximumPriceStamps = 0
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomA, sdk.OneDec())
+	input.OracleKeeper.StampHistoricPrices(input.Ctx.WithBlockHeight(90), params)
+
+	stamps = input.OracleKeeper.GetHistoricPrices(input.Ctx, types.TestDenomA, 0)
+	require.Empty(t, stamps)
+}
+
+func TestDeleteHistoricPrices(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// a denom prefixing another one keeps its own history
+	stampPrices(input, "denom", 1, 2, 3)
+	stampPrices(input, "denomA", 10, 20)
+
+	input.OracleKeeper.DeleteHistoricPrices(input.Ctx, "denom")
+	require.Empty(t, input.OracleKeeper.GetHistoricPrices(input.Ctx, "denom", 0))
+	require.Len(t, input.OracleKeeper.GetHistoricPrices(input.Ctx, "denomA", 0), 2)
+
+	// the height index of the deleted stamps is removed with them
+	input.OracleKeeper.PruneHistoricPrices(input.Ctx, 1)
+	require.Len(t, input.OracleKeeper.GetHistoricPrices(input.Ctx, "denomA", 0), 1)
+
+	store := input.Ctx.KVStore(input.OracleKeeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.HistoricPriceHeightKey)
+	defer iter.Close()
+	var indexed int
+	for ; iter.Valid(); iter.Next() {
+		indexed++
+	}
+	require.Equal(t, 1, indexed)
+}
+
+func TestHistoricExchangeRates(t *testing.T) {
+	input := CreateTestInput(t)
+
+	_, err := input.OracleKeeper.GetTWAPExchangeRate(input.Ctx, types.TestDenomA, 0)
+	require.ErrorIs(t, err, types.ErrNoHistoricPrice)
+	_, err = input.OracleKeeper.GetMedianExchangeRate(input.Ctx, types.TestDenomA, 0)
+	require.ErrorIs(t, err, types.ErrNoHistoricPrice)
+	_, _, err = input.OracleKeeper.GetMinMaxExchangeRate(input.Ctx, types.TestDenomA, 0)
+	require.ErrorIs(t, err, types.ErrNoHistoricPrice)
+
+	stampPrices(input, types.TestDenomA, 40, 10, 20, 30)
+
+	// 40, 10 and 20 for a minute each, 30 until now
+	twap, err := input.OracleKeeper.GetTWAPExchangeRate(input.Ctx, types.TestDenomA, 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(70).QuoInt64(3), twap)
+
+	median, err := input.OracleKeeper.GetMedianExchangeRate(input.Ctx, types.TestDenomA, 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(25), median)
+
+	min, max, err := input.OracleKeeper.GetMinMaxExchangeRate(input.Ctx, types.TestDenomA, 2*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10), min)
+	require.Equal(t, sdk.NewDec(30), max)
+}
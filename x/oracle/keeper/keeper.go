@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -19,9 +20,14 @@ import (
 
 // Keeper of the oracle store
 type Keeper struct {
-	cdc        codec.BinaryCodec
-	storeKey   storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	// paramSpace is the legacy x/params subspace, only used to migrate the
+	// parameters to the module store
 	paramSpace paramstypes.Subspace
+	// the address capable of executing the governance messages. Typically,
+	// this should be the x/gov module account.
+	authority sdk.AccAddress
 
 	accountKeeper  types.AccountKeeper
 	bankKeeper     types.BankKeeper
@@ -35,7 +41,7 @@ type Keeper struct {
 
 // NewKeeper constructs a new keeper for oracle
 func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey,
-	paramspace paramstypes.Subspace, accountKeeper types.AccountKeeper,
+	paramspace paramstypes.Subspace, authority sdk.AccAddress, accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper, distrKeeper types.DistributionKeeper,
 	slashingkeeper types.SlashingKeeper, stakingKeeper types.StakingKeeper, distrName string,
 ) Keeper {
@@ -44,6 +50,11 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey,
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
 	// set KeyTable if it has not already been set
 	if !paramspace.HasKeyTable() {
 		paramspace = paramspace.WithKeyTable(types.ParamKeyTable())
@@ -53,6 +64,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey,
 		cdc:            cdc,
 		storeKey:       storeKey,
 		paramSpace:     paramspace,
+		authority:      authority,
 		accountKeeper:  accountKeeper,
 		bankKeeper:     bankKeeper,
 		distrKeeper:    distrKeeper,
@@ -73,21 +85,93 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // GetExchangeRate gets the consensus exchange rate of the denom asset from the store.
 func (k Keeper) GetExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	exchangeRate, err := k.GetExchangeRateEntry(ctx, denom)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return exchangeRate.Rate, nil
+}
+
+// GetExchangeRateWithAge gets the consensus exchange rate of the denom asset
+// from the store, together with the time elapsed since it was last updated.
+func (k Keeper) GetExchangeRateWithAge(ctx sdk.Context, denom string) (sdk.Dec, time.Duration, error) {
+	exchangeRate, err := k.GetExchangeRateEntry(ctx, denom)
+	if err != nil {
+		return sdk.ZeroDec(), 0, err
+	}
+
+	return exchangeRate.Rate, exchangeRate.Age(ctx.BlockTime()), nil
+}
+
+// GetExchangeRateEntry gets the consensus exchange rate of the denom asset
+// from the store, together with the block height and time of its last update.
+func (k Keeper) GetExchangeRateEntry(ctx sdk.Context, denom string) (types.ExchangeRate, error) {
 	store := ctx.KVStore(k.storeKey)
 	b := store.Get(types.GetExchangeRateKey(denom))
 	if b == nil {
-		return sdk.ZeroDec(), sdkerrors.Wrap(types.ErrUnknownDenom, denom)
+		return types.ExchangeRate{}, sdkerrors.Wrap(types.ErrUnknownDenom, denom)
+	}
+
+	exchangeRate := types.ExchangeRate{}
+	k.cdc.MustUnmarshal(b, &exchangeRate)
+	return exchangeRate, nil
+}
+
+// GetCrossExchangeRate returns the price of one base denom in the quote denom,
+// derived from the exchange rates of both denoms in the quote denom of the
+// params. It fails when either exchange rate is missing or older than the max
+// price age.
+func (k Keeper) GetCrossExchangeRate(ctx sdk.Context, base, quote string) (sdk.Dec, error) {
+	params := k.GetParams(ctx)
+
+	baseRate, err := k.getQuotedExchangeRate(ctx, params, base)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	quoteRate, err := k.getQuotedExchangeRate(ctx, params, quote)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if !quoteRate.IsPositive() {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrInvalidExchangeRate, "%s: %s", quote, quoteRate)
+	}
+
+	return baseRate.Quo(quoteRate), nil
+}
+
+// getQuotedExchangeRate returns the exchange rate of a denom in the quote denom
+// of the params, which is one for the quote denom itself
+func (k Keeper) getQuotedExchangeRate(ctx sdk.Context, params types.Params, denom string) (sdk.Dec, error) {
+	if denom == params.QuoteDenom {
+		return sdk.OneDec(), nil
+	}
+
+	exchangeRate, err := k.GetExchangeRateEntry(ctx, denom)
+	if err != nil {
+		return sdk.ZeroDec(), err
 	}
 
-	dp := sdk.DecProto{}
-	k.cdc.MustUnmarshal(b, &dp)
-	return dp.Dec, nil
+	if params.MaxPriceAge > 0 && exchangeRate.Age(ctx.BlockTime()) > params.MaxPriceAge {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrStaleExchangeRate, "%s was last updated at height %d", denom, exchangeRate.LastUpdateHeight)
+	}
+
+	return exchangeRate.Rate, nil
 }
 
-// SetExchangeRate sets the consensus exchange rate of the denom asset to the store.
+// SetExchangeRate sets the consensus exchange rate of the denom asset to the
+// store, updated at the current block.
 func (k Keeper) SetExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
+	k.SetExchangeRateEntry(ctx, denom, types.NewExchangeRate(exchangeRate, ctx.BlockHeight(), ctx.BlockTime()))
+}
+
+// SetExchangeRateEntry sets the consensus exchange rate of the denom asset to
+// the store, with the block height and time of its last update.
+func (k Keeper) SetExchangeRateEntry(ctx sdk.Context, denom string, exchangeRate types.ExchangeRate) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: exchangeRate})
+	bz := k.cdc.MustMarshal(&exchangeRate)
 	store.Set(types.GetExchangeRateKey(denom), bz)
 }
 
@@ -110,19 +194,99 @@ func (k Keeper) DeleteExchangeRate(ctx sdk.Context, denom string) {
 
 // IterateExchangeRates iterates over luna rates in the store
 func (k Keeper) IterateExchangeRates(ctx sdk.Context, handler func(denom string, exchangeRate sdk.Dec) (stop bool)) {
+	k.IterateExchangeRateEntries(ctx, func(denom string, exchangeRate types.ExchangeRate) (stop bool) {
+		return handler(denom, exchangeRate.Rate)
+	})
+}
+
+// IterateExchangeRateEntries iterates over the exchange rates in the store,
+// together with the block height and time of their last update
+func (k Keeper) IterateExchangeRateEntries(ctx sdk.Context, handler func(denom string, exchangeRate types.ExchangeRate) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 	iter := sdk.KVStorePrefixIterator(store, types.ExchangeRateKey)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		denom := string(iter.Key()[len(types.ExchangeRateKey):])
-		dp := sdk.DecProto{}
-		k.cdc.MustUnmarshal(iter.Value(), &dp)
-		if handler(denom, dp.Dec) {
+		exchangeRate := types.ExchangeRate{}
+		k.cdc.MustUnmarshal(iter.Value(), &exchangeRate)
+		if handler(denom, exchangeRate) {
 			break
 		}
 	}
 }
 
+// PruneExpiredExchangeRates deletes the exchange rates that weren't updated
+// during the last max price age, and the rates of the denoms that are no
+// longer whitelisted. The rates updated at the current block and the rates of
+// the whitelisted denoms halted by the circuit breaker are always kept.
+func (k Keeper) PruneExpiredExchangeRates(ctx sdk.Context, whitelist types.DenomList, maxPriceAge time.Duration) {
+	whitelisted := make(map[string]bool, len(whitelist))
+	for _, denom := range whitelist {
+		whitelisted[denom.Name] = true
+	}
+
+	var expired []string
+	k.IterateExchangeRateEntries(ctx, func(denom string, exchangeRate types.ExchangeRate) (stop bool) {
+		switch {
+		case !whitelisted[denom]:
+			expired = append(expired, denom)
+		case exchangeRate.LastUpdateHeight == ctx.BlockHeight():
+			// updated by the ballot of this vote period
+		case k.IsPriceHalted(ctx, denom):
+			// kept until the halt is cleared
+		case maxPriceAge == 0 || exchangeRate.Age(ctx.BlockTime()) > maxPriceAge:
+			expired = append(expired, denom)
+		}
+		return false
+	})
+
+	for _, denom := range expired {
+		k.DeleteExchangeRate(ctx, denom)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeExchangeRateExpire,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			),
+		)
+	}
+}
+
+// ClearDenom deletes the exchange rate, the historic price stamps and the price
+// halt of a denom, and removes its exchange rates from the pending aggregate votes.
+// Aggregate prevotes only hold a hash of the exchange rates, so they are left
+// untouched and the denom is dropped when they are revealed.
+func (k Keeper) ClearDenom(ctx sdk.Context, denom string) {
+	k.DeleteExchangeRate(ctx, denom)
+	k.DeleteHistoricPrices(ctx, denom)
+	k.DeletePriceHalt(ctx, denom)
+
+	var (
+		voters []sdk.ValAddress
+		votes  []types.AggregateExchangeRateVote
+	)
+	k.IterateAggregateExchangeRateVotes(ctx, func(voterAddr sdk.ValAddress, aggregateVote types.AggregateExchangeRateVote) (stop bool) {
+		var tuples types.ExchangeRateTuples
+		for _, tuple := range aggregateVote.ExchangeRateTuples {
+			if tuple.Denom != denom {
+				tuples = append(tuples, tuple)
+			}
+		}
+		if len(tuples) != len(aggregateVote.ExchangeRateTuples) {
+			aggregateVote.ExchangeRateTuples = tuples
+			voters = append(voters, voterAddr)
+			votes = append(votes, aggregateVote)
+		}
+		return false
+	})
+
+	for i, voterAddr := range voters {
+		if len(votes[i].ExchangeRateTuples) == 0 {
+			k.DeleteAggregateExchangeRateVote(ctx, voterAddr)
+		} else {
+			k.SetAggregateExchangeRateVote(ctx, voterAddr, votes[i])
+		}
+	}
+}
+
 //-----------------------------------
 // Oracle delegation logic
 
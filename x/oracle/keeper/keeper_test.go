@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -57,6 +58,112 @@ func TestExchangeRate(t *testing.T) {
 	require.True(t, numExchangeRates == 3)
 }
 
+func TestExchangeRateWithAge(t *testing.T) {
+	input := CreateTestInput(t)
+
+	exchangeRate := sdk.NewDecWithPrec(839, int64(OracleDecPrecision)).MulInt64(types.MicroUnit)
+
+	_, _, err := input.OracleKeeper.GetExchangeRateWithAge(input.Ctx, types.TestDenomE)
+	require.Error(t, err)
+
+	ctx := input.Ctx.WithBlockHeight(10)
+	input.OracleKeeper.SetExchangeRate(ctx, types.TestDenomE, exchangeRate)
+
+	entry, err := input.OracleKeeper.GetExchangeRateEntry(ctx, types.TestDenomE)
+	require.NoError(t, err)
+	require.Equal(t, exchangeRate, entry.Rate)
+	require.Equal(t, int64(10), entry.LastUpdateHeight)
+	require.True(t, ctx.BlockTime().Equal(entry.LastUpdateTime))
+
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	rate, age, err := input.OracleKeeper.GetExchangeRateWithAge(ctx, types.TestDenomE)
+	require.NoError(t, err)
+	require.Equal(t, exchangeRate, rate)
+	require.Equal(t, time.Minute, age)
+}
+
+func TestCrossExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+
+	now := input.Ctx.BlockTime()
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomC, sdk.NewDec(10))
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomD, sdk.NewDec(4))
+	input.OracleKeeper.SetExchangeRate(input.Ctx.WithBlockTime(now.Add(-params.MaxPriceAge-time.Second)), types.TestDenomE, sdk.NewDec(2))
+
+	// rates of the denoms in each other
+	rate, err := input.OracleKeeper.GetCrossExchangeRate(input.Ctx, types.TestDenomC, types.TestDenomD)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(25, 1), rate)
+
+	rate, err = input.OracleKeeper.GetCrossExchangeRate(input.Ctx, types.TestDenomD, types.TestDenomC)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(4, 1), rate)
+
+	// rates in and of the quote denom
+	rate, err = input.OracleKeeper.GetCrossExchangeRate(input.Ctx, types.TestDenomC, params.QuoteDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10), rate)
+
+	rate, err = input.OracleKeeper.GetCrossExchangeRate(input.Ctx, params.QuoteDenom, types.TestDenomD)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), rate)
+
+	// unknown legs
+	_, err = input.OracleKeeper.GetCrossExchangeRate(input.Ctx, types.TestDenomH, types.TestDenomD)
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+	_, err = input.OracleKeeper.GetCrossExchangeRate(input.Ctx, types.TestDenomC, types.TestDenomH)
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	// stale legs
+	_, err = input.OracleKeeper.GetCrossExchangeRate(input.Ctx, types.TestDenomE, types.TestDenomD)
+	require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+	_, err = input.OracleKeeper.GetCrossExchangeRate(input.Ctx, types.TestDenomC, types.TestDenomE)
+	require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+}
+
+func TestPruneExpiredExchangeRates(t *testing.T) {
+	input := CreateTestInput(t)
+
+	exchangeRate := sdk.NewDecWithPrec(839, int64(OracleDecPrecision)).MulInt64(types.MicroUnit)
+	whitelist := types.DenomList{{Name: types.TestDenomE}, {Name: types.TestDenomH}, {Name: types.TestDenomC}}
+
+	now := input.Ctx.BlockTime()
+	input.OracleKeeper.SetExchangeRate(input.Ctx.WithBlockHeight(1).WithBlockTime(now.Add(-2*time.Minute)), types.TestDenomE, exchangeRate)
+	input.OracleKeeper.SetExchangeRate(input.Ctx.WithBlockHeight(2).WithBlockTime(now.Add(-time.Minute)), types.TestDenomH, exchangeRate)
+	input.OracleKeeper.SetExchangeRate(input.Ctx.WithBlockHeight(3), types.TestDenomC, exchangeRate)
+	input.OracleKeeper.SetExchangeRate(input.Ctx.WithBlockHeight(3), types.TestDenomA, exchangeRate)
+
+	// rates stored before the update time was tracked are expired
+	input.OracleKeeper.SetExchangeRateEntry(input.Ctx, types.TestDenomB, types.ExchangeRate{Rate: exchangeRate})
+
+	ctx := input.Ctx.WithBlockHeight(3)
+	input.OracleKeeper.PruneExpiredExchangeRates(ctx, append(whitelist, types.Denom{Name: types.TestDenomB}), time.Minute)
+
+	_, err := input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomE)
+	require.Error(t, err)
+	_, err = input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomH)
+	require.NoError(t, err)
+	_, err = input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomC)
+	require.NoError(t, err)
+	_, err = input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomA)
+	require.Error(t, err)
+	_, err = input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomB)
+	require.Error(t, err)
+
+	// only the rates updated at the current block are kept without max price age
+	ctx = ctx.WithBlockHeight(4)
+	input.OracleKeeper.SetExchangeRate(ctx, types.TestDenomE, exchangeRate)
+	input.OracleKeeper.PruneExpiredExchangeRates(ctx, whitelist, 0)
+
+	_, err = input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomE)
+	require.NoError(t, err)
+	_, err = input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomH)
+	require.Error(t, err)
+	_, err = input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomC)
+	require.Error(t, err)
+}
+
 func TestIterateExchangeRates(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -104,18 +211,23 @@ func TestParams(t *testing.T) {
 	input := CreateTestInput(t)
 
 	// Test default params setting
-	input.OracleKeeper.SetParams(input.Ctx, types.DefaultParams())
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, types.DefaultParams()))
 	params := input.OracleKeeper.GetParams(input.Ctx)
 	require.NotNil(t, params)
 
 	// Test custom params setting
 	votePeriod := uint64(10)
-	voteThreshold := sdk.NewDecWithPrec(33, 2)
+	voteThreshold := sdk.NewDecWithPrec(34, 2)
 	oracleRewardBand := sdk.NewDecWithPrec(1, 2)
 	rewardDistributionWindow := uint64(10000000000000)
 	slashFraction := sdk.NewDecWithPrec(1, 2)
 	slashWindow := uint64(1000)
 	minValidPerWindow := sdk.NewDecWithPrec(1, 4)
+	maxPriceAge := time.Hour
+	historicStampPeriod := uint64(20)
+	maximumPriceStamps := uint64(100)
+	quoteDenom := types.TestDenomA
+	performanceWindow := uint64(500)
 	whitelist := types.DenomList{
 		{Name: types.TestDenomD},
 		{Name: types.TestDenomC},
@@ -131,8 +243,13 @@ func TestParams(t *testing.T) {
 		SlashFraction:            slashFraction,
 		SlashWindow:              slashWindow,
 		MinValidPerWindow:        minValidPerWindow,
+		MaxPriceAge:              maxPriceAge,
+		HistoricStampPeriod:      historicStampPeriod,
+		MaximumPriceStamps:       maximumPriceStamps,
+		QuoteDenom:               quoteDenom,
+		PerformanceWindow:        performanceWindow,
 	}
-	input.OracleKeeper.SetParams(input.Ctx, newParams)
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, newParams))
 
 	storedParams := input.OracleKeeper.GetParams(input.Ctx)
 	require.NotNil(t, storedParams)
@@ -3,8 +3,10 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"sidechain/x/oracle/types"
@@ -164,3 +166,102 @@ func (ms msgServer) DelegateFeedConsent(goCtx context.Context, msg *types.MsgDel
 
 	return &types.MsgDelegateFeedConsentResponse{}, nil
 }
+
+// UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
+// it updates the parameters in the keeper only if the requested authority
+// is the Cosmos SDK governance module account. The denoms dropped from the
+// whitelist are cleared as with RemoveWhitelistDenom.
+func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	previous := ms.Whitelist(ctx)
+	if err := ms.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	whitelisted := make(map[string]bool, len(req.Params.Whitelist))
+	for _, denom := range req.Params.Whitelist {
+		whitelisted[denom.Name] = true
+	}
+	for _, denom := range previous {
+		if !whitelisted[denom.Name] {
+			ms.ClearDenom(ctx, denom.Name)
+		}
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// AddWhitelistDenom implements the gRPC MsgServer interface. After a successful
+// governance vote it adds a denom to the whitelist, so that validators start
+// voting on its exchange rate from the next vote period
+func (ms msgServer) AddWhitelistDenom(goCtx context.Context, req *types.MsgAddWhitelistDenom) (*types.MsgAddWhitelistDenomResponse, error) {
+	if ms.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := ms.GetParams(ctx)
+	for _, denom := range params.Whitelist {
+		if denom.Name == req.Denom.Name {
+			return nil, errorsmod.Wrap(types.ErrDenomWhitelisted, req.Denom.Name)
+		}
+	}
+
+	params.Whitelist = append(params.Whitelist, req.Denom)
+	if err := ms.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddWhitelistDenomResponse{}, nil
+}
+
+// RemoveWhitelistDenom implements the gRPC MsgServer interface. After a
+// successful governance vote it removes a denom from the whitelist and clears
+// its exchange rate, historic price stamps and pending votes
+func (ms msgServer) RemoveWhitelistDenom(goCtx context.Context, req *types.MsgRemoveWhitelistDenom) (*types.MsgRemoveWhitelistDenomResponse, error) {
+	if ms.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := ms.GetParams(ctx)
+
+	whitelist := make(types.DenomList, 0, len(params.Whitelist))
+	for _, denom := range params.Whitelist {
+		if denom.Name != req.Denom {
+			whitelist = append(whitelist, denom)
+		}
+	}
+	if len(whitelist) == len(params.Whitelist) {
+		return nil, errorsmod.Wrap(types.ErrUnknownDenom, req.Denom)
+	}
+
+	params.Whitelist = whitelist
+	if err := ms.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	ms.ClearDenom(ctx, req.Denom)
+
+	return &types.MsgRemoveWhitelistDenomResponse{}, nil
+}
+
+// ClearPriceHalt implements the gRPC MsgServer interface. After a successful
+// governance vote it clears the halt of the price of a denom by the circuit
+// breaker
+func (ms msgServer) ClearPriceHalt(goCtx context.Context, req *types.MsgClearPriceHalt) (*types.MsgClearPriceHaltResponse, error) {
+	if ms.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.ClearPriceHalt(ctx, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClearPriceHaltResponse{}, nil
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"sidechain/x/oracle/types"
 
@@ -141,6 +143,108 @@ func TestMsgServer_AggregatePrevoteVote(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_UpdateParams(t *testing.T) {
+	input, msgServer := setup(t)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 5
+	params.HistoricStampPeriod = 10
+
+	// Case 1: invalid authority
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(input.Ctx), &types.MsgUpdateParams{Authority: Addrs[0].String(), Params: params})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// Case 2: invalid params
+	invalid := params
+	invalid.VotePeriod = 0
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(input.Ctx), &types.MsgUpdateParams{Authority: authority, Params: invalid})
+	require.Error(t, err)
+
+	// Case 3: params updated by the governance account
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(input.Ctx), &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	require.Equal(t, params, input.OracleKeeper.GetParams(input.Ctx))
+
+	// Case 4: denoms dropped from the whitelist are cleared
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{{Name: types.TestDenomC}, {Name: types.TestDenomD}})
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomC, randomExchangeRate)
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomD, randomExchangeRate)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(input.Ctx), &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomD)
+	require.Error(t, err)
+	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+	require.NoError(t, err)
+}
+
+func TestMsgServer_AddWhitelistDenom(t *testing.T) {
+	input, msgServer := setup(t)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	whitelist := types.DenomList{{Name: types.TestDenomC}, {Name: types.TestDenomD}}
+	input.OracleKeeper.SetWhitelist(input.Ctx, whitelist)
+
+	// Case 1: invalid authority
+	_, err := msgServer.AddWhitelistDenom(sdk.WrapSDKContext(input.Ctx), types.NewMsgAddWhitelistDenom(Addrs[0], types.TestDenomE))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// Case 2: denom already whitelisted
+	_, err = msgServer.AddWhitelistDenom(sdk.WrapSDKContext(input.Ctx), types.NewMsgAddWhitelistDenom(authority, whitelist[0].Name))
+	require.ErrorIs(t, err, types.ErrDenomWhitelisted)
+
+	// Case 3: denom added to the whitelist
+	_, err = msgServer.AddWhitelistDenom(sdk.WrapSDKContext(input.Ctx), types.NewMsgAddWhitelistDenom(authority, types.TestDenomE))
+	require.NoError(t, err)
+	require.Equal(t, append(whitelist, types.Denom{Name: types.TestDenomE}), input.OracleKeeper.Whitelist(input.Ctx))
+}
+
+func TestMsgServer_RemoveWhitelistDenom(t *testing.T) {
+	input, msgServer := setup(t)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{{Name: types.TestDenomC}, {Name: types.TestDenomD}})
+
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomC, randomExchangeRate)
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomD, randomExchangeRate)
+	input.OracleKeeper.SetHistoricPrice(input.Ctx, types.NewPriceStamp(types.TestDenomC, randomExchangeRate, 1, input.Ctx.BlockTime()))
+	input.OracleKeeper.SetHistoricPrice(input.Ctx, types.NewPriceStamp(types.TestDenomD, randomExchangeRate, 1, input.Ctx.BlockTime()))
+	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+		types.NewExchangeRateTuple(types.TestDenomC, randomExchangeRate),
+		types.NewExchangeRateTuple(types.TestDenomD, randomExchangeRate),
+	}, ValAddrs[0]))
+	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, ValAddrs[1], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+		types.NewExchangeRateTuple(types.TestDenomD, randomExchangeRate),
+	}, ValAddrs[1]))
+
+	// Case 1: invalid authority
+	_, err := msgServer.RemoveWhitelistDenom(sdk.WrapSDKContext(input.Ctx), types.NewMsgRemoveWhitelistDenom(Addrs[0], types.TestDenomD))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// Case 2: denom not whitelisted
+	_, err = msgServer.RemoveWhitelistDenom(sdk.WrapSDKContext(input.Ctx), types.NewMsgRemoveWhitelistDenom(authority, types.TestDenomE))
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	// Case 3: denom removed with its rates and votes
+	_, err = msgServer.RemoveWhitelistDenom(sdk.WrapSDKContext(input.Ctx), types.NewMsgRemoveWhitelistDenom(authority, types.TestDenomD))
+	require.NoError(t, err)
+	require.Equal(t, types.DenomList{{Name: types.TestDenomC}}, input.OracleKeeper.Whitelist(input.Ctx))
+
+	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomD)
+	require.Error(t, err)
+	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+	require.NoError(t, err)
+
+	require.Empty(t, input.OracleKeeper.GetHistoricPrices(input.Ctx, types.TestDenomD, 0))
+	require.Len(t, input.OracleKeeper.GetHistoricPrices(input.Ctx, types.TestDenomC, 0), 1)
+
+	vote, err := input.OracleKeeper.GetAggregateExchangeRateVote(input.Ctx, ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, types.ExchangeRateTuples{types.NewExchangeRateTuple(types.TestDenomC, randomExchangeRate)}, vote.ExchangeRateTuples)
+	_, err = input.OracleKeeper.GetAggregateExchangeRateVote(input.Ctx, ValAddrs[1])
+	require.Error(t, err)
+}
+
 var (
 	stakingAmt         = sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	randomExchangeRate = sdk.NewDec(1700)
@@ -152,7 +256,7 @@ func setup(t *testing.T) (TestInput, types.MsgServer) {
 	params.VotePeriod = 1
 	params.SlashWindow = 100
 	params.RewardDistributionWindow = 100
-	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, params))
 	msgServer := NewMsgServerImpl(input.OracleKeeper)
 
 	sh := stakingkeeper.NewMsgServerImpl(input.StakingKeeper)
@@ -168,3 +272,27 @@ func setup(t *testing.T) (TestInput, types.MsgServer) {
 
 	return input, msgServer
 }
+
+func TestMsgServer_ClearPriceHalt(t *testing.T) {
+	input, msgServer := setup(t)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	input.OracleKeeper.SetPriceHalt(input.Ctx, types.NewPriceHalt(types.TestDenomC, randomExchangeRate, sdk.OneDec(), input.Ctx.BlockHeight()))
+
+	// Case 1: invalid authority
+	_, err := msgServer.ClearPriceHalt(sdk.WrapSDKContext(input.Ctx), types.NewMsgClearPriceHalt(Addrs[0], types.TestDenomC))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// Case 2: price not halted
+	_, err = msgServer.ClearPriceHalt(sdk.WrapSDKContext(input.Ctx), types.NewMsgClearPriceHalt(authority, types.TestDenomD))
+	require.ErrorIs(t, err, types.ErrPriceNotHalted)
+
+	// Case 3: halt cleared
+	require.True(t, input.OracleKeeper.IsPriceHalted(input.Ctx, types.TestDenomC))
+	_, err = msgServer.ClearPriceHalt(sdk.WrapSDKContext(input.Ctx), types.NewMsgClearPriceHalt(authority, types.TestDenomC))
+	require.NoError(t, err)
+	require.False(t, input.OracleKeeper.IsPriceHalted(input.Ctx, types.TestDenomC))
+
+	halt, found := input.OracleKeeper.GetPriceHalt(input.Ctx, types.TestDenomC)
+	require.True(t, found)
+	require.Equal(t, input.Ctx.BlockTime(), halt.ClearedTime)
+}
@@ -1,72 +1,120 @@
 package keeper
 
 import (
+	"time"
+
 	"sidechain/x/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // VotePeriod returns the number of blocks during which voting takes place.
-func (k Keeper) VotePeriod(ctx sdk.Context) (res uint64) {
-	k.paramSpace.Get(ctx, types.KeyVotePeriod, &res)
-	return
+func (k Keeper) VotePeriod(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).VotePeriod
 }
 
 // VoteThreshold returns the minimum percentage of votes that must be received for a ballot to pass.
-func (k Keeper) VoteThreshold(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyVoteThreshold, &res)
-	return
+func (k Keeper) VoteThreshold(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).VoteThreshold
 }
 
 // RewardBand returns the ratio of allowable exchange rate error that a validator can be rewared
-func (k Keeper) RewardBand(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyRewardBand, &res)
-	return
+func (k Keeper) RewardBand(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).RewardBand
 }
 
 // RewardDistributionWindow returns the number of vote periods during which seigiornage reward comes in and then is distributed.
-func (k Keeper) RewardDistributionWindow(ctx sdk.Context) (res uint64) {
-	k.paramSpace.Get(ctx, types.KeyRewardDistributionWindow, &res)
-	return
+func (k Keeper) RewardDistributionWindow(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).RewardDistributionWindow
 }
 
 // Whitelist returns the denom list that can be activated
-func (k Keeper) Whitelist(ctx sdk.Context) (res types.DenomList) {
-	k.paramSpace.Get(ctx, types.KeyWhitelist, &res)
-	return
+func (k Keeper) Whitelist(ctx sdk.Context) types.DenomList {
+	return k.GetParams(ctx).Whitelist
 }
 
 // SetWhitelist store new whitelist to param store
 // this function is only for test purpose
 func (k Keeper) SetWhitelist(ctx sdk.Context, whitelist types.DenomList) {
-	k.paramSpace.Set(ctx, types.KeyWhitelist, whitelist)
+	params := k.GetParams(ctx)
+	params.Whitelist = whitelist
+	k.setParams(ctx, params)
 }
 
 // SlashFraction returns oracle voting penalty rate
-func (k Keeper) SlashFraction(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeySlashFraction, &res)
-	return
+func (k Keeper) SlashFraction(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).SlashFraction
 }
 
 // SlashWindow returns # of vote period for oracle slashing
-func (k Keeper) SlashWindow(ctx sdk.Context) (res uint64) {
-	k.paramSpace.Get(ctx, types.KeySlashWindow, &res)
-	return
+func (k Keeper) SlashWindow(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).SlashWindow
 }
 
 // MinValidPerWindow returns oracle slashing threshold
-func (k Keeper) MinValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyMinValidPerWindow, &res)
-	return
+func (k Keeper) MinValidPerWindow(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).MinValidPerWindow
+}
+
+// SlashGracePeriod returns the number of blocks after a validator bonds during
+// which it is not penalized for missing votes
+func (k Keeper) SlashGracePeriod(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).SlashGracePeriod
+}
+
+// SlashWarningOnly returns whether the validators missing too many votes are
+// warned instead of being slashed and jailed
+func (k Keeper) SlashWarningOnly(ctx sdk.Context) bool {
+	return k.GetParams(ctx).SlashWarningOnly
+}
+
+// MaxPriceAge returns how long the last exchange rate of a denom is kept
+// without a new consensus
+func (k Keeper) MaxPriceAge(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).MaxPriceAge
+}
+
+// HistoricStampPeriod returns the number of blocks between two historic price stamps
+func (k Keeper) HistoricStampPeriod(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).HistoricStampPeriod
+}
+
+// MaximumPriceStamps returns the number of historic price stamps kept for each denom
+func (k Keeper) MaximumPriceStamps(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MaximumPriceStamps
+}
+
+// PerformanceWindow returns the number of blocks of the rolling window of the
+// validator performances
+func (k Keeper) PerformanceWindow(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).PerformanceWindow
 }
 
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if len(bz) == 0 {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
-// SetParams sets the total set of oracle parameters.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+// SetParams validates and sets the total set of oracle parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.setParams(ctx, params)
+	return nil
+}
+
+// setParams sets the oracle parameters in a single key
+func (k Keeper) setParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
 }
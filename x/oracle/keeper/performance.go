@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sidechain/x/oracle/types"
+)
+
+// GetValidatorPerformance returns the lifetime voting performance of a
+// validator
+func (k Keeper) GetValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress) types.PerformanceStats {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorPerformanceKey(operator))
+	if bz == nil {
+		return types.NewPerformanceStats()
+	}
+
+	var performance types.ValidatorPerformance
+	k.cdc.MustUnmarshal(bz, &performance)
+	return performance.Stats
+}
+
+// SetValidatorPerformance stores the lifetime voting performance of a
+// validator
+func (k Keeper) SetValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress, stats types.PerformanceStats) {
+	store := ctx.KVStore(k.storeKey)
+	performance := types.NewValidatorPerformance(operator, 0, stats)
+	bz := k.cdc.MustMarshal(&performance)
+	store.Set(types.GetValidatorPerformanceKey(operator), bz)
+}
+
+// IterateValidatorPerformances iterates over the lifetime voting performances
+// of the validators and performs a callback function
+func (k Keeper) IterateValidatorPerformances(ctx sdk.Context, handler func(performance types.ValidatorPerformance) (stop bool)) {
+	k.iterateValidatorPerformances(ctx, types.ValidatorPerformanceKey, handler)
+}
+
+// SetValidatorPerformancePeriod stores the voting performance of a validator
+// over the vote period ending at the block height of the performance, and
+// indexes it by block height
+func (k Keeper) SetValidatorPerformancePeriod(ctx sdk.Context, operator sdk.ValAddress, performance types.ValidatorPerformance) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&performance)
+	store.Set(types.GetValidatorPerformancePeriodKey(operator, performance.BlockHeight), bz)
+	store.Set(types.GetValidatorPerformanceHeightKey(performance.BlockHeight, operator), []byte{1})
+}
+
+// IterateValidatorPerformancePeriods iterates over the vote period voting
+// performances of every validator and performs a callback function
+func (k Keeper) IterateValidatorPerformancePeriods(ctx sdk.Context, handler func(performance types.ValidatorPerformance) (stop bool)) {
+	k.iterateValidatorPerformances(ctx, types.ValidatorPerformancePeriodKey, handler)
+}
+
+// GetValidatorPerformanceWindow returns the voting performance of a validator
+// over the vote periods ending within the last window blocks
+func (k Keeper) GetValidatorPerformanceWindow(ctx sdk.Context, operator sdk.ValAddress, window uint64) types.PerformanceStats {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorPerformancePeriodPrefix(operator))
+	defer iter.Close()
+
+	since := ctx.BlockHeight() - int64(window)
+
+	stats := types.NewPerformanceStats()
+	for ; iter.Valid(); iter.Next() {
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+		if performance.BlockHeight > since {
+			stats = stats.Add(performance.Stats)
+		}
+	}
+
+	return stats
+}
+
+// RecordValidatorPerformance adds the voting performance of a validator over
+// the vote period ending at the current block to its lifetime performance and
+// to its rolling window
+func (k Keeper) RecordValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress, stats types.PerformanceStats) {
+	k.SetValidatorPerformance(ctx, operator, k.GetValidatorPerformance(ctx, operator).Add(stats))
+	k.SetValidatorPerformancePeriod(ctx, operator, types.NewValidatorPerformance(operator, ctx.BlockHeight(), stats))
+}
+
+// PruneValidatorPerformancePeriods deletes the vote period voting performances
+// of every validator ending at or before the given block height, including the
+// validators that left the active set
+func (k Keeper) PruneValidatorPerformancePeriods(ctx sdk.Context, blockHeight int64) {
+	if blockHeight < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.ValidatorPerformanceHeightKey, types.GetValidatorPerformanceHeightPrefix(blockHeight+1))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		height := int64(sdk.BigEndianToUint64(key[len(types.ValidatorPerformanceHeightKey) : len(types.ValidatorPerformanceHeightKey)+8]))
+		operator := sdk.ValAddress(key[len(types.ValidatorPerformanceHeightKey)+8+1:])
+		keys = append(keys, key, types.GetValidatorPerformancePeriodKey(operator, height))
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) iterateValidatorPerformances(ctx sdk.Context, prefix []byte, handler func(performance types.ValidatorPerformance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+		if handler(performance) {
+			break
+		}
+	}
+}
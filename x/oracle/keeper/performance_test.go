@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sidechain/x/oracle/types"
+)
+
+func TestRecordValidatorPerformance(t *testing.T) {
+	input := CreateTestInput(t)
+
+	stats := types.NewPerformanceStats()
+	stats.VotePeriods = 1
+	stats.PeriodsVoted = 1
+	stats.WinCount = 2
+	stats.DeviationSum = sdk.NewDecWithPrec(1, 2)
+	stats.DeviationCount = 2
+
+	// a vote period every 10 blocks with a window of 30 blocks
+	for height := int64(10); height <= 50; height += 10 {
+		input.OracleKeeper.RecordValidatorPerformance(input.Ctx.WithBlockHeight(height), ValAddrs[0], stats)
+		input.OracleKeeper.PruneValidatorPerformancePeriods(input.Ctx, height-30)
+	}
+	ctx := input.Ctx.WithBlockHeight(50)
+
+	lifetime := input.OracleKeeper.GetValidatorPerformance(ctx, ValAddrs[0])
+	require.Equal(t, uint64(5), lifetime.VotePeriods)
+	require.Equal(t, uint64(10), lifetime.WinCount)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), lifetime.DeviationSum)
+	require.Equal(t, sdk.NewDecWithPrec(5, 3), lifetime.AverageDeviation())
+
+	window := input.OracleKeeper.GetValidatorPerformanceWindow(ctx, ValAddrs[0], 30)
+	require.Equal(t, uint64(3), window.VotePeriods)
+	require.Equal(t, uint64(6), window.WinCount)
+
+	// the periods out of the window are pruned
+	var heights []int64
+	input.OracleKeeper.IterateValidatorPerformancePeriods(ctx, func(performance types.ValidatorPerformance) (stop bool) {
+		heights = append(heights, performance.BlockHeight)
+		return false
+	})
+	require.Equal(t, []int64{30, 40, 50}, heights)
+
+	// other validators are left untouched
+	require.Equal(t, types.NewPerformanceStats(), input.OracleKeeper.GetValidatorPerformance(ctx, ValAddrs[1]))
+	require.Equal(t, uint64(0), input.OracleKeeper.GetValidatorPerformanceWindow(ctx, ValAddrs[1], 30).VotePeriods)
+}
+
+func TestPruneValidatorPerformancePeriods(t *testing.T) {
+	input := CreateTestInput(t)
+
+	stats := types.NewPerformanceStats()
+	stats.VotePeriods = 1
+
+	// the second validator leaves the active set after the first vote period
+	input.OracleKeeper.RecordValidatorPerformance(input.Ctx.WithBlockHeight(10), ValAddrs[0], stats)
+	input.OracleKeeper.RecordValidatorPerformance(input.Ctx.WithBlockHeight(10), ValAddrs[1], stats)
+	input.OracleKeeper.RecordValidatorPerformance(input.Ctx.WithBlockHeight(20), ValAddrs[0], stats)
+
+	input.OracleKeeper.PruneValidatorPerformancePeriods(input.Ctx, 10)
+
+	var periods []types.ValidatorPerformance
+	input.OracleKeeper.IterateValidatorPerformancePeriods(input.Ctx, func(performance types.ValidatorPerformance) (stop bool) {
+		periods = append(periods, performance)
+		return false
+	})
+	require.Len(t, periods, 1)
+	require.Equal(t, ValAddrs[0].String(), periods[0].ValidatorAddress)
+	require.Equal(t, int64(20), periods[0].BlockHeight)
+
+	// the height index is pruned with the periods
+	store := input.Ctx.KVStore(input.OracleKeeper.storeKey)
+	require.False(t, store.Has(types.GetValidatorPerformanceHeightKey(10, ValAddrs[1])))
+	require.True(t, store.Has(types.GetValidatorPerformanceHeightKey(20, ValAddrs[0])))
+
+	// the lifetime performance of the departed validator is kept
+	require.Equal(t, uint64(1), input.OracleKeeper.GetValidatorPerformance(input.Ctx, ValAddrs[1]).VotePeriods)
+}
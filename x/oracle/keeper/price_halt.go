@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"sidechain/x/oracle/types"
+)
+
+// GetPriceHalt returns the price halt of a denom
+func (k Keeper) GetPriceHalt(ctx sdk.Context, denom string) (types.PriceHalt, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPriceHaltKey(denom))
+	if bz == nil {
+		return types.PriceHalt{}, false
+	}
+
+	var halt types.PriceHalt
+	k.cdc.MustUnmarshal(bz, &halt)
+	return halt, true
+}
+
+// SetPriceHalt stores the price halt of a denom
+func (k Keeper) SetPriceHalt(ctx sdk.Context, halt types.PriceHalt) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&halt)
+	store.Set(types.GetPriceHaltKey(halt.Denom), bz)
+}
+
+// DeletePriceHalt deletes the price halt of a denom
+func (k Keeper) DeletePriceHalt(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPriceHaltKey(denom))
+}
+
+// IteratePriceHalts iterates over the price halts and performs a callback function
+func (k Keeper) IteratePriceHalts(ctx sdk.Context, handler func(halt types.PriceHalt) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PriceHaltKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var halt types.PriceHalt
+		k.cdc.MustUnmarshal(iter.Value(), &halt)
+		if handler(halt) {
+			break
+		}
+	}
+}
+
+// IsPriceHalted returns whether the price of a denom is halted by the circuit
+// breaker. The exchange rate of a halted denom is the last one accepted before
+// the halt and is not updated until governance clears the halt.
+func (k Keeper) IsPriceHalted(ctx sdk.Context, denom string) bool {
+	halt, found := k.GetPriceHalt(ctx, denom)
+	return found && !halt.IsCleared()
+}
+
+// CheckCircuitBreaker returns whether the tallied exchange rate of a denom may
+// update its exchange rate. The price of the denom is halted when the rate
+// deviates from the reference rate by more than the maximum deviation of the
+// denom, the reference rate being the previous exchange rate, or the
+// time-weighted average exchange rate over the deviation lookback when set.
+// The rates tallied within the deviation lookback following the clearing of a
+// halt are accepted without deviation check.
+func (k Keeper) CheckCircuitBreaker(ctx sdk.Context, params types.Params, denom string, exchangeRate sdk.Dec) bool {
+	maxDeviation, lookback, enabled := params.DenomMaxDeviation(denom)
+
+	if halt, found := k.GetPriceHalt(ctx, denom); found {
+		if !halt.IsCleared() {
+			return false
+		}
+		if !enabled || !ctx.BlockTime().Before(halt.ClearedTime.Add(lookback)) {
+			k.DeletePriceHalt(ctx, denom)
+		}
+		return true
+	}
+
+	if !enabled {
+		return true
+	}
+
+	// no deviation can be measured without a reference rate
+	referenceRate, err := k.getReferenceRate(ctx, denom, lookback)
+	if err != nil || !referenceRate.IsPositive() {
+		return true
+	}
+
+	deviation := exchangeRate.Sub(referenceRate).Abs().Quo(referenceRate)
+	if deviation.LTE(maxDeviation) {
+		return true
+	}
+
+	k.SetPriceHalt(ctx, types.NewPriceHalt(denom, exchangeRate, referenceRate, ctx.BlockHeight()))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypePriceHalt,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyExchangeRate, exchangeRate.String()),
+			sdk.NewAttribute(types.AttributeKeyReferenceRate, referenceRate.String()),
+		),
+	)
+
+	return false
+}
+
+// ClearPriceHalt clears the price halt of a denom, so that its exchange rate
+// is updated again from the next tally
+func (k Keeper) ClearPriceHalt(ctx sdk.Context, denom string) error {
+	halt, found := k.GetPriceHalt(ctx, denom)
+	if !found || halt.IsCleared() {
+		return sdkerrors.Wrap(types.ErrPriceNotHalted, denom)
+	}
+
+	halt.ClearedTime = ctx.BlockTime()
+	k.SetPriceHalt(ctx, halt)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypePriceHaltClear,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+
+	return nil
+}
+
+// getReferenceRate returns the rate the deviation of the tallied exchange
+// rate of a denom is measured against
+func (k Keeper) getReferenceRate(ctx sdk.Context, denom string, lookback time.Duration) (sdk.Dec, error) {
+	if lookback == 0 {
+		return k.GetExchangeRate(ctx, denom)
+	}
+
+	return k.GetTWAPExchangeRate(ctx, denom, lookback)
+}
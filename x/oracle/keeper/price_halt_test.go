@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sidechain/x/oracle/types"
+)
+
+// circuitBreakerParams returns the params of a whitelist of denom A with the
+// given maximum deviation and deviation lookback
+func circuitBreakerParams(input TestInput, maxDeviation sdk.Dec, lookback time.Duration) types.Params {
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomA, MaxDeviation: &maxDeviation, DeviationLookback: lookback}}
+	return params
+}
+
+func TestCheckCircuitBreaker(t *testing.T) {
+	input := CreateTestInput(t)
+	params := circuitBreakerParams(input, sdk.NewDecWithPrec(10, 2), 0)
+
+	// no reference rate yet
+	require.True(t, input.OracleKeeper.CheckCircuitBreaker(input.Ctx, params, types.TestDenomA, sdk.NewDec(100)))
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomA, sdk.NewDec(100))
+
+	// within 10% of the previous rate
+	require.True(t, input.OracleKeeper.CheckCircuitBreaker(input.Ctx, params, types.TestDenomA, sdk.NewDec(110)))
+	require.True(t, input.OracleKeeper.CheckCircuitBreaker(input.Ctx, params, types.TestDenomA, sdk.NewDec(90)))
+	require.False(t, input.OracleKeeper.IsPriceHalted(input.Ctx, types.TestDenomA))
+
+	// denoms without maximum deviation are never halted
+	require.True(t, input.OracleKeeper.CheckCircuitBreaker(input.Ctx, params, types.TestDenomB, sdk.NewDec(1000)))
+
+	// a drop of 90% halts the price
+	require.False(t, input.OracleKeeper.CheckCircuitBreaker(input.Ctx, params, types.TestDenomA, sdk.NewDec(10)))
+	require.True(t, input.OracleKeeper.IsPriceHalted(input.Ctx, types.TestDenomA))
+
+	halt, found := input.OracleKeeper.GetPriceHalt(input.Ctx, types.TestDenomA)
+	require.True(t, found)
+	require.Equal(t, types.NewPriceHalt(types.TestDenomA, sdk.NewDec(10), sdk.NewDec(100), input.Ctx.BlockHeight()), halt)
+
+	// any rate is rejected while halted
+	require.False(t, input.OracleKeeper.CheckCircuitBreaker(input.Ctx, params, types.TestDenomA, sdk.NewDec(100)))
+
+	// the next rate is accepted once cleared
+	require.NoError(t, input.OracleKeeper.ClearPriceHalt(input.Ctx, types.TestDenomA))
+	require.False(t, input.OracleKeeper.IsPriceHalted(input.Ctx, types.TestDenomA))
+	require.True(t, input.OracleKeeper.CheckCircuitBreaker(input.Ctx, params, types.TestDenomA, sdk.NewDec(10)))
+	_, found = input.OracleKeeper.GetPriceHalt(input.Ctx, types.TestDenomA)
+	require.False(t, found)
+
+	require.ErrorIs(t, input.OracleKeeper.ClearPriceHalt(input.Ctx, types.TestDenomA), types.ErrPriceNotHalted)
+}
+
+func TestCheckCircuitBreakerTWAP(t *testing.T) {
+	input := CreateTestInput(t)
+	params := circuitBreakerParams(input, sdk.NewDecWithPrec(20, 2), 10*time.Minute)
+
+	// the previous rate is far from the twap of 100
+	stampPrices(input, types.TestDenomA, 100, 100, 100)
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomA, sdk.NewDec(150))
+
+	require.True(t, input.OracleKeeper.CheckCircuitBreaker(input.Ctx, params, types.TestDenomA, sdk.NewDec(115)))
+	require.False(t, input.OracleKeeper.CheckCircuitBreaker(input.Ctx, params, types.TestDenomA, sdk.NewDec(150)))
+	require.True(t, input.OracleKeeper.IsPriceHalted(input.Ctx, types.TestDenomA))
+
+	// the rates are accepted during the lookback following the clearing
+	require.NoError(t, input.OracleKeeper.ClearPriceHalt(input.Ctx, types.TestDenomA))
+	ctx := input.Ctx.WithBlockTime(input.Ctx.BlockTime().Add(5 * time.Minute))
+	require.True(t, input.OracleKeeper.CheckCircuitBreaker(ctx, params, types.TestDenomA, sdk.NewDec(150)))
+	_, found := input.OracleKeeper.GetPriceHalt(ctx, types.TestDenomA)
+	require.True(t, found)
+
+	// and checked again after it
+	ctx = input.Ctx.WithBlockTime(input.Ctx.BlockTime().Add(10 * time.Minute))
+	require.True(t, input.OracleKeeper.CheckCircuitBreaker(ctx, params, types.TestDenomA, sdk.NewDec(150)))
+	_, found = input.OracleKeeper.GetPriceHalt(ctx, types.TestDenomA)
+	require.False(t, found)
+	require.False(t, input.OracleKeeper.CheckCircuitBreaker(ctx, params, types.TestDenomA, sdk.NewDec(1000)))
+}
+
+func TestPruneExpiredExchangeRatesHalted(t *testing.T) {
+	input := CreateTestInput(t)
+	params := circuitBreakerParams(input, sdk.NewDecWithPrec(10, 2), 0)
+
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomA, sdk.NewDec(100))
+	input.OracleKeeper.SetPriceHalt(input.Ctx, types.NewPriceHalt(types.TestDenomA, sdk.NewDec(10), sdk.NewDec(100), input.Ctx.BlockHeight()))
+
+	ctx := input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1).WithBlockTime(input.Ctx.BlockTime().Add(time.Hour))
+	input.OracleKeeper.PruneExpiredExchangeRates(ctx, params.Whitelist, time.Minute)
+
+	rate, err := input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomA)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(100), rate)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -27,10 +28,7 @@ var _ types.QueryServer = querier{}
 // Params queries params of distribution module
 func (q querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	q.paramSpace.GetParamSet(ctx, &params)
-
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
 }
 
 // ExchangeRate queries exchange rate of a denom
@@ -52,6 +50,25 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 	return &types.QueryExchangeRateResponse{ExchangeRate: exchangeRate}, nil
 }
 
+// CrossExchangeRate queries the exchange rate of a base denom in a quote denom
+func (q querier) CrossExchangeRate(c context.Context, req *types.QueryCrossExchangeRateRequest) (*types.QueryCrossExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Base) == 0 || len(req.Quote) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	exchangeRate, err := q.GetCrossExchangeRate(ctx, req.Base, req.Quote)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCrossExchangeRateResponse{ExchangeRate: exchangeRate}, nil
+}
+
 // ExchangeRates queries exchange rates of all denoms
 func (q querier) ExchangeRates(c context.Context, req *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -78,6 +95,84 @@ func (q querier) Actives(c context.Context, req *types.QueryActivesRequest) (*ty
 	return &types.QueryActivesResponse{Actives: denoms}, nil
 }
 
+// HistoricPrices queries the historic price stamps of a denom
+func (q querier) HistoricPrices(c context.Context, req *types.QueryHistoricPricesRequest) (*types.QueryHistoricPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	stamps := q.GetHistoricPrices(ctx, req.Denom, lookbackDuration(req.LookbackSeconds))
+
+	return &types.QueryHistoricPricesResponse{HistoricPrices: stamps}, nil
+}
+
+// TwapPrice queries the time-weighted average exchange rate of a denom
+func (q querier) TwapPrice(c context.Context, req *types.QueryTwapPriceRequest) (*types.QueryTwapPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	exchangeRate, err := q.GetTWAPExchangeRate(ctx, req.Denom, lookbackDuration(req.LookbackSeconds))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTwapPriceResponse{ExchangeRate: exchangeRate}, nil
+}
+
+// MedianPrice queries the median exchange rate of a denom
+func (q querier) MedianPrice(c context.Context, req *types.QueryMedianPriceRequest) (*types.QueryMedianPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	exchangeRate, err := q.GetMedianExchangeRate(ctx, req.Denom, lookbackDuration(req.LookbackSeconds))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMedianPriceResponse{ExchangeRate: exchangeRate}, nil
+}
+
+// MinMaxPrice queries the lowest and the highest exchange rates of a denom
+func (q querier) MinMaxPrice(c context.Context, req *types.QueryMinMaxPriceRequest) (*types.QueryMinMaxPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	min, max, err := q.GetMinMaxExchangeRate(ctx, req.Denom, lookbackDuration(req.LookbackSeconds))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMinMaxPriceResponse{Min: min, Max: max}, nil
+}
+
+// lookbackDuration converts the lookback window of a historic price query
+func lookbackDuration(seconds uint64) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
+
 // FeederDelegation queries the account address that the validator operator delegated oracle vote rights to
 func (q querier) FeederDelegation(c context.Context, req *types.QueryFeederDelegationRequest) (*types.QueryFeederDelegationResponse, error) {
 	if req == nil {
@@ -112,6 +207,30 @@ func (q querier) MissCounter(c context.Context, req *types.QueryMissCounterReque
 	}, nil
 }
 
+// ValidatorPerformance queries the lifetime and rolling window voting
+// performance of a validator
+func (q querier) ValidatorPerformance(c context.Context, req *types.QueryValidatorPerformanceRequest) (*types.QueryValidatorPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	lifetime := q.GetValidatorPerformance(ctx, valAddr)
+	window := q.GetValidatorPerformanceWindow(ctx, valAddr, q.PerformanceWindow(ctx))
+
+	return &types.QueryValidatorPerformanceResponse{
+		Lifetime:                 lifetime,
+		Window:                   window,
+		LifetimeAverageDeviation: lifetime.AverageDeviation(),
+		WindowAverageDeviation:   window.AverageDeviation(),
+	}, nil
+}
+
 // AggregatePrevote queries an aggregate prevote of a validator
 func (q querier) AggregatePrevote(c context.Context, req *types.QueryAggregatePrevoteRequest) (*types.QueryAggregatePrevoteResponse, error) {
 	if req == nil {
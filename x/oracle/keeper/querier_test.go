@@ -43,6 +43,36 @@ func TestQueryExchangeRate(t *testing.T) {
 	require.Equal(t, rate, res.ExchangeRate)
 }
 
+func TestQueryCrossExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomC, sdk.NewDec(1700))
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomD, sdk.NewDec(10))
+
+	// empty request
+	_, err := querier.CrossExchangeRate(ctx, nil)
+	require.Error(t, err)
+	_, err = querier.CrossExchangeRate(ctx, &types.QueryCrossExchangeRateRequest{Base: types.TestDenomC})
+	require.Error(t, err)
+
+	// unknown quote
+	_, err = querier.CrossExchangeRate(ctx, &types.QueryCrossExchangeRateRequest{
+		Base:  types.TestDenomC,
+		Quote: types.TestDenomE,
+	})
+	require.Error(t, err)
+
+	// Query to grpc
+	res, err := querier.CrossExchangeRate(ctx, &types.QueryCrossExchangeRateRequest{
+		Base:  types.TestDenomC,
+		Quote: types.TestDenomD,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(170), res.ExchangeRate)
+}
+
 func TestQueryMissCounter(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -63,6 +93,47 @@ func TestQueryMissCounter(t *testing.T) {
 	require.Equal(t, missCounter, res.MissCounter)
 }
 
+func TestQueryValidatorPerformance(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.OracleKeeper)
+
+	stats := types.NewPerformanceStats()
+	stats.VotePeriods = 1
+	stats.PeriodsVoted = 1
+	stats.DeviationSum = sdk.NewDecWithPrec(2, 2)
+	stats.DeviationCount = 2
+
+	// one period out of the window, one within
+	input.OracleKeeper.RecordValidatorPerformance(input.Ctx.WithBlockHeight(10), ValAddrs[0], stats)
+	ctx := input.Ctx.WithBlockHeight(200)
+	input.OracleKeeper.RecordValidatorPerformance(ctx, ValAddrs[0], stats)
+
+	params := input.OracleKeeper.GetParams(ctx)
+	params.PerformanceWindow = 100
+	require.NoError(t, input.OracleKeeper.SetParams(ctx, params))
+
+	// empty request
+	_, err := querier.ValidatorPerformance(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+
+	res, err := querier.ValidatorPerformance(sdk.WrapSDKContext(ctx), &types.QueryValidatorPerformanceRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Lifetime.VotePeriods)
+	require.Equal(t, uint64(1), res.Window.VotePeriods)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), res.LifetimeAverageDeviation)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), res.WindowAverageDeviation)
+
+	// validator without performance
+	res, err = querier.ValidatorPerformance(sdk.WrapSDKContext(ctx), &types.QueryValidatorPerformanceRequest{
+		ValidatorAddr: ValAddrs[1].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Lifetime.VotePeriods)
+	require.Equal(t, sdk.ZeroDec(), res.WindowAverageDeviation)
+}
+
 func TestQueryExchangeRates(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -227,3 +298,63 @@ func TestQueryAggregateVotes(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedVotes, res.AggregateVotes)
 }
+
+func TestQueryHistoricPrices(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	stampPrices(input, types.TestDenomD, 10, 20, 30)
+
+	// empty request
+	_, err := querier.HistoricPrices(ctx, nil)
+	require.Error(t, err)
+	_, err = querier.HistoricPrices(ctx, &types.QueryHistoricPricesRequest{})
+	require.Error(t, err)
+
+	res, err := querier.HistoricPrices(ctx, &types.QueryHistoricPricesRequest{
+		Denom: types.TestDenomD,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.HistoricPrices, 3)
+
+	res, err = querier.HistoricPrices(ctx, &types.QueryHistoricPricesRequest{
+		Denom:           types.TestDenomD,
+		LookbackSeconds: 60,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.HistoricPrices, 2)
+}
+
+func TestQueryHistoricExchangeRates(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.TwapPrice(ctx, nil)
+	require.Error(t, err)
+	_, err = querier.MedianPrice(ctx, nil)
+	require.Error(t, err)
+	_, err = querier.MinMaxPrice(ctx, nil)
+	require.Error(t, err)
+
+	// no historic price
+	_, err = querier.TwapPrice(ctx, &types.QueryTwapPriceRequest{Denom: types.TestDenomD})
+	require.Error(t, err)
+
+	stampPrices(input, types.TestDenomD, 10, 20, 30)
+
+	twap, err := querier.TwapPrice(ctx, &types.QueryTwapPriceRequest{Denom: types.TestDenomD})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(15), twap.ExchangeRate)
+
+	median, err := querier.MedianPrice(ctx, &types.QueryMedianPriceRequest{Denom: types.TestDenomD})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(20), median.ExchangeRate)
+
+	minMax, err := querier.MinMaxPrice(ctx, &types.QueryMinMaxPriceRequest{Denom: types.TestDenomD, LookbackSeconds: 60})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(20), minMax.Min)
+	require.Equal(t, sdk.NewDec(30), minMax.Max)
+}
@@ -2,47 +2,94 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sidechain/x/oracle/types"
 )
 
-// SlashAndResetMissCounters do slash any operator who over criteria & clear all operators miss counter to zero
+// SlashAndResetMissCounters do slash any operator who over criteria & clear all operators miss counter to zero.
+// Validators are only judged on the vote periods of the window they were bonded for, and are not penalized
+// during the grace period following their bonding. They are warned with an event instead of being slashed
+// and jailed when SlashWarningOnly is set.
 func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	height := ctx.BlockHeight()
 	distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-	// slash_window / vote_period
-	votePeriodsPerWindow := uint64(
-		sdk.NewDec(int64(k.SlashWindow(ctx))).
-			QuoInt64(int64(k.VotePeriod(ctx))).
-			TruncateInt64(),
-	)
-	minValidPerWindow := k.MinValidPerWindow(ctx)
-	slashFraction := k.SlashFraction(ctx)
+	params := k.GetParams(ctx)
 	powerReduction := k.StakingKeeper.PowerReduction(ctx)
 
 	k.IterateMissCounters(ctx, func(operator sdk.ValAddress, missCounter uint64) bool {
-		// Calculate valid vote rate; (SlashWindow - MissCounter)/SlashWindow
+		defer k.DeleteMissCounter(ctx, operator)
+
+		validator := k.StakingKeeper.Validator(ctx, operator)
+		if validator == nil || !validator.IsBonded() || validator.IsJailed() {
+			return false
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			panic(err)
+		}
+
+		votePeriods := k.slashableVotePeriods(ctx, consAddr, params)
+		if votePeriods == 0 {
+			return false
+		}
+		if missCounter > votePeriods {
+			missCounter = votePeriods
+		}
+
+		// Calculate valid vote rate; (VotePeriods - MissCounter)/VotePeriods
 		validVoteRate := sdk.NewDecFromInt(
-			sdk.NewInt(int64(votePeriodsPerWindow - missCounter))).
-			QuoInt64(int64(votePeriodsPerWindow))
+			sdk.NewInt(int64(votePeriods - missCounter))).
+			QuoInt64(int64(votePeriods))
 
 		// Penalize the validator whose the valid vote rate is smaller than min threshold
-		if validVoteRate.LT(minValidPerWindow) {
-			validator := k.StakingKeeper.Validator(ctx, operator)
-			if validator.IsBonded() && !validator.IsJailed() {
-				consAddr, err := validator.GetConsAddr()
-				if err != nil {
-					panic(err)
-				}
-
-				k.SlashingKeeper.Slash(
-					ctx, consAddr, slashFraction,
-					validator.GetConsensusPower(powerReduction), distributionHeight,
+		if validVoteRate.LT(params.MinValidPerWindow) {
+			if params.SlashWarningOnly {
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(types.EventTypeSlashWarning,
+						sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+						sdk.NewAttribute(types.AttributeKeyMissCount, sdk.NewIntFromUint64(missCounter).String()),
+						sdk.NewAttribute(types.AttributeKeyValidVoteRate, validVoteRate.String()),
+					),
 				)
-				k.SlashingKeeper.Jail(ctx, consAddr)
+				return false
 			}
+
+			k.SlashingKeeper.Slash(
+				ctx, consAddr, params.SlashFraction,
+				validator.GetConsensusPower(powerReduction), distributionHeight,
+			)
+			k.SlashingKeeper.Jail(ctx, consAddr)
 		}
 
-		k.DeleteMissCounter(ctx, operator)
 		return false
 	})
 }
+
+// slashableVotePeriods returns the number of vote periods of the slash window
+// ending at the current block a validator is judged on, which are the vote
+// periods it was bonded for, or zero during the grace period following its
+// bonding. Validators without signing info are judged on the whole window.
+func (k Keeper) slashableVotePeriods(ctx sdk.Context, consAddr sdk.ConsAddress, params types.Params) uint64 {
+	// slash_window / vote_period
+	votePeriodsPerWindow := params.SlashWindow / params.VotePeriod
+
+	signingInfo, found := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return votePeriodsPerWindow
+	}
+
+	bondHeight := signingInfo.StartHeight
+	if ctx.BlockHeight()-bondHeight < int64(params.SlashGracePeriod) {
+		return 0
+	}
+
+	// prorate the window of the validators bonded after it started
+	windowStart := ctx.BlockHeight() + 1 - int64(params.SlashWindow)
+	if bondHeight <= windowStart {
+		return votePeriodsPerWindow
+	}
+
+	return uint64(ctx.BlockHeight()+1-bondHeight) / params.VotePeriod
+}
@@ -37,6 +37,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -122,13 +123,14 @@ var (
 
 // TestInput nolint
 type TestInput struct {
-	Ctx           sdk.Context
-	Cdc           *codec.LegacyAmino
-	AccountKeeper authkeeper.AccountKeeper
-	BankKeeper    bankkeeper.Keeper
-	OracleKeeper  Keeper
-	StakingKeeper stakingkeeper.Keeper
-	DistrKeeper   distrkeeper.Keeper
+	Ctx            sdk.Context
+	Cdc            *codec.LegacyAmino
+	AccountKeeper  authkeeper.AccountKeeper
+	BankKeeper     bankkeeper.Keeper
+	OracleKeeper   Keeper
+	StakingKeeper  stakingkeeper.Keeper
+	DistrKeeper    distrkeeper.Keeper
+	SlashingKeeper slashingkeeper.Keeper
 }
 
 // CreateTestInput nolint
@@ -235,6 +237,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		appCodec,
 		keyOracle,
 		paramsKeeper.Subspace(types.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
 		accountKeeper,
 		bankKeeper,
 		distrKeeper,
@@ -244,9 +247,9 @@ func CreateTestInput(t *testing.T) TestInput {
 	)
 
 	defaults := types.DefaultParams()
-	keeper.SetParams(ctx, defaults)
+	require.NoError(t, keeper.SetParams(ctx, defaults))
 
-	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, keeper, stakingKeeper, distrKeeper}
+	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, keeper, stakingKeeper, distrKeeper, slashingKeeper}
 }
 
 // NewTestMsgCreateValidator test msg creator
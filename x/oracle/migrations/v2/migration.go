@@ -0,0 +1,28 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"sidechain/x/oracle/types"
+)
+
+// MigrateStore migrates the x/oracle module state from the consensus version 1
+// to version 2. Specifically, it sets the MaxPriceAge, HistoricStampPeriod and
+// MaximumPriceStamps parameters that were added to the oracle parameters to
+// their default values.
+//
+// The stored exchange rates don't need to be migrated: the ExchangeRate entry
+// is wire compatible with the sdk.DecProto stored so far. The rates decoded
+// from the previous format have no update time and expire at the end of the
+// next vote period unless they are voted again, like before the migration.
+func MigrateStore(ctx sdk.Context, paramSpace paramstypes.Subspace) error {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramSpace.Set(ctx, types.KeyMaxPriceAge, types.DefaultMaxPriceAge)
+	paramSpace.Set(ctx, types.KeyHistoricStampPeriod, types.DefaultHistoricStampPeriod)
+	paramSpace.Set(ctx, types.KeyMaximumPriceStamps, types.DefaultMaximumPriceStamps)
+	return nil
+}
@@ -0,0 +1,47 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2 "sidechain/x/oracle/migrations/v2"
+	"sidechain/x/oracle/types"
+)
+
+func TestMigrate(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramSpace := paramstypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// the parameters stored before the migration don't have a max price age
+	paramSpace.Set(ctx, types.KeyVotePeriod, types.DefaultVotePeriod)
+	require.False(t, paramSpace.Has(ctx, types.KeyMaxPriceAge))
+
+	require.NoError(t, v2.MigrateStore(ctx, paramSpace))
+
+	var maxPriceAge time.Duration
+	paramSpace.Get(ctx, types.KeyMaxPriceAge, &maxPriceAge)
+	require.Equal(t, types.DefaultMaxPriceAge, maxPriceAge)
+
+	var historicStampPeriod, maximumPriceStamps uint64
+	paramSpace.Get(ctx, types.KeyHistoricStampPeriod, &historicStampPeriod)
+	paramSpace.Get(ctx, types.KeyMaximumPriceStamps, &maximumPriceStamps)
+	require.Equal(t, types.DefaultHistoricStampPeriod, historicStampPeriod)
+	require.Equal(t, types.DefaultMaximumPriceStamps, maximumPriceStamps)
+
+	var votePeriod uint64
+	paramSpace.Get(ctx, types.KeyVotePeriod, &votePeriod)
+	require.Equal(t, types.DefaultVotePeriod, votePeriod)
+}
@@ -0,0 +1,37 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"sidechain/x/oracle/types"
+)
+
+// MigrateStore migrates the x/oracle module state from the consensus version 2
+// to version 3. Specifically, it takes the parameters that are currently stored
+// and managed by the Cosmos SDK params module and stores them directly into
+// the x/oracle module state. The parameters that were never part of the params
+// subspace are set to their default values.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramSpace paramstypes.Subspace, cdc codec.BinaryCodec) error {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	params.QuoteDenom = types.DefaultQuoteDenom
+	params.PerformanceWindow = types.DefaultPerformanceWindow
+	params.SlashGracePeriod = types.DefaultSlashGracePeriod
+	params.SlashWarningOnly = types.DefaultSlashWarningOnly
+
+	store := ctx.KVStore(storeKey)
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
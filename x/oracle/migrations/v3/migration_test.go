@@ -0,0 +1,41 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v3 "sidechain/x/oracle/migrations/v3"
+	"sidechain/x/oracle/types"
+)
+
+func TestMigrate(t *testing.T) {
+	// the legacy subspace shares the module store, its keys are prefixed
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramSpace := paramstypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	params := types.DefaultParams()
+	params.VotePeriod = 10
+	params.HistoricStampPeriod = 20
+	params.Whitelist = types.DenomList{{Name: types.TestDenomA}, {Name: types.TestDenomB}}
+	paramSpace.SetParamSet(ctx, &params)
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, paramSpace, cdc))
+
+	var migrated types.Params
+	bz := ctx.KVStore(storeKey).Get(types.ParamsKey)
+	require.NotNil(t, bz)
+	cdc.MustUnmarshal(bz, &migrated)
+	require.Equal(t, params, migrated)
+}
@@ -0,0 +1,61 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sidechain/x/oracle/types"
+)
+
+// PeriodPerformances returns the voting performance of each validator of the
+// claim map over the vote period, from the ballots of the period and the
+// exchange rates tallied from them. The claims must have been tallied.
+func PeriodPerformances(
+	ballots map[string]types.ExchangeRateBallot,
+	exchangeRates map[string]sdk.Dec,
+	validatorClaimMap map[string]types.Claim,
+	voteTargetsLen int,
+) map[string]types.PerformanceStats {
+	performances := make(map[string]types.PerformanceStats, len(validatorClaimMap))
+	for key := range validatorClaimMap {
+		stats := types.NewPerformanceStats()
+		stats.VotePeriods = 1
+		performances[key] = stats
+	}
+
+	// abstain votes of the tallied ballots are counted as wins by Tally
+	talliedAbstains := make(map[string]int64)
+	for denom, ballot := range ballots {
+		exchangeRate, tallied := exchangeRates[denom]
+		for _, vote := range ballot {
+			key := vote.Voter.String()
+			stats, ok := performances[key]
+			if !ok {
+				continue
+			}
+
+			stats.PeriodsVoted = 1
+			switch {
+			case !vote.ExchangeRate.IsPositive():
+				stats.AbstainCount++
+				if tallied {
+					talliedAbstains[key]++
+				}
+			case tallied && exchangeRate.IsPositive():
+				stats.DeviationSum = stats.DeviationSum.Add(vote.ExchangeRate.Sub(exchangeRate).Abs().Quo(exchangeRate))
+				stats.DeviationCount++
+			}
+			performances[key] = stats
+		}
+	}
+
+	for key, claim := range validatorClaimMap {
+		stats := performances[key]
+		stats.WinCount = uint64(claim.WinCount - talliedAbstains[key])
+		if int(claim.WinCount) < voteTargetsLen {
+			stats.MissCount = 1
+		}
+		performances[key] = stats
+	}
+
+	return performances
+}
@@ -0,0 +1,286 @@
+package precompile
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sidechain/contracts"
+	"sidechain/x/oracle/keeper"
+	"sidechain/x/oracle/types"
+)
+
+const (
+	// GetExchangeRateMethod is the name of the method returning the exchange
+	// rate of a denom
+	GetExchangeRateMethod = "getExchangeRate"
+	// GetExchangeRatesMethod is the name of the method returning the exchange
+	// rates of every denom
+	GetExchangeRatesMethod = "getExchangeRates"
+	// GetTwapMethod is the name of the method returning the time-weighted
+	// average exchange rate of a denom
+	GetTwapMethod = "getTwap"
+)
+
+// Gas costs of the precompile methods. GasBase is charged for the calls
+// that do not select a method of the precompile or whose input is malformed.
+const (
+	GasBase                 uint64 = 3_000
+	GasGetExchangeRate      uint64 = 3_000
+	GasGetExchangeRates     uint64 = 3_000
+	GasGetExchangeRatesItem uint64 = 1_000
+	GasGetTwap              uint64 = 3_000
+	GasGetTwapStamp         uint64 = 500
+)
+
+var (
+	// Address is the address the oracle precompile is deployed at
+	Address = common.HexToAddress("0x0000000000000000000000000000000000000900")
+
+	// ErrNoContext is returned by the precompile when it is run without the
+	// context of the EVM it runs in
+	ErrNoContext = errors.New("oracle precompile is not bound to a context")
+
+	// revertSelector is the selector of the Error(string) revert reason
+	revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+	stringArguments = abi.Arguments{{Type: mustNewType("string")}}
+
+	_ vm.PrecompiledContract = (*Precompile)(nil)
+)
+
+// ExchangeRate is the exchange rate returned by getExchangeRates
+type ExchangeRate struct {
+	Denom       string
+	Rate        *big.Int
+	LastUpdated uint64
+}
+
+// Precompile is the oracle precompiled contract. It exposes the exchange rates
+// and the time-weighted average exchange rates of the oracle module to the
+// EVM, as fixed-point numbers with 18 decimals alongside the unix time they
+// were last updated at.
+//
+// The precompile reads the oracle store through the context it is bound to
+// with WithContext, which must be the context of the EVM it runs in.
+type Precompile struct {
+	abi    abi.ABI
+	keeper keeper.Keeper
+	ctx    sdk.Context
+}
+
+// NewPrecompile returns an oracle precompile reading the store of the given
+// keeper. It must be bound to a context with WithContext before it is run.
+func NewPrecompile(k keeper.Keeper) *Precompile {
+	return &Precompile{
+		abi:    contracts.IOracleContract.ABI,
+		keeper: k,
+	}
+}
+
+// WithContext returns a copy of the precompile reading the store through the
+// given context. The store reads do not consume the gas of the context, as
+// the EVM charges the RequiredGas of each call.
+func (p Precompile) WithContext(ctx sdk.Context) vm.PrecompiledContract {
+	p.ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	return &p
+}
+
+// Address returns the address the precompile is deployed at
+func (Precompile) Address() common.Address {
+	return Address
+}
+
+// RequiredGas returns the gas required to execute the precompile
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	method, err := p.method(input)
+	if err != nil {
+		return GasBase
+	}
+
+	switch method.Name {
+	case GetExchangeRateMethod:
+		return GasGetExchangeRate
+	case GetExchangeRatesMethod:
+		if !p.bound() {
+			return GasGetExchangeRates
+		}
+		return GasGetExchangeRates + GasGetExchangeRatesItem*uint64(len(p.exchangeRates()))
+	case GetTwapMethod:
+		if !p.bound() {
+			return GasGetTwap
+		}
+		args, err := method.Inputs.Unpack(input[4:])
+		if err != nil {
+			return GasBase
+		}
+		stamps, err := p.historicPrices(args)
+		if err != nil {
+			return GasGetTwap
+		}
+		return GasGetTwap + GasGetTwapStamp*uint64(len(stamps))
+	default:
+		return GasBase
+	}
+}
+
+// Run executes the precompile. Errors revert the call with their message as
+// revert reason.
+func (p Precompile) Run(input []byte) ([]byte, error) {
+	bz, err := p.run(input)
+	if err != nil {
+		return revertReason(err), vm.ErrExecutionReverted
+	}
+
+	return bz, nil
+}
+
+func (p Precompile) run(input []byte) ([]byte, error) {
+	if !p.bound() {
+		return nil, ErrNoContext
+	}
+
+	method, err := p.method(input)
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case GetExchangeRateMethod:
+		return p.getExchangeRate(method, args)
+	case GetExchangeRatesMethod:
+		return p.getExchangeRates(method)
+	case GetTwapMethod:
+		return p.getTwap(method, args)
+	default:
+		return nil, fmt.Errorf("unknown method %s", method.Name)
+	}
+}
+
+// method returns the ABI method called by the input
+func (p Precompile) method(input []byte) (*abi.Method, error) {
+	if len(input) < 4 {
+		return nil, errors.New("invalid input length")
+	}
+
+	return p.abi.MethodById(input[:4])
+}
+
+// bound returns true if the precompile is bound to a context
+func (p Precompile) bound() bool {
+	return p.ctx.MultiStore() != nil
+}
+
+// exchangeRates returns the exchange rate entries of every denom
+func (p Precompile) exchangeRates() map[string]types.ExchangeRate {
+	rates := make(map[string]types.ExchangeRate)
+	p.keeper.IterateExchangeRateEntries(p.ctx, func(denom string, exchangeRate types.ExchangeRate) (stop bool) {
+		rates[denom] = exchangeRate
+		return false
+	})
+
+	return rates
+}
+
+func (p Precompile) getExchangeRate(method *abi.Method, args []interface{}) ([]byte, error) {
+	denom := args[0].(string)
+
+	exchangeRate, err := p.keeper.GetExchangeRateEntry(p.ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(exchangeRate.Rate.BigInt(), unixTime(exchangeRate.LastUpdateTime))
+}
+
+func (p Precompile) getExchangeRates(method *abi.Method) ([]byte, error) {
+	rates := p.exchangeRates()
+
+	exchangeRates := make([]ExchangeRate, 0, len(rates))
+	for denom, exchangeRate := range rates {
+		exchangeRates = append(exchangeRates, ExchangeRate{
+			Denom:       denom,
+			Rate:        exchangeRate.Rate.BigInt(),
+			LastUpdated: unixTime(exchangeRate.LastUpdateTime),
+		})
+	}
+	sort.Slice(exchangeRates, func(i, j int) bool {
+		return exchangeRates[i].Denom < exchangeRates[j].Denom
+	})
+
+	return method.Outputs.Pack(exchangeRates)
+}
+
+// historicPrices returns the historic price stamps read by a getTwap call
+// with the given arguments
+func (p Precompile) historicPrices(args []interface{}) (types.PriceStamps, error) {
+	denom := args[0].(string)
+	lookbackSeconds := args[1].(uint64)
+
+	if lookbackSeconds > math.MaxInt64/uint64(time.Second) {
+		return nil, fmt.Errorf("lookback of %d seconds is too long", lookbackSeconds)
+	}
+
+	return p.keeper.GetHistoricPrices(p.ctx, denom, time.Duration(lookbackSeconds)*time.Second), nil
+}
+
+func (p Precompile) getTwap(method *abi.Method, args []interface{}) ([]byte, error) {
+	stamps, err := p.historicPrices(args)
+	if err != nil {
+		return nil, err
+	}
+	if len(stamps) == 0 {
+		return nil, types.ErrNoHistoricPrice.Wrap(args[0].(string))
+	}
+
+	twap, err := stamps.TWAP(p.ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(twap.BigInt(), unixTime(stamps[len(stamps)-1].BlockTime))
+}
+
+// unixTime returns the unix time in seconds of t, or zero for the zero time
+func unixTime(t time.Time) uint64 {
+	if t.IsZero() || t.Unix() < 0 {
+		return 0
+	}
+
+	return uint64(t.Unix())
+}
+
+// revertReason returns the error message ABI encoded as an Error(string)
+// revert reason
+func revertReason(err error) []byte {
+	bz, packErr := stringArguments.Pack(err.Error())
+	if packErr != nil {
+		return nil
+	}
+
+	return append(append([]byte{}, revertSelector...), bz...)
+}
+
+func mustNewType(typeName string) abi.Type {
+	typ, err := abi.NewType(typeName, "", nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return typ
+}
@@ -0,0 +1,191 @@
+package precompile_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"sidechain/contracts"
+	"sidechain/x/oracle/keeper"
+	"sidechain/x/oracle/precompile"
+	"sidechain/x/oracle/types"
+)
+
+func setupPrecompile(t *testing.T) (keeper.TestInput, vm.PrecompiledContract) {
+	input := keeper.CreateTestInput(t)
+
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomA, sdk.NewDecWithPrec(15, 1))
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomB, sdk.NewDec(2))
+
+	now := input.Ctx.BlockTime()
+	input.OracleKeeper.SetHistoricPrice(input.Ctx, types.NewPriceStamp(types.TestDenomA, sdk.NewDec(1), 1, now.Add(-2*time.Minute)))
+	input.OracleKeeper.SetHistoricPrice(input.Ctx, types.NewPriceStamp(types.TestDenomA, sdk.NewDec(3), 2, now.Add(-time.Minute)))
+
+	return input, precompile.NewPrecompile(input.OracleKeeper).WithContext(input.Ctx)
+}
+
+func call(t *testing.T, p vm.PrecompiledContract, method string, args ...interface{}) ([]interface{}, error) {
+	oracleABI := contracts.IOracleContract.ABI
+
+	input, err := oracleABI.Pack(method, args...)
+	require.NoError(t, err)
+	require.NotZero(t, p.RequiredGas(input))
+
+	bz, err := p.Run(input)
+	if err != nil {
+		require.ErrorIs(t, err, vm.ErrExecutionReverted)
+		reason, unpackErr := abi.UnpackRevert(bz)
+		require.NoError(t, unpackErr)
+		require.NotEmpty(t, reason)
+		return nil, err
+	}
+
+	return oracleABI.Unpack(method, bz)
+}
+
+func TestGetExchangeRate(t *testing.T) {
+	input, p := setupPrecompile(t)
+
+	res, err := call(t, p, precompile.GetExchangeRateMethod, types.TestDenomA)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1_500_000_000_000_000_000), res[0])
+	require.Equal(t, uint64(input.Ctx.BlockTime().Unix()), res[1])
+
+	_, err = call(t, p, precompile.GetExchangeRateMethod, types.TestDenomC)
+	require.Error(t, err)
+}
+
+func TestGetExchangeRates(t *testing.T) {
+	_, p := setupPrecompile(t)
+
+	oracleABI := contracts.IOracleContract.ABI
+	input, err := oracleABI.Pack(precompile.GetExchangeRatesMethod)
+	require.NoError(t, err)
+	require.Equal(t, precompile.GasGetExchangeRates+2*precompile.GasGetExchangeRatesItem, p.RequiredGas(input))
+
+	bz, err := p.Run(input)
+	require.NoError(t, err)
+
+	var rates []precompile.ExchangeRate
+	require.NoError(t, oracleABI.UnpackIntoInterface(&rates, precompile.GetExchangeRatesMethod, bz))
+	require.Len(t, rates, 2)
+	require.Equal(t, types.TestDenomA, rates[0].Denom)
+	require.Equal(t, sdk.NewDecWithPrec(15, 1).BigInt(), rates[0].Rate)
+	require.Equal(t, types.TestDenomB, rates[1].Denom)
+	require.Equal(t, sdk.NewDec(2).BigInt(), rates[1].Rate)
+}
+
+func TestGetTwap(t *testing.T) {
+	input, p := setupPrecompile(t)
+
+	// 1 for a minute then 3 for a minute
+	res, err := call(t, p, precompile.GetTwapMethod, types.TestDenomA, uint64(0))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2).BigInt(), res[0])
+	require.Equal(t, uint64(input.Ctx.BlockTime().Add(-time.Minute).Unix()), res[1])
+
+	res, err = call(t, p, precompile.GetTwapMethod, types.TestDenomA, uint64(60))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3).BigInt(), res[0])
+
+	_, err = call(t, p, precompile.GetTwapMethod, types.TestDenomB, uint64(0))
+	require.Error(t, err)
+}
+
+func TestGetTwapGas(t *testing.T) {
+	_, p := setupPrecompile(t)
+	oracleABI := contracts.IOracleContract.ABI
+
+	// the gas scales with the number of stamps read
+	input, err := oracleABI.Pack(precompile.GetTwapMethod, types.TestDenomA, uint64(0))
+	require.NoError(t, err)
+	require.Equal(t, precompile.GasGetTwap+2*precompile.GasGetTwapStamp, p.RequiredGas(input))
+
+	input, err = oracleABI.Pack(precompile.GetTwapMethod, types.TestDenomA, uint64(60))
+	require.NoError(t, err)
+	require.Equal(t, precompile.GasGetTwap+precompile.GasGetTwapStamp, p.RequiredGas(input))
+
+	input, err = oracleABI.Pack(precompile.GetTwapMethod, types.TestDenomB, uint64(0))
+	require.NoError(t, err)
+	require.Equal(t, precompile.GasGetTwap, p.RequiredGas(input))
+
+	// malformed arguments are charged the base cost
+	require.Equal(t, precompile.GasBase, p.RequiredGas(input[:4]))
+}
+
+func TestReadsStore(t *testing.T) {
+	input, p := setupPrecompile(t)
+
+	// writes made after the precompile is bound are read by its next calls
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomC, sdk.NewDec(4))
+	res, err := call(t, p, precompile.GetExchangeRateMethod, types.TestDenomC)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(4).BigInt(), res[0])
+
+	// a cleared denom is not returned anymore
+	input.OracleKeeper.ClearDenom(input.Ctx, types.TestDenomA)
+	_, err = call(t, p, precompile.GetExchangeRateMethod, types.TestDenomA)
+	require.Error(t, err)
+	_, err = call(t, p, precompile.GetTwapMethod, types.TestDenomA, uint64(0))
+	require.Error(t, err)
+
+	// a precompile bound to a context at an earlier state reads that state
+	cacheCtx, _ := input.Ctx.CacheContext()
+	input.OracleKeeper.SetExchangeRate(cacheCtx, types.TestDenomB, sdk.NewDec(5))
+	res, err = call(t, precompile.NewPrecompile(input.OracleKeeper).WithContext(cacheCtx), precompile.GetExchangeRateMethod, types.TestDenomB)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(5).BigInt(), res[0])
+	res, err = call(t, p, precompile.GetExchangeRateMethod, types.TestDenomB)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2).BigInt(), res[0])
+}
+
+func TestRemoveWhitelistDenom(t *testing.T) {
+	input, p := setupPrecompile(t)
+	msgServer := keeper.NewMsgServerImpl(input.OracleKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{{Name: types.TestDenomA}, {Name: types.TestDenomB}})
+
+	// the removed denom is no longer returned in the same block
+	_, err := msgServer.RemoveWhitelistDenom(sdk.WrapSDKContext(input.Ctx), types.NewMsgRemoveWhitelistDenom(authority, types.TestDenomA))
+	require.NoError(t, err)
+
+	_, err = call(t, p, precompile.GetExchangeRateMethod, types.TestDenomA)
+	require.Error(t, err)
+	_, err = call(t, p, precompile.GetTwapMethod, types.TestDenomA, uint64(0))
+	require.Error(t, err)
+
+	res, err := call(t, p, precompile.GetExchangeRateMethod, types.TestDenomB)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2).BigInt(), res[0])
+}
+
+func TestRunWithoutContext(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	p := precompile.NewPrecompile(input.OracleKeeper)
+
+	bz, err := contracts.IOracleContract.ABI.Pack(precompile.GetExchangeRatesMethod)
+	require.NoError(t, err)
+
+	_, err = p.Run(bz)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+}
+
+func TestRunInvalidInput(t *testing.T) {
+	_, p := setupPrecompile(t)
+
+	for _, input := range [][]byte{{0x01}, {0x01, 0x02, 0x03, 0x04}} {
+		require.Equal(t, precompile.GasBase, p.RequiredGas(input))
+
+		_, err := p.Run(input)
+		require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	}
+}
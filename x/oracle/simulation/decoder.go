@@ -19,7 +19,7 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
 		case bytes.Equal(kvA.Key[:1], types.ExchangeRateKey):
-			var exchangeRateA, exchangeRateB sdk.DecProto
+			var exchangeRateA, exchangeRateB types.ExchangeRate
 			cdc.MustUnmarshal(kvA.Value, &exchangeRateA)
 			cdc.MustUnmarshal(kvB.Value, &exchangeRateB)
 			return fmt.Sprintf("%v\n%v", exchangeRateA, exchangeRateB)
@@ -40,6 +40,20 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &voteA)
 			cdc.MustUnmarshal(kvB.Value, &voteB)
 			return fmt.Sprintf("%v\n%v", voteA, voteB)
+		case bytes.Equal(kvA.Key[:1], types.HistoricPriceKey):
+			var stampA, stampB types.PriceStamp
+			cdc.MustUnmarshal(kvA.Value, &stampA)
+			cdc.MustUnmarshal(kvB.Value, &stampB)
+			return fmt.Sprintf("%v\n%v", stampA, stampB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorPerformanceKey),
+			bytes.Equal(kvA.Key[:1], types.ValidatorPerformancePeriodKey):
+			var performanceA, performanceB types.ValidatorPerformance
+			cdc.MustUnmarshal(kvA.Value, &performanceA)
+			cdc.MustUnmarshal(kvB.Value, &performanceB)
+			return fmt.Sprintf("%v\n%v", performanceA, performanceB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorPerformanceHeightKey),
+			bytes.Equal(kvA.Key[:1], types.HistoricPriceHeightKey):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
 		default:
 			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
 		}
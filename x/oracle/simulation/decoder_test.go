@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/require"
@@ -29,8 +30,9 @@ func TestDecodeDistributionStore(t *testing.T) {
 	cdc := keeper.MakeTestCodec(t)
 	dec := sim.NewDecodeStore(cdc)
 
-	exchangeRate := sdk.NewDecWithPrec(1234, 1)
+	exchangeRate := types.NewExchangeRate(sdk.NewDecWithPrec(1234, 1), 10, time.Now().UTC())
 	missCounter := uint64(23)
+	priceStamp := types.NewPriceStamp(denomA, sdk.NewDecWithPrec(1234, 1), 10, time.Now().UTC())
 
 	aggregatePrevote := types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash([]byte("12345")), valAddr, 123)
 	aggregateVote := types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
@@ -40,11 +42,13 @@ func TestDecodeDistributionStore(t *testing.T) {
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
-			{Key: types.ExchangeRateKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: exchangeRate})},
+			{Key: types.ExchangeRateKey, Value: cdc.MustMarshal(&exchangeRate)},
 			{Key: types.FeederDelegationKey, Value: feederAddr.Bytes()},
 			{Key: types.MissCounterKey, Value: cdc.MustMarshal(&gogotypes.UInt64Value{Value: missCounter})},
 			{Key: types.AggregateExchangeRatePrevoteKey, Value: cdc.MustMarshal(&aggregatePrevote)},
 			{Key: types.AggregateExchangeRateVoteKey, Value: cdc.MustMarshal(&aggregateVote)},
+			{Key: types.HistoricPriceKey, Value: cdc.MustMarshal(&priceStamp)},
+			{Key: types.HistoricPriceHeightKey, Value: []byte{1}},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -58,6 +62,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"MissCounter", fmt.Sprintf("%v\n%v", missCounter, missCounter)},
 		{"AggregatePrevote", fmt.Sprintf("%v\n%v", aggregatePrevote, aggregatePrevote)},
 		{"AggregateVote", fmt.Sprintf("%v\n%v", aggregateVote, aggregateVote)},
+		{"HistoricPrice", fmt.Sprintf("%v\n%v", priceStamp, priceStamp)},
+		{"HistoricPriceHeight", fmt.Sprintf("%v\n%v", []byte{1}, []byte{1})},
 		{"other", ""},
 	}
 
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -22,6 +23,12 @@ const (
 	slashFractionKey            = "slash_fraction"
 	slashWindowKey              = "slash_window"
 	minValidPerWindowKey        = "min_valid_per_window"
+	maxPriceAgeKey              = "max_price_age"
+	historicStampPeriodKey      = "historic_stamp_period"
+	maximumPriceStampsKey       = "maximum_price_stamps"
+	performanceWindowKey        = "performance_window"
+	slashGracePeriodKey         = "slash_grace_period"
+	slashWarningOnlyKey         = "slash_warning_only"
 )
 
 // GenVotePeriod randomized VotePeriod
@@ -59,6 +66,37 @@ func GenMinValidPerWindow(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(500)), 3))
 }
 
+// GenMaxPriceAge randomized MaxPriceAge
+func GenMaxPriceAge(r *rand.Rand) time.Duration {
+	return time.Duration(r.Intn(3600)) * time.Second
+}
+
+// GenHistoricStampPeriod randomized HistoricStampPeriod, a multiple of the
+// vote period
+func GenHistoricStampPeriod(r *rand.Rand, votePeriod uint64) uint64 {
+	return votePeriod * uint64(1+r.Intn(10))
+}
+
+// GenMaximumPriceStamps randomized MaximumPriceStamps
+func GenMaximumPriceStamps(r *rand.Rand) uint64 {
+	return uint64(r.Intn(1000))
+}
+
+// GenPerformanceWindow randomized PerformanceWindow
+func GenPerformanceWindow(r *rand.Rand) uint64 {
+	return uint64(100 + r.Intn(100000))
+}
+
+// GenSlashGracePeriod randomized SlashGracePeriod
+func GenSlashGracePeriod(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100000))
+}
+
+// GenSlashWarningOnly randomized SlashWarningOnly
+func GenSlashWarningOnly(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
 // RandomizedGenState generates a random GenesisState for oracle
 func RandomizedGenState(simState *module.SimulationState) {
 	var votePeriod uint64
@@ -103,6 +141,42 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { minValidPerWindow = GenMinValidPerWindow(r) },
 	)
 
+	var maxPriceAge time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxPriceAgeKey, &maxPriceAge, simState.Rand,
+		func(r *rand.Rand) { maxPriceAge = GenMaxPriceAge(r) },
+	)
+
+	var historicStampPeriod uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, historicStampPeriodKey, &historicStampPeriod, simState.Rand,
+		func(r *rand.Rand) { historicStampPeriod = GenHistoricStampPeriod(r, votePeriod) },
+	)
+
+	var maximumPriceStamps uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maximumPriceStampsKey, &maximumPriceStamps, simState.Rand,
+		func(r *rand.Rand) { maximumPriceStamps = GenMaximumPriceStamps(r) },
+	)
+
+	var performanceWindow uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, performanceWindowKey, &performanceWindow, simState.Rand,
+		func(r *rand.Rand) { performanceWindow = GenPerformanceWindow(r) },
+	)
+
+	var slashGracePeriod uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, slashGracePeriodKey, &slashGracePeriod, simState.Rand,
+		func(r *rand.Rand) { slashGracePeriod = GenSlashGracePeriod(r) },
+	)
+
+	var slashWarningOnly bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, slashWarningOnlyKey, &slashWarningOnly, simState.Rand,
+		func(r *rand.Rand) { slashWarningOnly = GenSlashWarningOnly(r) },
+	)
+
 	oracleGenesis := types.NewGenesisState(
 		types.Params{
 			VotePeriod:               votePeriod,
@@ -113,12 +187,23 @@ func RandomizedGenState(simState *module.SimulationState) {
 			SlashFraction:            slashFraction,
 			SlashWindow:              slashWindow,
 			MinValidPerWindow:        minValidPerWindow,
+			MaxPriceAge:              maxPriceAge,
+			HistoricStampPeriod:      historicStampPeriod,
+			MaximumPriceStamps:       maximumPriceStamps,
+			QuoteDenom:               types.DefaultQuoteDenom,
+			PerformanceWindow:        performanceWindow,
+			SlashGracePeriod:         slashGracePeriod,
+			SlashWarningOnly:         slashWarningOnly,
 		},
 		[]types.ExchangeRateTuple{},
 		[]types.FeederDelegation{},
 		[]types.MissCounter{},
 		[]types.AggregateExchangeRatePrevote{},
 		[]types.AggregateExchangeRateVote{},
+		[]types.PriceStamp{},
+		[]types.PriceHalt{},
+		[]types.ValidatorPerformance{},
+		[]types.ValidatorPerformance{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis.Params, "", " ")
@@ -3,48 +3,33 @@ package simulation
 // DONTCOVER
 
 import (
-	"fmt"
 	"math/rand"
 
-	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
-	"github.com/cosmos/cosmos-sdk/x/simulation"
-
 	"sidechain/x/oracle/types"
 )
 
-// ParamChanges defines the parameters that can be modified by param change proposals
-// on the simulation
-func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
-	return []simtypes.ParamChange{
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeyVotePeriod),
-			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%d\"", GenVotePeriod(r))
-			},
-		),
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeyVoteThreshold),
-			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenVoteThreshold(r))
-			},
-		),
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRewardBand),
-			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenRewardBand(r))
-			},
-		),
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRewardDistributionWindow),
-			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%d\"", GenRewardDistributionWindow(r))
-			},
-		),
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeySlashFraction),
-			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenSlashFraction(r))
-			},
-		),
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeySlashWindow),
-			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%d\"", GenSlashWindow(r))
-			},
-		),
+// GenParams returns randomized oracle parameters with an empty whitelist. The
+// parameters are kept in the module store instead of the params subspace, so
+// they are changed with a MsgUpdateParams from the governance account rather
+// than with a param change proposal.
+func GenParams(r *rand.Rand) types.Params {
+	votePeriod := GenVotePeriod(r)
+
+	return types.Params{
+		VotePeriod:               votePeriod,
+		VoteThreshold:            GenVoteThreshold(r),
+		RewardBand:               GenRewardBand(r),
+		RewardDistributionWindow: GenRewardDistributionWindow(r),
+		Whitelist:                types.DenomList{},
+		SlashFraction:            GenSlashFraction(r),
+		SlashWindow:              GenSlashWindow(r),
+		MinValidPerWindow:        GenMinValidPerWindow(r),
+		MaxPriceAge:              GenMaxPriceAge(r),
+		HistoricStampPeriod:      GenHistoricStampPeriod(r, votePeriod),
+		MaximumPriceStamps:       GenMaximumPriceStamps(r),
+		QuoteDenom:               types.DefaultQuoteDenom,
+		PerformanceWindow:        GenPerformanceWindow(r),
+		SlashGracePeriod:         GenSlashGracePeriod(r),
+		SlashWarningOnly:         GenSlashWarningOnly(r),
 	}
 }
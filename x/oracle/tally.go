@@ -6,31 +6,33 @@ import (
 	"sidechain/x/oracle/types"
 )
 
-// Tally calculates the median and returns it. Sets the set of voters to be rewarded, i.e. voted within
-// a reasonable spread from the weighted median to the store
+// Tally aggregates the exchange rate of the ballot with the given method and returns it. Sets the set of
+// voters to be rewarded, i.e. voted within a reasonable spread from the exchange rate to the store. The
+// spread is the larger of the reward band and the standard deviation of the ballot around the exchange rate
 // CONTRACT: pb must be sorted
 func Tally(ctx sdk.Context,
 	pb types.ExchangeRateBallot,
+	aggregation types.AggregationMethod,
 	rewardBand sdk.Dec,
 	validatorClaimMap map[string]types.Claim,
 ) (sdk.Dec, error) {
-	weightedMedian, err := pb.WeightedMedian()
+	exchangeRate, err := pb.Aggregate(aggregation)
 	if err != nil {
 		return sdk.ZeroDec(), err
 	}
 
-	standardDeviation, err := pb.StandardDeviation()
+	standardDeviation, err := pb.StandardDeviationAround(exchangeRate)
 	if err != nil {
 		return sdk.ZeroDec(), err
 	}
 
-	rewardSpread := weightedMedian.Mul(rewardBand.QuoInt64(2))
+	rewardSpread := exchangeRate.Mul(rewardBand.QuoInt64(2))
 	rewardSpread = sdk.MaxDec(rewardSpread, standardDeviation)
 
 	for _, vote := range pb {
 		// Filter ballot winners & abstain voters
-		if (vote.ExchangeRate.GTE(weightedMedian.Sub(rewardSpread)) &&
-			vote.ExchangeRate.LTE(weightedMedian.Add(rewardSpread))) ||
+		if (vote.ExchangeRate.GTE(exchangeRate.Sub(rewardSpread)) &&
+			vote.ExchangeRate.LTE(exchangeRate.Add(rewardSpread))) ||
 			!vote.ExchangeRate.IsPositive() {
 			key := vote.Voter.String()
 			claim := validatorClaimMap[key]
@@ -40,5 +42,5 @@ func Tally(ctx sdk.Context,
 		}
 	}
 
-	return weightedMedian, nil
+	return exchangeRate, nil
 }
@@ -66,7 +66,9 @@ func TestFuzz_Tally(t *testing.T) {
 	var rewardBand sdk.Dec
 	f.Fuzz(&rewardBand)
 
-	require.NotPanics(t, func() {
-		oracle.Tally(input.Ctx, ballot, rewardBand, claimMap)
-	})
+	for method := range types.AggregationMethod_name {
+		require.NotPanics(t, func() {
+			oracle.Tally(input.Ctx, ballot, types.AggregationMethod(method), rewardBand, claimMap)
+		})
+	}
 }
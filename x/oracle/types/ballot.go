@@ -1,11 +1,22 @@
 package types
 
 import (
+	"fmt"
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Aggregation method parameters
+var (
+	// TrimmedMeanTrimFraction is the fraction of the voting power discarded on
+	// each side of the ballot by the trimmed weighted mean
+	TrimmedMeanTrimFraction = sdk.NewDecWithPrec(25, 2)
+	// MADOutlierThreshold is the number of median absolute deviations a vote
+	// may deviate from the weighted median before being discarded as outlier
+	MADOutlierThreshold = sdk.NewDec(3)
+)
+
 // NOTE: we don't need to implement proto interface on this file
 //       these are not used in store or rpc response
 
@@ -74,6 +85,95 @@ func (pb ExchangeRateBallot) WeightedMedian() (sdk.Dec, error) {
 	return sdk.ZeroDec(), nil
 }
 
+// Aggregate returns the exchange rate of the ballot aggregated with the given
+// method.
+// CONTRACT: ballot must be sorted
+func (pb ExchangeRateBallot) Aggregate(method AggregationMethod) (sdk.Dec, error) {
+	switch method {
+	case AGGREGATION_METHOD_WEIGHTED_MEDIAN:
+		return pb.WeightedMedian()
+	case AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN:
+		return pb.TrimmedWeightedMean(TrimmedMeanTrimFraction)
+	case AGGREGATION_METHOD_MEDIAN_MAD:
+		return pb.MADFilteredMedian(MADOutlierThreshold)
+	default:
+		return sdk.ZeroDec(), fmt.Errorf("unknown aggregation method %d", method)
+	}
+}
+
+// TrimmedWeightedMean returns the mean weighted by the power of the
+// ExchangeRateVote, after discarding the given fraction of the total power on
+// each side of the ballot.
+// CONTRACT: ballot must be sorted
+func (pb ExchangeRateBallot) TrimmedWeightedMean(trimFraction sdk.Dec) (sdk.Dec, error) {
+	if !sort.IsSorted(pb) {
+		return sdk.ZeroDec(), ErrBallotNotSorted
+	}
+
+	totalPower := sdk.NewDec(pb.Power())
+	lower := totalPower.Mul(trimFraction)
+	upper := totalPower.Sub(lower)
+
+	sum := sdk.ZeroDec()
+	weight := sdk.ZeroDec()
+	pivot := sdk.ZeroDec()
+	for _, v := range pb {
+		start := pivot
+		pivot = pivot.Add(sdk.NewDec(v.Power))
+
+		// power of the vote within the kept range of the ballot
+		kept := sdk.MinDec(pivot, upper).Sub(sdk.MaxDec(start, lower))
+		if kept.IsPositive() {
+			sum = sum.Add(v.ExchangeRate.Mul(kept))
+			weight = weight.Add(kept)
+		}
+	}
+
+	if !weight.IsPositive() {
+		return sdk.ZeroDec(), nil
+	}
+
+	return sum.Quo(weight), nil
+}
+
+// MADFilteredMedian returns the median weighted by the power of the
+// ExchangeRateVote, after discarding the votes deviating from the weighted
+// median by more than threshold median absolute deviations.
+// CONTRACT: ballot must be sorted
+func (pb ExchangeRateBallot) MADFilteredMedian(threshold sdk.Dec) (sdk.Dec, error) {
+	median, err := pb.WeightedMedian()
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	deviations := make(ExchangeRateBallot, 0, len(pb))
+	for _, v := range pb {
+		if v.Power > 0 {
+			deviations = append(deviations, NewVoteForTally(v.ExchangeRate.Sub(median).Abs(), v.Denom, v.Voter, v.Power))
+		}
+	}
+	sort.Sort(deviations)
+
+	mad, err := deviations.WeightedMedian()
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	maxDeviation := mad.Mul(threshold)
+	inliers := make(ExchangeRateBallot, 0, len(pb))
+	for _, v := range pb {
+		if v.Power > 0 && v.ExchangeRate.Sub(median).Abs().LTE(maxDeviation) {
+			inliers = append(inliers, v)
+		}
+	}
+
+	if len(inliers) == 0 {
+		return median, nil
+	}
+
+	return inliers.WeightedMedian()
+}
+
 // StandardDeviation returns the standard deviation by the power of the ExchangeRateVote.
 func (pb ExchangeRateBallot) StandardDeviation() (sdk.Dec, error) {
 	if len(pb) == 0 {
@@ -85,6 +185,16 @@ func (pb ExchangeRateBallot) StandardDeviation() (sdk.Dec, error) {
 		return sdk.ZeroDec(), err
 	}
 
+	return pb.StandardDeviationAround(median)
+}
+
+// StandardDeviationAround returns the standard deviation of the exchange rates
+// of the ballot around the given exchange rate.
+func (pb ExchangeRateBallot) StandardDeviationAround(exchangeRate sdk.Dec) (sdk.Dec, error) {
+	if len(pb) == 0 {
+		return sdk.ZeroDec(), nil
+	}
+
 	sum := sdk.ZeroDec()
 	ballotLength := int64(len(pb))
 	for _, v := range pb {
@@ -94,7 +204,7 @@ func (pb ExchangeRateBallot) StandardDeviation() (sdk.Dec, error) {
 					ballotLength--
 				}
 			}()
-			deviation := v.ExchangeRate.Sub(median)
+			deviation := v.ExchangeRate.Sub(exchangeRate)
 			sum = sum.Add(deviation.Mul(deviation))
 		}()
 	}
@@ -187,6 +187,123 @@ func TestPBWeightedMedian(t *testing.T) {
 	}
 }
 
+func TestPBTrimmedWeightedMean(t *testing.T) {
+	tests := []struct {
+		inputs  []int64
+		weights []int64
+		mean    sdk.Dec
+		panic   bool
+	}{
+		{
+			// Lowest and highest votes trimmed
+			[]int64{1, 2, 3, 4},
+			[]int64{1, 1, 1, 1},
+			sdk.NewDecWithPrec(25, 1),
+			false,
+		},
+		{
+			// Votes partially trimmed
+			[]int64{1, 10, 11, 12, 1000},
+			[]int64{1, 1, 1, 1, 1},
+			sdk.NewDec(11),
+			false,
+		},
+		{
+			// Supermajority one number
+			[]int64{1, 2, 10, 100000},
+			[]int64{1, 1, 100, 1},
+			sdk.NewDec(10),
+			false,
+		},
+		{
+			// No votes
+			[]int64{},
+			[]int64{},
+			sdk.NewDec(0),
+			false,
+		},
+		{
+			// not sorted panic
+			[]int64{2, 1, 10, 100000},
+			[]int64{1, 1, 100, 1},
+			sdk.NewDec(10),
+			true,
+		},
+	}
+
+	for _, tc := range tests {
+		pb := types.ExchangeRateBallot{}
+		for i, input := range tc.inputs {
+			valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+			pb = append(pb, types.NewVoteForTally(sdk.NewDec(input), types.TestDenomD, valAddr, tc.weights[i]))
+		}
+
+		mean, err := pb.TrimmedWeightedMean(types.TrimmedMeanTrimFraction)
+
+		if tc.panic {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, tc.mean, mean)
+		}
+	}
+}
+
+func TestPBMADFilteredMedian(t *testing.T) {
+	tests := []struct {
+		inputs  []int64
+		weights []int64
+		median  sdk.Dec
+		panic   bool
+	}{
+		{
+			// Outliers discarded on both sides
+			[]int64{90, 100, 101, 102, 1000, 1001},
+			[]int64{1, 1, 1, 1, 1, 1},
+			sdk.NewDec(100),
+			false,
+		},
+		{
+			// Zero median absolute deviation
+			[]int64{5, 5, 5, 7},
+			[]int64{1, 1, 1, 1},
+			sdk.NewDec(5),
+			false,
+		},
+		{
+			// No votes
+			[]int64{},
+			[]int64{},
+			sdk.NewDec(0),
+			false,
+		},
+		{
+			// not sorted panic
+			[]int64{2, 1, 10, 100000},
+			[]int64{1, 1, 100, 1},
+			sdk.NewDec(10),
+			true,
+		},
+	}
+
+	for _, tc := range tests {
+		pb := types.ExchangeRateBallot{}
+		for i, input := range tc.inputs {
+			valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+			pb = append(pb, types.NewVoteForTally(sdk.NewDec(input), types.TestDenomD, valAddr, tc.weights[i]))
+		}
+
+		median, err := pb.MADFilteredMedian(types.MADOutlierThreshold)
+
+		if tc.panic {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, tc.median, median)
+		}
+	}
+}
+
 func TestPBStandardDeviation(t *testing.T) {
 	tests := []struct {
 		inputs            []float64
@@ -249,6 +366,32 @@ func TestPBStandardDeviation(t *testing.T) {
 	}
 }
 
+func TestPBStandardDeviationAround(t *testing.T) {
+	pb := types.ExchangeRateBallot{}
+	for _, rate := range []int64{1, 1, 1, 6, 7, 7} {
+		pb = append(pb, types.NewVoteForTally(sdk.NewDec(rate), types.TestDenomD, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 10))
+	}
+
+	// around the weighted median, the same as StandardDeviation
+	median, err := pb.WeightedMedian()
+	require.NoError(t, err)
+	sd, err := pb.StandardDeviation()
+	require.NoError(t, err)
+	sdAround, err := pb.StandardDeviationAround(median)
+	require.NoError(t, err)
+	require.Equal(t, sd, sdAround)
+
+	// around the mean of 3.83, the smallest of the deviations
+	sdAround, err = pb.StandardDeviationAround(sdk.MustNewDecFromStr("3.833333333333333333"))
+	require.NoError(t, err)
+	require.True(t, sdAround.LT(sd))
+	require.Equal(t, "2.852873794770614961", sdAround.String())
+
+	sdAround, err = types.ExchangeRateBallot{}.StandardDeviationAround(sdk.OneDec())
+	require.NoError(t, err)
+	require.True(t, sdAround.IsZero())
+}
+
 func TestPBStandardDeviationOverflow(t *testing.T) {
 	valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
 	exchangeRate, err := sdk.NewDecFromStr("100000000000000000000000000000000000000000000000000000000.0")
@@ -14,6 +14,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAggregateExchangeRatePrevote{}, "oracle/MsgAggregateExchangeRatePrevote", nil)
 	cdc.RegisterConcrete(&MsgAggregateExchangeRateVote{}, "oracle/MsgAggregateExchangeRateVote", nil)
 	cdc.RegisterConcrete(&MsgDelegateFeedConsent{}, "oracle/MsgDelegateFeedConsent", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "oracle/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgAddWhitelistDenom{}, "oracle/MsgAddWhitelistDenom", nil)
+	cdc.RegisterConcrete(&MsgRemoveWhitelistDenom{}, "oracle/MsgRemoveWhitelistDenom", nil)
+	cdc.RegisterConcrete(&MsgClearPriceHalt{}, "oracle/MsgClearPriceHalt", nil)
 }
 
 // RegisterInterfaces registers the x/oracle interfaces types with the interface registry
@@ -22,6 +26,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDelegateFeedConsent{},
 		&MsgAggregateExchangeRatePrevote{},
 		&MsgAggregateExchangeRateVote{},
+		&MsgUpdateParams{},
+		&MsgAddWhitelistDenom{},
+		&MsgRemoveWhitelistDenom{},
+		&MsgClearPriceHalt{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
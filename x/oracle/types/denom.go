@@ -1,9 +1,12 @@
 package types
 
 import (
+	"fmt"
 	"strings"
 
 	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // String implements fmt.Stringer interface
@@ -14,7 +17,49 @@ func (d Denom) String() string {
 
 // Equal implements equal interface
 func (d Denom) Equal(d1 *Denom) bool {
-	return d.Name == d1.Name
+	if d.Name != d1.Name || d.Aggregation != d1.Aggregation {
+		return false
+	}
+
+	if d.DeviationLookback != d1.DeviationLookback {
+		return false
+	}
+
+	return decPtrEqual(d.RewardBand, d1.RewardBand) && decPtrEqual(d.MaxDeviation, d1.MaxDeviation)
+}
+
+// decPtrEqual returns whether both decimals are unset or equal
+func decPtrEqual(d, d1 *sdk.Dec) bool {
+	if d == nil || d1 == nil {
+		return d == nil && d1 == nil
+	}
+
+	return d.Equal(*d1)
+}
+
+// Validate performs a basic validation of the denom configuration
+func (d Denom) Validate() error {
+	if len(d.Name) == 0 {
+		return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+	}
+
+	if _, ok := AggregationMethod_name[int32(d.Aggregation)]; !ok {
+		return fmt.Errorf("oracle parameter Whitelist Denom %s has an invalid aggregation method %d", d.Name, d.Aggregation)
+	}
+
+	if d.RewardBand != nil && (d.RewardBand.IsNil() || d.RewardBand.GT(sdk.OneDec()) || d.RewardBand.IsNegative()) {
+		return fmt.Errorf("oracle parameter Whitelist Denom %s RewardBand must be between [0, 1]", d.Name)
+	}
+
+	if d.MaxDeviation != nil && (d.MaxDeviation.IsNil() || !d.MaxDeviation.IsPositive()) {
+		return fmt.Errorf("oracle parameter Whitelist Denom %s MaxDeviation must be positive", d.Name)
+	}
+
+	if d.DeviationLookback < 0 {
+		return fmt.Errorf("oracle parameter Whitelist Denom %s DeviationLookback must not be negative", d.Name)
+	}
+
+	return nil
 }
 
 // DenomList is array of Denom
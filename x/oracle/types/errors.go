@@ -24,4 +24,8 @@ var (
 	ErrNoAggregateVote       = sdkerrors.Register(ModuleName, 12, "no aggregate vote")
 	ErrUnknownDenom          = sdkerrors.Register(ModuleName, 13, "unknown denom")
 	ErrBallotNotSorted       = sdkerrors.Register(ModuleName, 14, "ballot not sorted")
+	ErrNoHistoricPrice       = sdkerrors.Register(ModuleName, 15, "no historic price")
+	ErrDenomWhitelisted      = sdkerrors.Register(ModuleName, 16, "denom already whitelisted")
+	ErrStaleExchangeRate     = sdkerrors.Register(ModuleName, 17, "stale exchange rate")
+	ErrPriceNotHalted        = sdkerrors.Register(ModuleName, 18, "price not halted")
 )
@@ -3,11 +3,15 @@ package types
 // Oracle module event types
 const (
 	EventTypeExchangeRateUpdate = "exchange_rate_update"
+	EventTypeExchangeRateExpire = "exchange_rate_expire"
 	EventTypePrevote            = "prevote"
 	EventTypeVote               = "vote"
 	EventTypeFeedDelegate       = "feed_delegate"
 	EventTypeAggregatePrevote   = "aggregate_prevote"
 	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypePriceHalt          = "price_halt"
+	EventTypePriceHaltClear     = "price_halt_clear"
+	EventTypeSlashWarning       = "slash_warning"
 
 	AttributeKeyDenom         = "denom"
 	AttributeKeyVoter         = "voter"
@@ -15,6 +19,9 @@ const (
 	AttributeKeyExchangeRates = "exchange_rates"
 	AttributeKeyOperator      = "operator"
 	AttributeKeyFeeder        = "feeder"
+	AttributeKeyReferenceRate = "reference_rate"
+	AttributeKeyMissCount     = "miss_count"
+	AttributeKeyValidVoteRate = "valid_vote_rate"
 
 	AttributeValueCategory = ModuleName
 )
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewExchangeRate creates an ExchangeRate instance
+func NewExchangeRate(rate sdk.Dec, lastUpdateHeight int64, lastUpdateTime time.Time) ExchangeRate {
+	return ExchangeRate{
+		Rate:             rate,
+		LastUpdateHeight: lastUpdateHeight,
+		LastUpdateTime:   lastUpdateTime,
+	}
+}
+
+// Age returns the time elapsed between the last update of the exchange rate
+// and the given block time. The rates stored before the update time was
+// tracked have the maximum age.
+func (er ExchangeRate) Age(blockTime time.Time) time.Duration {
+	if er.LastUpdateTime.IsZero() {
+		return time.Duration(math.MaxInt64)
+	}
+
+	age := blockTime.Sub(er.LastUpdateTime)
+	if age < 0 {
+		return 0
+	}
+
+	return age
+}
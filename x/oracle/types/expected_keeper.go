@@ -4,13 +4,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// StakingKeeper is expected keeper for staking module
+// SlashingKeeper is expected keeper for slashing module
 type SlashingKeeper interface {
-	Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64) // slash the validator and delegators of the validator, specifying slash fraction, offence power and offence height
-	Jail(sdk.Context, sdk.ConsAddress)                         // jail a validator
+	Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64)                                       // slash the validator and delegators of the validator, specifying slash fraction, offence power and offence height
+	Jail(sdk.Context, sdk.ConsAddress)                                                               // jail a validator
+	GetValidatorSigningInfo(sdk.Context, sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool) // get the signing info of a validator, holding the height it last bonded at
 }
 
 // StakingKeeper is expected keeper for staking module
@@ -2,8 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
@@ -12,6 +14,9 @@ func NewGenesisState(
 	feederDelegations []FeederDelegation, missCounters []MissCounter,
 	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
+	historicPrices []PriceStamp, priceHalts []PriceHalt,
+	validatorPerformances []ValidatorPerformance,
+	validatorPerformancePeriods []ValidatorPerformance,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -20,6 +25,10 @@ func NewGenesisState(
 		MissCounters:                  missCounters,
 		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
+		HistoricPrices:                historicPrices,
+		PriceHalts:                    priceHalts,
+		ValidatorPerformances:         validatorPerformances,
+		ValidatorPerformancePeriods:   validatorPerformancePeriods,
 	}
 }
 
@@ -30,11 +39,44 @@ func DefaultGenesisState() *GenesisState {
 		[]FeederDelegation{},
 		[]MissCounter{},
 		[]AggregateExchangeRatePrevote{},
-		[]AggregateExchangeRateVote{})
+		[]AggregateExchangeRateVote{},
+		[]PriceStamp{},
+		[]PriceHalt{},
+		[]ValidatorPerformance{},
+		[]ValidatorPerformance{})
 }
 
 // ValidateGenesis validates the oracle genesis state
 func ValidateGenesis(data *GenesisState) error {
+	for _, stamp := range data.HistoricPrices {
+		if len(stamp.Denom) == 0 {
+			return fmt.Errorf("historic price must have a denom")
+		}
+		if stamp.ExchangeRate.IsNil() || stamp.ExchangeRate.IsNegative() {
+			return fmt.Errorf("invalid historic price of %s at height %d: %s", stamp.Denom, stamp.BlockHeight, stamp.ExchangeRate)
+		}
+	}
+
+	for _, halt := range data.PriceHalts {
+		if len(halt.Denom) == 0 {
+			return fmt.Errorf("price halt must have a denom")
+		}
+		if halt.ExchangeRate.IsNil() || halt.ReferenceRate.IsNil() {
+			return fmt.Errorf("invalid price halt of %s at height %d", halt.Denom, halt.BlockHeight)
+		}
+	}
+
+	for _, performances := range [][]ValidatorPerformance{data.ValidatorPerformances, data.ValidatorPerformancePeriods} {
+		for _, performance := range performances {
+			if _, err := sdk.ValAddressFromBech32(performance.ValidatorAddress); err != nil {
+				return fmt.Errorf("invalid validator performance address %s: %w", performance.ValidatorAddress, err)
+			}
+			if performance.Stats.DeviationSum.IsNil() || performance.Stats.DeviationSum.IsNegative() {
+				return fmt.Errorf("invalid deviation sum of validator %s: %s", performance.ValidatorAddress, performance.Stats.DeviationSum)
+			}
+		}
+	}
+
 	return data.Params.Validate()
 }
 
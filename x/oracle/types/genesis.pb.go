@@ -32,6 +32,10 @@ type GenesisState struct {
 	MissCounters                  []MissCounter                  `protobuf:"bytes,4,rep,name=miss_counters,json=missCounters,proto3" json:"miss_counters"`
 	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,5,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
+	HistoricPrices                []PriceStamp                   `protobuf:"bytes,7,rep,name=historic_prices,json=historicPrices,proto3" json:"historic_prices"`
+	PriceHalts                    []PriceHalt                    `protobuf:"bytes,8,rep,name=price_halts,json=priceHalts,proto3" json:"price_halts"`
+	ValidatorPerformances         []ValidatorPerformance         `protobuf:"bytes,9,rep,name=validator_performances,json=validatorPerformances,proto3" json:"validator_performances"`
+	ValidatorPerformancePeriods   []ValidatorPerformance         `protobuf:"bytes,10,rep,name=validator_performance_periods,json=validatorPerformancePeriods,proto3" json:"validator_performance_periods"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -109,6 +113,34 @@ func (m *GenesisState) GetAggregateExchangeRateVotes() []AggregateExchangeRateVo
 	return nil
 }
 
+func (m *GenesisState) GetHistoricPrices() []PriceStamp {
+	if m != nil {
+		return m.HistoricPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceHalts() []PriceHalt {
+	if m != nil {
+		return m.PriceHalts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorPerformances() []ValidatorPerformance {
+	if m != nil {
+		return m.ValidatorPerformances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorPerformancePeriods() []ValidatorPerformance {
+	if m != nil {
+		return m.ValidatorPerformancePeriods
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -227,37 +259,43 @@ func init() {
 func init() { proto.RegisterFile("sidechain/oracle/genesis.proto", fileDescriptor_4963cfbbdf24900f) }
 
 var fileDescriptor_4963cfbbdf24900f = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6a, 0xdb, 0x40,
-	0x10, 0xc6, 0xad, 0xc4, 0x35, 0x74, 0x6d, 0x07, 0x67, 0xe9, 0x41, 0x18, 0xac, 0xb8, 0x2e, 0x85,
-	0x40, 0x40, 0x22, 0x2e, 0xf4, 0x1e, 0xf7, 0xef, 0xa5, 0x10, 0xd4, 0xd2, 0x42, 0xa1, 0x88, 0xb1,
-	0x34, 0x56, 0x04, 0xb6, 0x56, 0xec, 0x6c, 0x4c, 0x7a, 0xe8, 0x3b, 0xf4, 0x39, 0xfa, 0x20, 0x25,
-	0xc7, 0x1c, 0x7b, 0x6a, 0x8b, 0xfd, 0x22, 0xc5, 0xbb, 0x1b, 0x5b, 0xb5, 0xe2, 0xd2, 0x93, 0xcd,
-	0x7c, 0xbf, 0xf9, 0xbe, 0x59, 0xed, 0x0e, 0xf3, 0x28, 0x4b, 0x30, 0xbe, 0x80, 0x2c, 0x0f, 0x84,
-	0x84, 0x78, 0x8a, 0x41, 0x8a, 0x39, 0x52, 0x46, 0x7e, 0x21, 0x85, 0x12, 0xbc, 0xb3, 0xd6, 0x7d,
-	0xa3, 0x77, 0x1f, 0xa4, 0x22, 0x15, 0x5a, 0x0c, 0x56, 0xff, 0x0c, 0xd7, 0xed, 0x55, 0x7c, 0xcc,
-	0x8f, 0x95, 0xbd, 0x58, 0xd0, 0x4c, 0x50, 0x30, 0x06, 0xc2, 0x60, 0x7e, 0x3a, 0x46, 0x05, 0xa7,
-	0x41, 0x2c, 0xb2, 0xdc, 0xe8, 0x83, 0xef, 0x75, 0xd6, 0x7a, 0x65, 0x82, 0xdf, 0x2a, 0x50, 0xc8,
-	0x9f, 0xb2, 0x46, 0x01, 0x12, 0x66, 0xe4, 0x3a, 0x7d, 0xe7, 0xb8, 0x39, 0x74, 0xfd, 0xed, 0x41,
-	0xfc, 0x73, 0xad, 0x8f, 0xea, 0xd7, 0x3f, 0x8f, 0x6a, 0xa1, 0xa5, 0xf9, 0x07, 0xc6, 0x27, 0x88,
-	0x09, 0xca, 0x28, 0xc1, 0x29, 0xa6, 0xa0, 0x32, 0x91, 0x93, 0xbb, 0xd7, 0xdf, 0x3f, 0x6e, 0x0e,
-	0x07, 0x55, 0x8f, 0x97, 0x9a, 0x7d, 0xbe, 0x46, 0xad, 0xdb, 0xe1, 0x64, 0xab, 0x4e, 0x7c, 0xc2,
-	0x0e, 0xf0, 0x2a, 0xbe, 0x80, 0x3c, 0xc5, 0x48, 0x82, 0x42, 0x72, 0xf7, 0xb5, 0xe9, 0xa3, 0xaa,
-	0xe9, 0x0b, 0xcb, 0x85, 0xa0, 0xf0, 0xdd, 0x65, 0x31, 0xc5, 0x51, 0x77, 0xe5, 0xfa, 0xed, 0xd7,
-	0x11, 0xaf, 0x48, 0x14, 0xb6, 0xb1, 0x54, 0x23, 0xfe, 0x9a, 0xb5, 0x67, 0x19, 0x51, 0x14, 0x8b,
-	0xcb, 0x5c, 0xa1, 0x24, 0xb7, 0xae, 0x63, 0x7a, 0xd5, 0x98, 0x37, 0x19, 0xd1, 0x33, 0x43, 0xd9,
-	0xb1, 0x5b, 0xb3, 0x4d, 0x89, 0xf8, 0x17, 0xd6, 0x87, 0x34, 0x95, 0xab, 0x13, 0x60, 0xf4, 0xd7,
-	0xec, 0x51, 0x21, 0x71, 0x2e, 0x56, 0x67, 0xb8, 0xa7, 0xcd, 0xfd, 0xaa, 0xf9, 0xd9, 0x6d, 0x67,
-	0x79, 0xe2, 0x73, 0xd3, 0x66, 0xd3, 0x7a, 0xf0, 0x0f, 0x86, 0xb8, 0x62, 0xbd, 0x5d, 0xf1, 0x26,
-	0xbb, 0xa1, 0xb3, 0x4f, 0xfe, 0x33, 0xfb, 0xfd, 0x26, 0xb8, 0x0b, 0xbb, 0x00, 0x1a, 0x4c, 0x58,
-	0x67, 0xfb, 0x4e, 0xf9, 0x63, 0x76, 0x60, 0xdf, 0x04, 0x24, 0x89, 0x44, 0x32, 0x6f, 0xea, 0x7e,
-	0xd8, 0x36, 0xd5, 0x33, 0x53, 0xe4, 0x27, 0xec, 0x70, 0x0e, 0xd3, 0x2c, 0x01, 0x25, 0x36, 0xe4,
-	0x9e, 0x26, 0x3b, 0x6b, 0xc1, 0xc2, 0x83, 0x4f, 0xac, 0x59, 0xfa, 0xfe, 0x77, 0xf7, 0x3a, 0x77,
-	0xf7, 0xf2, 0x87, 0xac, 0x55, 0xbe, 0x62, 0x9d, 0x51, 0x0f, 0x9b, 0xa5, 0xcb, 0x1b, 0x0d, 0xaf,
-	0x17, 0x9e, 0x73, 0xb3, 0xf0, 0x9c, 0xdf, 0x0b, 0xcf, 0xf9, 0xba, 0xf4, 0x6a, 0x37, 0x4b, 0xaf,
-	0xf6, 0x63, 0xe9, 0xd5, 0x3e, 0xba, 0x9b, 0x45, 0xbb, 0xba, 0x5d, 0x35, 0xf5, 0xb9, 0x40, 0x1a,
-	0x37, 0xf4, 0x2a, 0x3d, 0xf9, 0x13, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x37, 0x7a, 0x40, 0xd3, 0x03,
-	0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xdf, 0x6a, 0x13, 0x41,
+	0x14, 0xc6, 0xb3, 0x6d, 0x6d, 0xed, 0xa4, 0xad, 0xed, 0xa0, 0xb2, 0xa4, 0x66, 0x5b, 0x23, 0x4a,
+	0xa1, 0x90, 0xa5, 0x15, 0xbc, 0x6f, 0xfc, 0x57, 0x10, 0x21, 0xa4, 0x52, 0x41, 0x90, 0x65, 0xba,
+	0x7b, 0xb2, 0x19, 0xd8, 0xdd, 0x59, 0xe6, 0x4c, 0x43, 0xbd, 0xf0, 0x1d, 0x7c, 0x0e, 0x2f, 0x7c,
+	0x8e, 0x5e, 0xf6, 0xd2, 0x2b, 0x95, 0xe4, 0x45, 0x64, 0x67, 0x76, 0x93, 0x98, 0xdd, 0x88, 0x78,
+	0xb5, 0xcb, 0xf9, 0xbe, 0xf3, 0xfb, 0xce, 0xc0, 0x9c, 0x21, 0x0e, 0xf2, 0x00, 0xfc, 0x01, 0xe3,
+	0x89, 0x2b, 0x24, 0xf3, 0x23, 0x70, 0x43, 0x48, 0x00, 0x39, 0xb6, 0x53, 0x29, 0x94, 0xa0, 0xdb,
+	0x13, 0xbd, 0x6d, 0xf4, 0xc6, 0xdd, 0x50, 0x84, 0x42, 0x8b, 0x6e, 0xf6, 0x67, 0x7c, 0x8d, 0x66,
+	0x89, 0x63, 0x3e, 0xb9, 0xec, 0xf8, 0x02, 0x63, 0x81, 0xee, 0x05, 0x43, 0x70, 0x87, 0x47, 0x17,
+	0xa0, 0xd8, 0x91, 0xeb, 0x0b, 0x9e, 0x18, 0xbd, 0xf5, 0x6d, 0x8d, 0x6c, 0xbc, 0x36, 0xc1, 0x67,
+	0x8a, 0x29, 0xa0, 0xcf, 0xc8, 0x6a, 0xca, 0x24, 0x8b, 0xd1, 0xb6, 0xf6, 0xad, 0x83, 0xfa, 0xb1,
+	0xdd, 0x9e, 0x1f, 0xa4, 0xdd, 0xd5, 0x7a, 0x67, 0xe5, 0xfa, 0xc7, 0x5e, 0xad, 0x97, 0xbb, 0xe9,
+	0x7b, 0x42, 0xfb, 0x00, 0x01, 0x48, 0x2f, 0x80, 0x08, 0x42, 0xa6, 0xb8, 0x48, 0xd0, 0x5e, 0xda,
+	0x5f, 0x3e, 0xa8, 0x1f, 0xb7, 0xca, 0x8c, 0x57, 0xda, 0xfb, 0x62, 0x62, 0xcd, 0x69, 0x3b, 0xfd,
+	0xb9, 0x3a, 0xd2, 0x3e, 0xd9, 0x82, 0x2b, 0x7f, 0xc0, 0x92, 0x10, 0x3c, 0xc9, 0x14, 0xa0, 0xbd,
+	0xac, 0xa1, 0x8f, 0xca, 0xd0, 0x97, 0xb9, 0xaf, 0xc7, 0x14, 0xbc, 0xbb, 0x4c, 0x23, 0xe8, 0x34,
+	0x32, 0xea, 0xd7, 0x9f, 0x7b, 0xb4, 0x24, 0x61, 0x6f, 0x13, 0x66, 0x6a, 0x48, 0x4f, 0xc9, 0x66,
+	0xcc, 0x11, 0x3d, 0x5f, 0x5c, 0x26, 0x0a, 0x24, 0xda, 0x2b, 0x3a, 0xa6, 0x59, 0x8e, 0x79, 0xcb,
+	0x11, 0x9f, 0x1b, 0x57, 0x3e, 0xf6, 0x46, 0x3c, 0x2d, 0x21, 0xfd, 0x4c, 0xf6, 0x59, 0x18, 0xca,
+	0xec, 0x04, 0xe0, 0xfd, 0x31, 0xbb, 0x97, 0x4a, 0x18, 0x8a, 0xec, 0x0c, 0xb7, 0x34, 0xbc, 0x5d,
+	0x86, 0x9f, 0x14, 0x9d, 0xb3, 0x13, 0x77, 0x4d, 0x5b, 0x9e, 0xd6, 0x64, 0x7f, 0xf1, 0x20, 0x55,
+	0xa4, 0xb9, 0x28, 0xde, 0x64, 0xaf, 0xea, 0xec, 0xc3, 0x7f, 0xcc, 0x3e, 0x9f, 0x06, 0x37, 0xd8,
+	0x22, 0x03, 0xd2, 0x37, 0xe4, 0xce, 0x80, 0xa3, 0x12, 0x92, 0xfb, 0x5e, 0x2a, 0xb9, 0x0f, 0x68,
+	0xaf, 0xe9, 0x9c, 0x07, 0x15, 0x17, 0x28, 0xd3, 0xcf, 0x14, 0x8b, 0xd3, 0x1c, 0xbc, 0x55, 0xb4,
+	0x6a, 0x05, 0x69, 0x87, 0xd4, 0x35, 0xc3, 0x1b, 0xb0, 0x48, 0xa1, 0x7d, 0x5b, 0x83, 0x76, 0x17,
+	0x80, 0x4e, 0x59, 0xa4, 0x72, 0x0e, 0x49, 0x8b, 0x02, 0x52, 0x9f, 0xdc, 0x1f, 0xb2, 0x88, 0x07,
+	0x4c, 0x09, 0xe9, 0xa5, 0x20, 0xfb, 0x42, 0xc6, 0x2c, 0xc9, 0xe6, 0x5a, 0xd7, 0xb8, 0x27, 0x65,
+	0xdc, 0x79, 0xe1, 0xef, 0x4e, 0xed, 0x39, 0xf9, 0xde, 0xb0, 0x42, 0x43, 0x9a, 0x92, 0x66, 0x65,
+	0x48, 0xf6, 0xcf, 0x45, 0x80, 0x36, 0xf9, 0x8f, 0xac, 0xdd, 0xaa, 0xac, 0xae, 0x01, 0xb6, 0xfa,
+	0x64, 0x7b, 0x7e, 0x77, 0xe8, 0x63, 0xb2, 0x95, 0xef, 0x1e, 0x0b, 0x02, 0x09, 0x68, 0x76, 0x77,
+	0xbd, 0xb7, 0x69, 0xaa, 0x27, 0xa6, 0x48, 0x0f, 0xc9, 0xce, 0x74, 0xd8, 0xc2, 0xb9, 0xa4, 0x9d,
+	0xdb, 0x13, 0x21, 0x37, 0xb7, 0x3e, 0x92, 0xfa, 0xcc, 0x3d, 0xaf, 0xee, 0xb5, 0xaa, 0x7b, 0xe9,
+	0x43, 0xb2, 0x31, 0xbb, 0x4a, 0x3a, 0x63, 0xa5, 0x57, 0x9f, 0x59, 0x92, 0xce, 0xf1, 0xf5, 0xc8,
+	0xb1, 0x6e, 0x46, 0x8e, 0xf5, 0x6b, 0xe4, 0x58, 0x5f, 0xc6, 0x4e, 0xed, 0x66, 0xec, 0xd4, 0xbe,
+	0x8f, 0x9d, 0xda, 0x07, 0x7b, 0xfa, 0xa0, 0x5d, 0x15, 0x4f, 0x9a, 0xfa, 0x94, 0x02, 0x5e, 0xac,
+	0xea, 0x27, 0xeb, 0xe9, 0xef, 0x01, 0x00, 0x0c, 0x3d, 0x90, 0x6f, 0x3b, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -280,6 +318,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorPerformancePeriods) > 0 {
+		for iNdEx := len(m.ValidatorPerformancePeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPerformancePeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for iNdEx := len(m.ValidatorPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PriceHalts) > 0 {
+		for iNdEx := len(m.PriceHalts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHalts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.HistoricPrices) > 0 {
+		for iNdEx := len(m.HistoricPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.AggregateExchangeRateVotes) > 0 {
 		for iNdEx := len(m.AggregateExchangeRateVotes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -484,6 +578,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.HistoricPrices) > 0 {
+		for _, e := range m.HistoricPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceHalts) > 0 {
+		for _, e := range m.PriceHalts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for _, e := range m.ValidatorPerformances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorPerformancePeriods) > 0 {
+		for _, e := range m.ValidatorPerformancePeriods {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -758,6 +876,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoricPrices = append(m.HistoricPrices, PriceStamp{})
+			if err := m.HistoricPrices[len(m.HistoricPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHalts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceHalts = append(m.PriceHalts, PriceHalt{})
+			if err := m.PriceHalts[len(m.PriceHalts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPerformances = append(m.ValidatorPerformances, ValidatorPerformance{})
+			if err := m.ValidatorPerformances[len(m.ValidatorPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformancePeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPerformancePeriods = append(m.ValidatorPerformancePeriods, ValidatorPerformance{})
+			if err := m.ValidatorPerformancePeriods[len(m.ValidatorPerformancePeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,9 +3,11 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"sidechain/x/oracle/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -15,6 +17,36 @@ func TestGenesisValidation(t *testing.T) {
 
 	genState.Params.VotePeriod = 0
 	require.Error(t, types.ValidateGenesis(genState))
+
+	genState = types.DefaultGenesisState()
+	genState.HistoricPrices = []types.PriceStamp{
+		types.NewPriceStamp(types.TestDenomA, sdk.NewDec(10), 1, time.Now().UTC()),
+	}
+	require.NoError(t, types.ValidateGenesis(genState))
+
+	genState.HistoricPrices[0].Denom = ""
+	require.Error(t, types.ValidateGenesis(genState))
+
+	genState.HistoricPrices[0] = types.NewPriceStamp(types.TestDenomA, sdk.NewDec(-1), 1, time.Now().UTC())
+	require.Error(t, types.ValidateGenesis(genState))
+
+	genState = types.DefaultGenesisState()
+	genState.PriceHalts = []types.PriceHalt{
+		types.NewPriceHalt(types.TestDenomA, sdk.NewDec(10), sdk.NewDec(100), 1),
+	}
+	require.NoError(t, types.ValidateGenesis(genState))
+
+	genState.PriceHalts[0].Denom = ""
+	require.Error(t, types.ValidateGenesis(genState))
+
+	genState = types.DefaultGenesisState()
+	genState.ValidatorPerformances = []types.ValidatorPerformance{
+		types.NewValidatorPerformance(sdk.ValAddress([]byte("val1________________")), 0, types.NewPerformanceStats()),
+	}
+	require.NoError(t, types.ValidateGenesis(genState))
+
+	genState.ValidatorPerformances[0].ValidatorAddress = ""
+	require.Error(t, types.ValidateGenesis(genState))
 }
 
 func TestGetGenesisStateFromAppState(t *testing.T) {
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPriceStamp creates a PriceStamp instance
+func NewPriceStamp(denom string, exchangeRate sdk.Dec, blockHeight int64, blockTime time.Time) PriceStamp {
+	return PriceStamp{
+		Denom:        denom,
+		ExchangeRate: exchangeRate,
+		BlockHeight:  blockHeight,
+		BlockTime:    blockTime,
+	}
+}
+
+// PriceStamps is a list of price stamps of a denom, sorted by block height
+type PriceStamps []PriceStamp
+
+// TWAP returns the time-weighted average exchange rate of the price stamps.
+// Each exchange rate is weighted by the time until the next stamp, or until
+// the given block time for the last one. The plain average is returned when
+// every stamp has the same time.
+func (ps PriceStamps) TWAP(blockTime time.Time) (sdk.Dec, error) {
+	if len(ps) == 0 {
+		return sdk.ZeroDec(), ErrNoHistoricPrice
+	}
+
+	weightedSum := sdk.ZeroDec()
+	totalWeight := int64(0)
+	for i, stamp := range ps {
+		end := blockTime
+		if i+1 < len(ps) {
+			end = ps[i+1].BlockTime
+		}
+
+		weight := int64(end.Sub(stamp.BlockTime))
+		if weight <= 0 {
+			continue
+		}
+
+		weightedSum = weightedSum.Add(stamp.ExchangeRate.MulInt64(weight))
+		totalWeight += weight
+	}
+
+	if totalWeight == 0 {
+		return ps.Mean()
+	}
+
+	return weightedSum.QuoInt64(totalWeight), nil
+}
+
+// Mean returns the average exchange rate of the price stamps
+func (ps PriceStamps) Mean() (sdk.Dec, error) {
+	if len(ps) == 0 {
+		return sdk.ZeroDec(), ErrNoHistoricPrice
+	}
+
+	sum := sdk.ZeroDec()
+	for _, stamp := range ps {
+		sum = sum.Add(stamp.ExchangeRate)
+	}
+
+	return sum.QuoInt64(int64(len(ps))), nil
+}
+
+// Median returns the median exchange rate of the price stamps. The average of
+// the two middle exchange rates is returned for an even number of stamps.
+func (ps PriceStamps) Median() (sdk.Dec, error) {
+	if len(ps) == 0 {
+		return sdk.ZeroDec(), ErrNoHistoricPrice
+	}
+
+	rates := make([]sdk.Dec, len(ps))
+	for i, stamp := range ps {
+		rates[i] = stamp.ExchangeRate
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].LT(rates[j])
+	})
+
+	mid := len(rates) / 2
+	if len(rates)%2 == 0 {
+		return rates[mid-1].Add(rates[mid]).QuoInt64(2), nil
+	}
+
+	return rates[mid], nil
+}
+
+// MinMax returns the lowest and the highest exchange rates of the price stamps
+func (ps PriceStamps) MinMax() (sdk.Dec, sdk.Dec, error) {
+	if len(ps) == 0 {
+		return sdk.ZeroDec(), sdk.ZeroDec(), ErrNoHistoricPrice
+	}
+
+	min, max := ps[0].ExchangeRate, ps[0].ExchangeRate
+	for _, stamp := range ps[1:] {
+		min = sdk.MinDec(min, stamp.ExchangeRate)
+		max = sdk.MaxDec(max, stamp.ExchangeRate)
+	}
+
+	return min, max, nil
+}
@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"sidechain/x/oracle/types"
+)
+
+func TestPriceStampsTWAP(t *testing.T) {
+	now := time.Now().UTC()
+
+	_, err := types.PriceStamps{}.TWAP(now)
+	require.ErrorIs(t, err, types.ErrNoHistoricPrice)
+
+	stamps := types.PriceStamps{
+		types.NewPriceStamp(types.TestDenomA, sdk.NewDec(10), 1, now.Add(-4*time.Minute)),
+		types.NewPriceStamp(types.TestDenomA, sdk.NewDec(20), 2, now.Add(-3*time.Minute)),
+		types.NewPriceStamp(types.TestDenomA, sdk.NewDec(40), 3, now.Add(-time.Minute)),
+	}
+
+	// 10 for 1 minute, 20 for 2 minutes and 40 for 1 minute
+	twap, err := stamps.TWAP(now)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(90).QuoInt64(4), twap)
+
+	// the plain average is used when the stamps have the same time
+	sameTime := types.PriceStamps{
+		types.NewPriceStamp(types.TestDenomA, sdk.NewDec(10), 1, now),
+		types.NewPriceStamp(types.TestDenomA, sdk.NewDec(20), 2, now),
+	}
+	twap, err = sameTime.TWAP(now)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(15), twap)
+}
+
+func TestPriceStampsMedian(t *testing.T) {
+	now := time.Now().UTC()
+
+	_, err := types.PriceStamps{}.Median()
+	require.ErrorIs(t, err, types.ErrNoHistoricPrice)
+
+	stamps := types.PriceStamps{
+		types.NewPriceStamp(types.TestDenomA, sdk.NewDec(30), 1, now),
+		types.NewPriceStamp(types.TestDenomA, sdk.NewDec(10), 2, now),
+		types.NewPriceStamp(types.TestDenomA, sdk.NewDec(20), 3, now),
+	}
+
+	median, err := stamps.Median()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(20), median)
+
+	stamps = append(stamps, types.NewPriceStamp(types.TestDenomA, sdk.NewDec(100), 4, now))
+	median, err = stamps.Median()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(25), median)
+}
+
+func TestPriceStampsMinMax(t *testing.T) {
+	now := time.Now().UTC()
+
+	_, _, err := types.PriceStamps{}.MinMax()
+	require.ErrorIs(t, err, types.ErrNoHistoricPrice)
+
+	stamps := types.PriceStamps{
+		types.NewPriceStamp(types.TestDenomA, sdk.NewDec(30), 1, now),
+		types.NewPriceStamp(types.TestDenomA, sdk.NewDec(10), 2, now),
+		types.NewPriceStamp(types.TestDenomA, sdk.NewDec(20), 3, now),
+	}
+
+	min, max, err := stamps.MinMax()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10), min)
+	require.Equal(t, sdk.NewDec(30), max)
+}
@@ -22,7 +22,7 @@ const (
 // Keys for oracle store
 // Items are stored with the following key: values
 //
-// - 0x01<denom_Bytes>: sdk.Dec
+// - 0x01<denom_Bytes>: ExchangeRate
 //
 // - 0x02<valAddress_Bytes>: accAddress
 //
@@ -32,7 +32,19 @@ const (
 //
 // - 0x05<valAddress_Bytes>: AggregateExchangeRateVote
 //
-// - 0x06<denom_Bytes>: sdk.Dec
+// - 0x06<denom_Bytes><blockHeight_Bytes>: PriceStamp
+//
+// - 0x07: Params
+//
+// - 0x08<denom_Bytes>: PriceHalt
+//
+// - 0x09<valAddress_Bytes>: ValidatorPerformance
+//
+// - 0x0A<valAddress_Bytes><blockHeight_Bytes>: ValidatorPerformance
+//
+// - 0x0B<blockHeight_Bytes><valAddress_Bytes>: ValidatorPerformance index
+//
+// - 0x0C<blockHeight_Bytes><denom_Bytes>: PriceStamp index
 var (
 	// Keys for store prefixes
 	ExchangeRateKey                 = []byte{0x01} // prefix for each key to a rate
@@ -40,6 +52,13 @@ var (
 	MissCounterKey                  = []byte{0x03} // prefix for each key to a miss counter
 	AggregateExchangeRatePrevoteKey = []byte{0x04} // prefix for each key to a aggregate prevote
 	AggregateExchangeRateVoteKey    = []byte{0x05} // prefix for each key to a aggregate vote
+	HistoricPriceKey                = []byte{0x06} // prefix for each key to a historic price stamp
+	ParamsKey                       = []byte{0x07} // key for the module parameters
+	PriceHaltKey                    = []byte{0x08} // prefix for each key to a price halt
+	ValidatorPerformanceKey         = []byte{0x09} // prefix for each key to a lifetime validator performance
+	ValidatorPerformancePeriodKey   = []byte{0x0A} // prefix for each key to a vote period validator performance
+	ValidatorPerformanceHeightKey   = []byte{0x0B} // prefix for each key indexing a vote period validator performance by height
+	HistoricPriceHeightKey          = []byte{0x0C} // prefix for each key indexing a historic price stamp by height
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -66,3 +85,53 @@ func GetAggregateExchangeRatePrevoteKey(v sdk.ValAddress) []byte {
 func GetAggregateExchangeRateVoteKey(v sdk.ValAddress) []byte {
 	return append(AggregateExchangeRateVoteKey, address.MustLengthPrefix(v)...)
 }
+
+// GetPriceHaltKey - stored by *denom*
+func GetPriceHaltKey(denom string) []byte {
+	return append(PriceHaltKey, []byte(denom)...)
+}
+
+// GetValidatorPerformanceKey - stored by *Validator* address
+func GetValidatorPerformanceKey(v sdk.ValAddress) []byte {
+	return append(ValidatorPerformanceKey, address.MustLengthPrefix(v)...)
+}
+
+// GetValidatorPerformancePeriodKey - stored by *Validator* address and *block height*
+func GetValidatorPerformancePeriodKey(v sdk.ValAddress, blockHeight int64) []byte {
+	return append(GetValidatorPerformancePeriodPrefix(v), sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+}
+
+// GetValidatorPerformancePeriodPrefix - prefix of the vote period performances of a validator
+func GetValidatorPerformancePeriodPrefix(v sdk.ValAddress) []byte {
+	return append(ValidatorPerformancePeriodKey, address.MustLengthPrefix(v)...)
+}
+
+// GetValidatorPerformanceHeightKey - stored by *block height* and *Validator* address
+func GetValidatorPerformanceHeightKey(blockHeight int64, v sdk.ValAddress) []byte {
+	return append(GetValidatorPerformanceHeightPrefix(blockHeight), address.MustLengthPrefix(v)...)
+}
+
+// GetValidatorPerformanceHeightPrefix - prefix of the vote period performances indexed at a block height
+func GetValidatorPerformanceHeightPrefix(blockHeight int64) []byte {
+	return append(ValidatorPerformanceHeightKey, sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+}
+
+// GetHistoricPriceKey - stored by *denom* and *block height*
+func GetHistoricPriceKey(denom string, blockHeight int64) []byte {
+	return append(GetHistoricPriceDenomPrefix(denom), sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+}
+
+// GetHistoricPriceDenomPrefix - prefix of the historic prices of a denom
+func GetHistoricPriceDenomPrefix(denom string) []byte {
+	return append(HistoricPriceKey, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetHistoricPriceHeightKey - stored by *block height* and *denom*
+func GetHistoricPriceHeightKey(blockHeight int64, denom string) []byte {
+	return append(GetHistoricPriceHeightPrefix(blockHeight), address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetHistoricPriceHeightPrefix - prefix of the historic prices indexed at a block height
+func GetHistoricPriceHeightPrefix(blockHeight int64) []byte {
+	return append(HistoricPriceHeightKey, sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+}
@@ -12,6 +12,10 @@ var (
 	_ sdk.Msg = &MsgDelegateFeedConsent{}
 	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgAddWhitelistDenom{}
+	_ sdk.Msg = &MsgRemoveWhitelistDenom{}
+	_ sdk.Msg = &MsgClearPriceHalt{}
 )
 
 // oracle message types
@@ -19,6 +23,10 @@ const (
 	TypeMsgDelegateFeedConsent          = "delegate_feeder"
 	TypeMsgAggregateExchangeRatePrevote = "aggregate_exchange_rate_prevote"
 	TypeMsgAggregateExchangeRateVote    = "aggregate_exchange_rate_vote"
+	TypeMsgUpdateParams                 = "update_params"
+	TypeMsgAddWhitelistDenom            = "add_whitelist_denom"
+	TypeMsgRemoveWhitelistDenom         = "remove_whitelist_denom"
+	TypeMsgClearPriceHalt               = "clear_price_halt"
 )
 
 //-------------------------------------------------
@@ -194,3 +202,139 @@ func (msg MsgDelegateFeedConsent) ValidateBasic() error {
 
 	return nil
 }
+
+// Route implements sdk.Msg
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// NewMsgAddWhitelistDenom creates a MsgAddWhitelistDenom instance
+func NewMsgAddWhitelistDenom(authority sdk.AccAddress, denom string) *MsgAddWhitelistDenom {
+	return &MsgAddWhitelistDenom{
+		Authority: authority.String(),
+		Denom:     Denom{Name: denom},
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAddWhitelistDenom) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAddWhitelistDenom) Type() string { return TypeMsgAddWhitelistDenom }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAddWhitelistDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAddWhitelistDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAddWhitelistDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom.Name); err != nil {
+		return err
+	}
+
+	return msg.Denom.Validate()
+}
+
+// NewMsgRemoveWhitelistDenom creates a MsgRemoveWhitelistDenom instance
+func NewMsgRemoveWhitelistDenom(authority sdk.AccAddress, denom string) *MsgRemoveWhitelistDenom {
+	return &MsgRemoveWhitelistDenom{
+		Authority: authority.String(),
+		Denom:     denom,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRemoveWhitelistDenom) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRemoveWhitelistDenom) Type() string { return TypeMsgRemoveWhitelistDenom }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRemoveWhitelistDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRemoveWhitelistDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRemoveWhitelistDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	if len(msg.Denom) == 0 {
+		return sdkerrors.Wrap(ErrUnknownDenom, "denom cannot be empty")
+	}
+
+	return nil
+}
+
+// NewMsgClearPriceHalt creates a MsgClearPriceHalt instance
+func NewMsgClearPriceHalt(authority sdk.AccAddress, denom string) *MsgClearPriceHalt {
+	return &MsgClearPriceHalt{
+		Authority: authority.String(),
+		Denom:     denom,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgClearPriceHalt) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgClearPriceHalt) Type() string { return TypeMsgClearPriceHalt }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgClearPriceHalt) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgClearPriceHalt) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgClearPriceHalt) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	if len(msg.Denom) == 0 {
+		return sdkerrors.Wrap(ErrUnknownDenom, "denom cannot be empty")
+	}
+
+	return nil
+}
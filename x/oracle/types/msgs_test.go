@@ -115,3 +115,32 @@ func randSeq(n int) string {
 	}
 	return string(b)
 }
+
+func TestMsgWhitelistDenom(t *testing.T) {
+	authority := sdk.AccAddress([]byte("addr1_______________"))
+
+	tests := []struct {
+		authority  sdk.AccAddress
+		denom      string
+		expectPass bool
+	}{
+		{authority, types.TestDenomD, true},
+		{sdk.AccAddress{}, types.TestDenomD, false},
+		{authority, "", false},
+	}
+
+	for i, tc := range tests {
+		addMsg := types.NewMsgAddWhitelistDenom(tc.authority, tc.denom)
+		removeMsg := types.NewMsgRemoveWhitelistDenom(tc.authority, tc.denom)
+		clearMsg := types.NewMsgClearPriceHalt(tc.authority, tc.denom)
+		if tc.expectPass {
+			require.NoError(t, addMsg.ValidateBasic(), "test: %v", i)
+			require.NoError(t, removeMsg.ValidateBasic(), "test: %v", i)
+			require.NoError(t, clearMsg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, addMsg.ValidateBasic(), "test: %v", i)
+			require.Error(t, removeMsg.ValidateBasic(), "test: %v", i)
+			require.Error(t, clearMsg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
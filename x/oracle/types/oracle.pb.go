@@ -9,15 +9,20 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +30,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AggregationMethod defines how the exchange rate of a denom is aggregated
+// from the votes of its ballot.
+type AggregationMethod int32
+
+const (
+	// AGGREGATION_METHOD_WEIGHTED_MEDIAN takes the median of the votes weighted
+	// by the voting power of the validators.
+	AGGREGATION_METHOD_WEIGHTED_MEDIAN AggregationMethod = 0
+	// AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN discards the lowest and highest
+	// quarter of the voting power and takes the mean of the remaining votes
+	// weighted by the voting power of the validators.
+	AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN AggregationMethod = 1
+	// AGGREGATION_METHOD_MEDIAN_MAD discards the votes deviating from the
+	// weighted median by more than three median absolute deviations and takes
+	// the weighted median of the remaining votes.
+	AGGREGATION_METHOD_MEDIAN_MAD AggregationMethod = 2
+)
+
+var AggregationMethod_name = map[int32]string{
+	0: "AGGREGATION_METHOD_WEIGHTED_MEDIAN",
+	1: "AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN",
+	2: "AGGREGATION_METHOD_MEDIAN_MAD",
+}
+
+var AggregationMethod_value = map[string]int32{
+	"AGGREGATION_METHOD_WEIGHTED_MEDIAN":       0,
+	"AGGREGATION_METHOD_TRIMMED_WEIGHTED_MEAN": 1,
+	"AGGREGATION_METHOD_MEDIAN_MAD":            2,
+}
+
+func (x AggregationMethod) String() string {
+	return proto.EnumName(AggregationMethod_name, int32(x))
+}
+
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5528910e9ea340b0, []int{0}
+}
+
 // Params defines the parameters for the oracle module.
 type Params struct {
 	VotePeriod               uint64                                 `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
@@ -35,6 +78,29 @@ type Params struct {
 	SlashFraction            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
 	SlashWindow              uint64                                 `protobuf:"varint,7,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
 	MinValidPerWindow        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	// max_price_age is how long the last exchange rate of a denom is kept when
+	// the following vote periods fail to reach quorum. The rate is removed once
+	// it is older, or at the end of the next vote period when zero.
+	MaxPriceAge time.Duration `protobuf:"bytes,9,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age" yaml:"max_price_age"`
+	// historic_stamp_period is the number of blocks between two historic price
+	// stamps of the exchange rates. It must be a multiple of the vote period.
+	HistoricStampPeriod uint64 `protobuf:"varint,10,opt,name=historic_stamp_period,json=historicStampPeriod,proto3" json:"historic_stamp_period,omitempty" yaml:"historic_stamp_period"`
+	// maximum_price_stamps is the number of historic price stamps kept for each
+	// denom. No historic prices are kept when zero.
+	MaximumPriceStamps uint64 `protobuf:"varint,11,opt,name=maximum_price_stamps,json=maximumPriceStamps,proto3" json:"maximum_price_stamps,omitempty" yaml:"maximum_price_stamps"`
+	// quote_denom is the denom the exchange rates are voted in. Cross exchange
+	// rates between two denoms are derived from their rates in the quote denom.
+	QuoteDenom string `protobuf:"bytes,12,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// performance_window is the number of blocks of the rolling window the
+	// performance of the validators is tracked over, besides their lifetime
+	// performance.
+	PerformanceWindow uint64 `protobuf:"varint,13,opt,name=performance_window,json=performanceWindow,proto3" json:"performance_window,omitempty" yaml:"performance_window"`
+	// slash_grace_period is the number of blocks after a validator bonds during
+	// which it is not penalized for missing votes.
+	SlashGracePeriod uint64 `protobuf:"varint,14,opt,name=slash_grace_period,json=slashGracePeriod,proto3" json:"slash_grace_period,omitempty" yaml:"slash_grace_period"`
+	// slash_warning_only makes the validators missing too many votes be warned
+	// with an event instead of being slashed and jailed.
+	SlashWarningOnly bool `protobuf:"varint,15,opt,name=slash_warning_only,json=slashWarningOnly,proto3" json:"slash_warning_only,omitempty" yaml:"slash_warning_only"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -97,9 +163,72 @@ func (m *Params) GetSlashWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *Params) GetHistoricStampPeriod() uint64 {
+	if m != nil {
+		return m.HistoricStampPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaximumPriceStamps() uint64 {
+	if m != nil {
+		return m.MaximumPriceStamps
+	}
+	return 0
+}
+
+func (m *Params) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *Params) GetPerformanceWindow() uint64 {
+	if m != nil {
+		return m.PerformanceWindow
+	}
+	return 0
+}
+
+func (m *Params) GetSlashGracePeriod() uint64 {
+	if m != nil {
+		return m.SlashGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetSlashWarningOnly() bool {
+	if m != nil {
+		return m.SlashWarningOnly
+	}
+	return false
+}
+
 // Denom - the object to hold configurations of each denom
 type Denom struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// aggregation is the method the exchange rate of the denom is aggregated
+	// from the ballot with
+	Aggregation AggregationMethod `protobuf:"varint,2,opt,name=aggregation,proto3,enum=sidechain.oracle.AggregationMethod" json:"aggregation,omitempty" yaml:"aggregation,omitempty"`
+	// reward_band is the reward band of the denom. The reward_band of the params
+	// is used when unset.
+	RewardBand *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band,omitempty" yaml:"reward_band,omitempty"`
+	// max_deviation is the largest relative deviation of a tallied exchange rate
+	// from the reference rate of the denom before its price is halted. There is
+	// no circuit breaker when unset.
+	MaxDeviation *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_deviation,json=maxDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_deviation,omitempty" yaml:"max_deviation,omitempty"`
+	// deviation_lookback is the lookback window of the time-weighted average
+	// exchange rate the deviation is measured against. The deviation is measured
+	// against the previous exchange rate when zero.
+	DeviationLookback time.Duration `protobuf:"bytes,5,opt,name=deviation_lookback,json=deviationLookback,proto3,stdduration" json:"deviation_lookback" yaml:"deviation_lookback,omitempty"`
 }
 
 func (m *Denom) Reset()      { *m = Denom{} }
@@ -251,64 +380,356 @@ func (m *ExchangeRateTuple) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExchangeRateTuple proto.InternalMessageInfo
 
+// ExchangeRate - the consensus exchange rate of a denom with the block it was
+// last updated at. The rate field is wire compatible with sdk.DecProto, which
+// was stored before the update height and time were tracked.
+type ExchangeRate struct {
+	Rate             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate" yaml:"rate"`
+	LastUpdateHeight int64                                  `protobuf:"varint,2,opt,name=last_update_height,json=lastUpdateHeight,proto3" json:"last_update_height,omitempty" yaml:"last_update_height"`
+	LastUpdateTime   time.Time                              `protobuf:"bytes,3,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time" yaml:"last_update_time"`
+}
+
+func (m *ExchangeRate) Reset()         { *m = ExchangeRate{} }
+func (m *ExchangeRate) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRate) ProtoMessage()    {}
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5528910e9ea340b0, []int{5}
+}
+func (m *ExchangeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRate.Merge(m, src)
+}
+func (m *ExchangeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRate proto.InternalMessageInfo
+
+// PriceStamp - the exchange rate of a denom at a historic price stamp
+type PriceStamp struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	BlockHeight  int64                                  `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	BlockTime    time.Time                              `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time" yaml:"block_time"`
+}
+
+func (m *PriceStamp) Reset()         { *m = PriceStamp{} }
+func (m *PriceStamp) String() string { return proto.CompactTextString(m) }
+func (*PriceStamp) ProtoMessage()    {}
+func (*PriceStamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5528910e9ea340b0, []int{6}
+}
+func (m *PriceStamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceStamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceStamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceStamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceStamp.Merge(m, src)
+}
+func (m *PriceStamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceStamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceStamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceStamp proto.InternalMessageInfo
+
+// PriceHalt - the halt of the price of a denom by the circuit breaker. The
+// exchange rate of a halted denom is kept until the halt is cleared by
+// governance.
+type PriceHalt struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// exchange_rate is the tallied exchange rate that halted the price
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	// reference_rate is the rate the deviation was measured against
+	ReferenceRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reference_rate,json=referenceRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reference_rate" yaml:"reference_rate"`
+	BlockHeight   int64                                  `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	// cleared_time is the block time the halt was cleared at, zero while the
+	// price is halted. The exchange rates tallied during the deviation lookback
+	// following it are accepted without deviation check.
+	ClearedTime time.Time `protobuf:"bytes,5,opt,name=cleared_time,json=clearedTime,proto3,stdtime" json:"cleared_time" yaml:"cleared_time"`
+}
+
+func (m *PriceHalt) Reset()         { *m = PriceHalt{} }
+func (m *PriceHalt) String() string { return proto.CompactTextString(m) }
+func (*PriceHalt) ProtoMessage()    {}
+func (*PriceHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5528910e9ea340b0, []int{7}
+}
+func (m *PriceHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceHalt.Merge(m, src)
+}
+func (m *PriceHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceHalt proto.InternalMessageInfo
+
+// PerformanceStats - the voting performance of a validator over a number of
+// vote periods
+type PerformanceStats struct {
+	// vote_periods is the number of vote periods the validator was in the
+	// active set
+	VotePeriods uint64 `protobuf:"varint,1,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty" yaml:"vote_periods"`
+	// periods_voted is the number of vote periods the validator voted in
+	PeriodsVoted uint64 `protobuf:"varint,2,opt,name=periods_voted,json=periodsVoted,proto3" json:"periods_voted,omitempty" yaml:"periods_voted"`
+	// win_count is the number of ballots the validator voted within the reward
+	// band of
+	WinCount uint64 `protobuf:"varint,3,opt,name=win_count,json=winCount,proto3" json:"win_count,omitempty" yaml:"win_count"`
+	// abstain_count is the number of ballots the validator abstained from
+	AbstainCount uint64 `protobuf:"varint,4,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty" yaml:"abstain_count"`
+	// miss_count is the number of vote periods counted as a miss
+	MissCount uint64 `protobuf:"varint,5,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty" yaml:"miss_count"`
+	// deviation_sum is the sum of the relative deviations of the votes of the
+	// validator from the tallied exchange rates
+	DeviationSum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=deviation_sum,json=deviationSum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deviation_sum" yaml:"deviation_sum"`
+	// deviation_count is the number of votes summed in deviation_sum
+	DeviationCount uint64 `protobuf:"varint,7,opt,name=deviation_count,json=deviationCount,proto3" json:"deviation_count,omitempty" yaml:"deviation_count"`
+}
+
+func (m *PerformanceStats) Reset()         { *m = PerformanceStats{} }
+func (m *PerformanceStats) String() string { return proto.CompactTextString(m) }
+func (*PerformanceStats) ProtoMessage()    {}
+func (*PerformanceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5528910e9ea340b0, []int{8}
+}
+func (m *PerformanceStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerformanceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerformanceStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerformanceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerformanceStats.Merge(m, src)
+}
+func (m *PerformanceStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerformanceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerformanceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerformanceStats proto.InternalMessageInfo
+
+// ValidatorPerformance - the voting performance of a validator, over its
+// lifetime or over the vote period ending at a block height
+type ValidatorPerformance struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// block_height is the last block of the vote period of the stats, zero for
+	// the lifetime stats
+	BlockHeight int64            `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	Stats       PerformanceStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats" yaml:"stats"`
+}
+
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5528910e9ea340b0, []int{9}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("sidechain.oracle.AggregationMethod", AggregationMethod_name, AggregationMethod_value)
 	proto.RegisterType((*Params)(nil), "sidechain.oracle.Params")
 	proto.RegisterType((*Denom)(nil), "sidechain.oracle.Denom")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "sidechain.oracle.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "sidechain.oracle.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "sidechain.oracle.ExchangeRateTuple")
+	proto.RegisterType((*ExchangeRate)(nil), "sidechain.oracle.ExchangeRate")
+	proto.RegisterType((*PriceStamp)(nil), "sidechain.oracle.PriceStamp")
+	proto.RegisterType((*PriceHalt)(nil), "sidechain.oracle.PriceHalt")
+	proto.RegisterType((*PerformanceStats)(nil), "sidechain.oracle.PerformanceStats")
+	proto.RegisterType((*ValidatorPerformance)(nil), "sidechain.oracle.ValidatorPerformance")
 }
 
 func init() { proto.RegisterFile("sidechain/oracle/oracle.proto", fileDescriptor_5528910e9ea340b0) }
 
 var fileDescriptor_5528910e9ea340b0 = []byte{
-	// 726 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x31, 0x6f, 0x13, 0x4b,
-	0x10, 0xf6, 0xbd, 0x24, 0x7e, 0xf1, 0xda, 0x79, 0x2f, 0xb9, 0xe7, 0x47, 0x8e, 0x00, 0xbe, 0xb0,
-	0x51, 0xa2, 0x34, 0xd8, 0x4a, 0x28, 0x10, 0xee, 0x38, 0x99, 0xd0, 0x80, 0x64, 0x9d, 0xa2, 0x20,
-	0x21, 0x24, 0x6b, 0xef, 0x6e, 0xf1, 0xad, 0x72, 0x77, 0x6b, 0xed, 0xae, 0xe3, 0xa4, 0xa1, 0xa6,
-	0x0c, 0x1d, 0x65, 0x6a, 0x7a, 0xf8, 0x0d, 0x29, 0x53, 0x22, 0x8a, 0x03, 0x25, 0x14, 0xd4, 0xfe,
-	0x05, 0x68, 0xf7, 0xd6, 0xc9, 0x39, 0x76, 0x41, 0x44, 0x75, 0x9e, 0xf9, 0x66, 0xbf, 0x99, 0xfd,
-	0x66, 0xc6, 0x0b, 0xee, 0x71, 0x12, 0x60, 0x3f, 0x44, 0x24, 0x69, 0x50, 0x86, 0xfc, 0x08, 0xeb,
-	0x4f, 0xbd, 0xc7, 0xa8, 0xa0, 0xe6, 0xe2, 0x25, 0x5c, 0xcf, 0xfc, 0x2b, 0xd5, 0x2e, 0xed, 0x52,
-	0x05, 0x36, 0xe4, 0xaf, 0x2c, 0x6e, 0xa5, 0xe6, 0x53, 0x1e, 0x53, 0xde, 0xf0, 0x10, 0xc7, 0x8d,
-	0x83, 0x2d, 0x0f, 0x0b, 0xb4, 0xd5, 0xf0, 0x29, 0x49, 0x32, 0x1c, 0xbe, 0x2f, 0x82, 0x62, 0x1b,
-	0x31, 0x14, 0x73, 0xf3, 0x11, 0x28, 0x1f, 0x50, 0x81, 0x3b, 0x3d, 0xcc, 0x08, 0x0d, 0x2c, 0x63,
-	0xd5, 0xd8, 0x9c, 0x75, 0x6e, 0x0d, 0x53, 0xdb, 0x3c, 0x42, 0x71, 0xd4, 0x84, 0x39, 0x10, 0xba,
-	0x40, 0x5a, 0x6d, 0x65, 0x98, 0x09, 0xf8, 0x47, 0x61, 0x22, 0x64, 0x98, 0x87, 0x34, 0x0a, 0xac,
-	0xbf, 0x56, 0x8d, 0xcd, 0x92, 0xf3, 0xec, 0x34, 0xb5, 0x0b, 0x5f, 0x53, 0x7b, 0xa3, 0x4b, 0x44,
-	0xd8, 0xf7, 0xea, 0x3e, 0x8d, 0x1b, 0xba, 0x9c, 0xec, 0xf3, 0x80, 0x07, 0xfb, 0x0d, 0x71, 0xd4,
-	0xc3, 0xbc, 0xde, 0xc2, 0xfe, 0x30, 0xb5, 0xff, 0xcf, 0x65, 0xba, 0x64, 0x83, 0xee, 0x82, 0x74,
-	0xec, 0x8e, 0x6c, 0x13, 0x83, 0x32, 0xc3, 0x03, 0xc4, 0x82, 0x8e, 0x87, 0x92, 0xc0, 0x9a, 0x51,
-	0xc9, 0x5a, 0x37, 0x4e, 0xa6, 0xaf, 0x95, 0xa3, 0x82, 0x2e, 0xc8, 0x2c, 0x07, 0x25, 0x81, 0xe9,
-	0x83, 0x15, 0x8d, 0x05, 0x84, 0x0b, 0x46, 0xbc, 0xbe, 0x20, 0x34, 0xe9, 0x0c, 0x48, 0x12, 0xd0,
-	0x81, 0x35, 0xab, 0xe4, 0x59, 0x1f, 0xa6, 0xf6, 0xfd, 0x31, 0x9e, 0x29, 0xb1, 0xd0, 0xb5, 0x32,
-	0xb0, 0x95, 0xc3, 0x5e, 0x2a, 0xc8, 0x7c, 0x0d, 0x4a, 0x83, 0x90, 0x08, 0x1c, 0x11, 0x2e, 0xac,
-	0xb9, 0xd5, 0x99, 0xcd, 0xf2, 0xf6, 0x72, 0xfd, 0x7a, 0x6f, 0xeb, 0x2d, 0x9c, 0xd0, 0xd8, 0x59,
-	0x97, 0x57, 0x1c, 0xa6, 0xf6, 0x62, 0x96, 0xf0, 0xf2, 0x1c, 0xfc, 0xf8, 0xcd, 0x2e, 0xa9, 0x90,
-	0xe7, 0x84, 0x0b, 0xf7, 0x8a, 0x50, 0x76, 0x86, 0x47, 0x88, 0x87, 0x9d, 0x37, 0x0c, 0xf9, 0x32,
-	0xab, 0x55, 0xfc, 0xb3, 0xce, 0x8c, 0xb3, 0x41, 0x77, 0x41, 0x39, 0x76, 0xb4, 0x6d, 0x36, 0x41,
-	0x25, 0x8b, 0xd0, 0x22, 0xfd, 0xad, 0x44, 0x5a, 0x1e, 0xa6, 0xf6, 0x7f, 0xf9, 0xf3, 0x23, 0x59,
-	0xca, 0xca, 0xd4, 0x4a, 0xbc, 0x05, 0xd5, 0x98, 0x24, 0x9d, 0x03, 0x14, 0x91, 0x40, 0x8e, 0xd9,
-	0x88, 0x63, 0x5e, 0x55, 0xfc, 0xe2, 0xc6, 0x15, 0xdf, 0xc9, 0x32, 0x4e, 0xe3, 0x84, 0xee, 0x52,
-	0x4c, 0x92, 0x3d, 0xe9, 0x6d, 0x63, 0x96, 0xe5, 0x6f, 0xce, 0x7f, 0x38, 0xb1, 0x0b, 0x3f, 0x4f,
-	0x6c, 0x03, 0x36, 0xc1, 0x9c, 0x52, 0xd3, 0x5c, 0x03, 0xb3, 0x09, 0x8a, 0xb1, 0x5a, 0x85, 0x92,
-	0xf3, 0xef, 0x30, 0xb5, 0xcb, 0x19, 0xa9, 0xf4, 0x42, 0x57, 0x81, 0xcd, 0xca, 0xbb, 0x13, 0xbb,
-	0xa0, 0xcf, 0x16, 0xe0, 0x27, 0x03, 0xdc, 0x7d, 0xd2, 0xed, 0x32, 0xdc, 0x45, 0x02, 0x3f, 0x3d,
-	0xf4, 0x43, 0x94, 0x74, 0xb1, 0x8b, 0x04, 0x6e, 0x33, 0x2c, 0xa7, 0x58, 0x72, 0x86, 0x88, 0x87,
-	0x93, 0x9c, 0xd2, 0x0b, 0x5d, 0x05, 0x9a, 0x1b, 0x60, 0x4e, 0x06, 0x33, 0xbd, 0x48, 0x8b, 0xc3,
-	0xd4, 0xae, 0x5c, 0xad, 0x06, 0x83, 0x6e, 0x06, 0x2b, 0xbd, 0xfb, 0x5e, 0x4c, 0x44, 0xc7, 0x8b,
-	0xa8, 0xbf, 0xaf, 0x56, 0x61, 0x5c, 0xef, 0x1c, 0x2a, 0xf5, 0x56, 0xa6, 0x23, 0xad, 0x6b, 0x75,
-	0xff, 0x30, 0xc0, 0xed, 0xa9, 0x75, 0xef, 0xc9, 0xa2, 0x8f, 0x0d, 0x50, 0xc5, 0xda, 0xd9, 0x61,
-	0x48, 0x6e, 0x67, 0xbf, 0x17, 0x61, 0x6e, 0x19, 0x6a, 0x62, 0xd7, 0x26, 0x27, 0x36, 0x4f, 0xb1,
-	0x2b, 0x63, 0x9d, 0xc7, 0x7a, 0x7a, 0x75, 0x5f, 0xa6, 0xd1, 0xc9, 0x41, 0x36, 0x27, 0x4e, 0x72,
-	0xd7, 0xc4, 0x13, 0xbe, 0xdf, 0x95, 0xe8, 0xda, 0x35, 0x3f, 0x1b, 0x60, 0x69, 0x22, 0x81, 0xe4,
-	0x0a, 0x64, 0xc3, 0x75, 0x53, 0x72, 0x5c, 0xca, 0x0d, 0xdd, 0x0c, 0x36, 0xf7, 0xc1, 0xc2, 0x58,
-	0xd9, 0x3a, 0xf7, 0xce, 0x8d, 0x67, 0xb3, 0x3a, 0x45, 0x03, 0xe8, 0x56, 0xf2, 0xd7, 0x1c, 0x2f,
-	0xdc, 0xd9, 0x3e, 0x3d, 0xaf, 0x19, 0x67, 0xe7, 0x35, 0xe3, 0xfb, 0x79, 0xcd, 0x38, 0xbe, 0xa8,
-	0x15, 0xce, 0x2e, 0x6a, 0x85, 0x2f, 0x17, 0xb5, 0xc2, 0x2b, 0xeb, 0xea, 0xa1, 0x38, 0x1c, 0x3d,
-	0x15, 0x2a, 0x97, 0x57, 0x54, 0x7f, 0xf1, 0x0f, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x82, 0x4f,
-	0xa3, 0x75, 0x4b, 0x06, 0x00, 0x00,
+	// 1631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x3b, 0x6c, 0xdb, 0xce,
+	0x19, 0x17, 0x2d, 0xd9, 0xb5, 0x4e, 0x92, 0x23, 0x33, 0x4a, 0xc2, 0xb8, 0xb6, 0xe8, 0x9c, 0x91,
+	0xc0, 0x28, 0x52, 0x09, 0x71, 0x0b, 0x14, 0x35, 0x90, 0x41, 0x8a, 0x1c, 0xdb, 0x68, 0xec, 0x38,
+	0x17, 0x35, 0x29, 0x8a, 0xa2, 0xc4, 0x89, 0x3c, 0x4b, 0x84, 0xf9, 0x50, 0x49, 0xca, 0x8f, 0xa5,
+	0x63, 0x91, 0x31, 0xe8, 0x94, 0x31, 0x40, 0xb6, 0xee, 0xcd, 0xd4, 0xa5, 0x5b, 0xc6, 0x8c, 0x45,
+	0x07, 0xa5, 0x48, 0x3a, 0x74, 0xad, 0x96, 0xae, 0xc5, 0x3d, 0x28, 0x9e, 0x44, 0x01, 0xf9, 0x0b,
+	0x59, 0x32, 0xd9, 0xdf, 0xeb, 0xf7, 0x7d, 0xf7, 0xbd, 0x8e, 0x27, 0xb0, 0x11, 0xda, 0x16, 0x31,
+	0x7b, 0xd8, 0xf6, 0xea, 0x7e, 0x80, 0x4d, 0x87, 0x88, 0x3f, 0xb5, 0x7e, 0xe0, 0x47, 0xbe, 0x5a,
+	0x1e, 0x8b, 0x6b, 0x9c, 0xbf, 0x56, 0xe9, 0xfa, 0x5d, 0x9f, 0x09, 0xeb, 0xf4, 0x3f, 0xae, 0xb7,
+	0x56, 0x35, 0xfd, 0xd0, 0xf5, 0xc3, 0x7a, 0x07, 0x87, 0xa4, 0x7e, 0xfe, 0xa0, 0x43, 0x22, 0xfc,
+	0xa0, 0x6e, 0xfa, 0xb6, 0x17, 0xcb, 0xbb, 0xbe, 0xdf, 0x75, 0x48, 0x9d, 0x51, 0x9d, 0xc1, 0x69,
+	0xdd, 0x1a, 0x04, 0x38, 0xb2, 0xfd, 0x58, 0xae, 0x4f, 0xcb, 0x23, 0xdb, 0x25, 0x61, 0x84, 0xdd,
+	0x3e, 0x57, 0x80, 0x7f, 0x03, 0x60, 0xe9, 0x04, 0x07, 0xd8, 0x0d, 0xd5, 0x5f, 0x80, 0xc2, 0xb9,
+	0x1f, 0x11, 0xa3, 0x4f, 0x02, 0xdb, 0xb7, 0x34, 0x65, 0x53, 0xd9, 0xce, 0x35, 0x6f, 0x8e, 0x86,
+	0xba, 0x7a, 0x85, 0x5d, 0x67, 0x17, 0x4a, 0x42, 0x88, 0x00, 0xa5, 0x4e, 0x18, 0xa1, 0x7a, 0x60,
+	0x85, 0xc9, 0xa2, 0x5e, 0x40, 0xc2, 0x9e, 0xef, 0x58, 0xda, 0xc2, 0xa6, 0xb2, 0x9d, 0x6f, 0xee,
+	0x7f, 0x18, 0xea, 0x99, 0x7f, 0x0e, 0xf5, 0x7b, 0x5d, 0x3b, 0xea, 0x0d, 0x3a, 0x35, 0xd3, 0x77,
+	0xeb, 0xe2, 0x3c, 0xfc, 0xcf, 0x4f, 0x43, 0xeb, 0xac, 0x1e, 0x5d, 0xf5, 0x49, 0x58, 0x6b, 0x11,
+	0x73, 0x34, 0xd4, 0x6f, 0x48, 0x9e, 0xc6, 0x68, 0x10, 0x95, 0x28, 0xa3, 0x1d, 0xd3, 0x2a, 0x01,
+	0x85, 0x80, 0x5c, 0xe0, 0xc0, 0x32, 0x3a, 0xd8, 0xb3, 0xb4, 0x2c, 0x73, 0xd6, 0x9a, 0xdb, 0x99,
+	0x38, 0x96, 0x04, 0x05, 0x11, 0xe0, 0x54, 0x13, 0x7b, 0x96, 0x6a, 0x82, 0x35, 0x21, 0xb3, 0xec,
+	0x30, 0x0a, 0xec, 0xce, 0x80, 0x26, 0xd6, 0xb8, 0xb0, 0x3d, 0xcb, 0xbf, 0xd0, 0x72, 0x2c, 0x3d,
+	0x77, 0x47, 0x43, 0xfd, 0xce, 0x04, 0xce, 0x0c, 0x5d, 0x88, 0x34, 0x2e, 0x6c, 0x49, 0xb2, 0x97,
+	0x4c, 0xa4, 0xfe, 0x0e, 0xe4, 0x2f, 0x7a, 0x76, 0x44, 0x1c, 0x3b, 0x8c, 0xb4, 0xc5, 0xcd, 0xec,
+	0x76, 0x61, 0xe7, 0x56, 0x6d, 0xba, 0x39, 0x6a, 0x2d, 0xe2, 0xf9, 0x6e, 0xf3, 0x2e, 0x3d, 0xe2,
+	0x68, 0xa8, 0x97, 0xb9, 0xc3, 0xb1, 0x1d, 0xfc, 0xcb, 0x27, 0x3d, 0xcf, 0x54, 0x9e, 0xd8, 0x61,
+	0x84, 0x12, 0x40, 0x5a, 0x99, 0xd0, 0xc1, 0x61, 0xcf, 0x38, 0x0d, 0xb0, 0x49, 0xbd, 0x6a, 0x4b,
+	0xdf, 0x56, 0x99, 0x49, 0x34, 0x88, 0x4a, 0x8c, 0xf1, 0x58, 0xd0, 0xea, 0x2e, 0x28, 0x72, 0x0d,
+	0x91, 0xa4, 0x1f, 0xb1, 0x24, 0xdd, 0x1a, 0x0d, 0xf5, 0xeb, 0xb2, 0x7d, 0x9c, 0x96, 0x02, 0x23,
+	0x45, 0x26, 0xfe, 0x08, 0x2a, 0xae, 0xed, 0x19, 0xe7, 0xd8, 0xb1, 0x2d, 0xda, 0x66, 0x31, 0xc6,
+	0x32, 0x8b, 0xf8, 0x68, 0xee, 0x88, 0x7f, 0xcc, 0x3d, 0xce, 0xc2, 0x84, 0x68, 0xd5, 0xb5, 0xbd,
+	0x17, 0x94, 0x7b, 0x42, 0x02, 0xe1, 0xdf, 0x00, 0x25, 0x17, 0x5f, 0x1a, 0xfd, 0xc0, 0x36, 0x89,
+	0x81, 0xbb, 0x44, 0xcb, 0x6f, 0x2a, 0xdb, 0x85, 0x9d, 0xdb, 0x35, 0x3e, 0x42, 0xb5, 0x78, 0x84,
+	0x6a, 0x2d, 0x31, 0x62, 0xcd, 0x4d, 0x51, 0x8f, 0x8a, 0xf0, 0x24, 0x5b, 0xc3, 0x37, 0x9f, 0x74,
+	0x05, 0x15, 0x5c, 0x7c, 0x79, 0x42, 0x59, 0x8d, 0x2e, 0x51, 0xdb, 0xe0, 0x46, 0xcf, 0x0e, 0x23,
+	0x3f, 0xb0, 0x4d, 0x83, 0x8d, 0x60, 0x3c, 0x69, 0x80, 0x65, 0x69, 0x73, 0x34, 0xd4, 0xd7, 0x39,
+	0xd2, 0x4c, 0x35, 0x88, 0xae, 0xc7, 0xfc, 0xe7, 0x94, 0x2d, 0x86, 0xef, 0x19, 0xa8, 0xb8, 0xf8,
+	0xd2, 0x76, 0x07, 0xae, 0x70, 0xce, 0x6c, 0x42, 0xad, 0xc0, 0x40, 0x75, 0x29, 0x11, 0x33, 0xb4,
+	0x20, 0x52, 0x05, 0x9b, 0x45, 0xc9, 0x70, 0xd9, 0x22, 0xf8, 0xc3, 0x80, 0x8e, 0xa0, 0x45, 0x7b,
+	0x4a, 0x2b, 0xb2, 0x02, 0x48, 0x8b, 0x40, 0x12, 0x42, 0x04, 0x18, 0xc5, 0xba, 0x4f, 0x7d, 0x02,
+	0xd4, 0x3e, 0x09, 0x4e, 0xfd, 0xc0, 0xc5, 0x9e, 0x49, 0xe2, 0x02, 0x96, 0x58, 0x24, 0x1b, 0xa3,
+	0xa1, 0x7e, 0x9b, 0xdb, 0xa7, 0x75, 0x20, 0x5a, 0x95, 0x98, 0xa2, 0x20, 0xbf, 0x02, 0x2a, 0x6f,
+	0x97, 0x6e, 0x80, 0xcd, 0xf1, 0x5a, 0x5a, 0x99, 0x46, 0x4b, 0xeb, 0x40, 0x54, 0x66, 0xcc, 0x7d,
+	0xca, 0x13, 0x69, 0x1a, 0x83, 0x5d, 0xe0, 0xc0, 0xb3, 0xbd, 0xae, 0xe1, 0x7b, 0xce, 0x95, 0x76,
+	0x6d, 0x53, 0xd9, 0x5e, 0x4e, 0x83, 0xc9, 0x3a, 0x31, 0xd8, 0x4b, 0xce, 0x7b, 0xea, 0x39, 0x57,
+	0xbb, 0xcb, 0x6f, 0xde, 0xea, 0x99, 0xff, 0xbc, 0xd5, 0x15, 0xf8, 0xa7, 0x1c, 0x58, 0xe4, 0x67,
+	0xdf, 0x02, 0x39, 0x0f, 0xbb, 0x84, 0xad, 0xcd, 0x7c, 0xf3, 0xda, 0x68, 0xa8, 0x17, 0x38, 0x24,
+	0xe5, 0x42, 0xc4, 0x84, 0x6a, 0x17, 0x14, 0x70, 0xb7, 0x1b, 0x90, 0x2e, 0x6b, 0x20, 0xb6, 0x26,
+	0x57, 0x76, 0xb6, 0xd2, 0xf3, 0xde, 0x48, 0x94, 0x8e, 0x48, 0xd4, 0xf3, 0x2d, 0xb9, 0x3b, 0x24,
+	0x84, 0xfb, 0xbe, 0x6b, 0x47, 0xc4, 0xed, 0x47, 0x57, 0x10, 0xc9, 0xc8, 0xaa, 0x3f, 0x6b, 0x45,
+	0x1e, 0x7f, 0x18, 0xea, 0xca, 0x5c, 0x33, 0xb4, 0x9e, 0x5a, 0x91, 0xb2, 0x47, 0x79, 0x59, 0x0e,
+	0xf8, 0xf4, 0x58, 0xe4, 0xdc, 0xe6, 0x67, 0xcb, 0x31, 0x97, 0x27, 0x73, 0xbb, 0xac, 0x26, 0xc3,
+	0x34, 0x06, 0x93, 0x9d, 0x16, 0x5d, 0x7c, 0xd9, 0x8a, 0x05, 0xea, 0x15, 0x50, 0xc7, 0x5a, 0x86,
+	0xe3, 0xfb, 0x67, 0x1d, 0x6c, 0x9e, 0x69, 0x8b, 0x5f, 0x9b, 0xdc, 0xba, 0x98, 0xdc, 0x2d, 0xee,
+	0x2c, 0x0d, 0x21, 0x79, 0x64, 0x83, 0xbc, 0x3a, 0x56, 0x79, 0x22, 0x34, 0x76, 0x8b, 0xaf, 0xde,
+	0xea, 0x19, 0xd1, 0x08, 0x19, 0xf8, 0x57, 0x05, 0xac, 0xc7, 0x55, 0x23, 0x7b, 0x97, 0x66, 0x0f,
+	0x7b, 0x5d, 0x82, 0x70, 0x44, 0x4e, 0x02, 0x42, 0x6f, 0x2f, 0xda, 0x1f, 0x3d, 0x1c, 0xf6, 0xd2,
+	0xfd, 0x41, 0xb9, 0x10, 0x31, 0xa1, 0x7a, 0x0f, 0x2c, 0x52, 0xe5, 0x40, 0x5c, 0xa0, 0xe5, 0xd1,
+	0x50, 0x2f, 0x26, 0x57, 0x62, 0x00, 0x11, 0x17, 0xb3, 0x3d, 0x3b, 0xe8, 0xb8, 0x76, 0x64, 0x74,
+	0x1c, 0xdf, 0x3c, 0xd3, 0xb2, 0xa9, 0x3d, 0x2b, 0x49, 0xe9, 0x9e, 0x65, 0x64, 0xd3, 0xf1, 0x53,
+	0x71, 0xff, 0x5b, 0x01, 0xb7, 0x67, 0xc6, 0xfd, 0x82, 0x06, 0xfd, 0x5a, 0x01, 0x15, 0x22, 0x98,
+	0x46, 0x80, 0xe9, 0xad, 0x3c, 0xe8, 0x3b, 0x24, 0xd4, 0x14, 0x76, 0x53, 0xcd, 0xe8, 0x5c, 0x19,
+	0xa2, 0x4d, 0x75, 0x9b, 0xbf, 0x14, 0xb9, 0x16, 0x6b, 0x68, 0x16, 0x1c, 0xbd, 0xc0, 0xd4, 0x94,
+	0x65, 0x88, 0x54, 0x92, 0xe2, 0xfd, 0xd0, 0x14, 0x4d, 0x1d, 0xf3, 0xbd, 0x02, 0x56, 0x53, 0x0e,
+	0x28, 0x16, 0x5f, 0x71, 0xca, 0x34, 0x96, 0x58, 0x6e, 0x5c, 0xac, 0x9e, 0x81, 0xd2, 0x44, 0xd8,
+	0xc2, 0xf7, 0xe3, 0xb9, 0xef, 0xa4, 0xca, 0x8c, 0x1c, 0x40, 0x54, 0x94, 0x8f, 0x39, 0x15, 0xf8,
+	0xbb, 0x05, 0x50, 0x94, 0x03, 0x57, 0x9f, 0x81, 0x1c, 0x0b, 0x81, 0x87, 0xfc, 0x70, 0xee, 0x10,
+	0x44, 0xd7, 0x71, 0xcf, 0x0c, 0x8a, 0xee, 0x46, 0x07, 0x87, 0x91, 0x31, 0xe8, 0x5b, 0xb4, 0x26,
+	0x3d, 0x62, 0x77, 0x7b, 0x11, 0x3b, 0x63, 0x56, 0xde, 0x8d, 0x69, 0x1d, 0x88, 0xca, 0x94, 0xf9,
+	0x6b, 0xc6, 0x3b, 0x60, 0x2c, 0xd5, 0x06, 0x65, 0x59, 0x91, 0x7e, 0x6f, 0xb2, 0xf6, 0x2c, 0xec,
+	0xac, 0xa5, 0xe6, 0xb1, 0x1d, 0x7f, 0x8c, 0x36, 0xb7, 0x44, 0x93, 0xdc, 0x4a, 0xbb, 0xa2, 0x08,
+	0xf0, 0x35, 0x1d, 0xc2, 0x95, 0xc4, 0x19, 0xb5, 0xdc, 0x5d, 0x7e, 0x15, 0x67, 0xe9, 0xef, 0x0b,
+	0x00, 0x24, 0x37, 0xd8, 0x77, 0x59, 0x57, 0x3a, 0xb3, 0x6c, 0x1c, 0xe3, 0xfc, 0x66, 0x59, 0x7e,
+	0xa5, 0x99, 0x95, 0xa5, 0x10, 0x15, 0x18, 0x29, 0x92, 0xfa, 0x1b, 0x00, 0xb8, 0x94, 0xa5, 0x33,
+	0xf7, 0xd5, 0x74, 0x6e, 0x88, 0x74, 0xae, 0xca, 0xc8, 0x49, 0x22, 0xf3, 0x8c, 0x31, 0x95, 0xc3,
+	0xf7, 0x59, 0x90, 0x67, 0x39, 0x3c, 0xc0, 0x4e, 0xf4, 0x7d, 0xa6, 0xd0, 0x03, 0x2b, 0x01, 0x39,
+	0x25, 0x01, 0xf1, 0x4c, 0xae, 0xa0, 0x65, 0xbf, 0xed, 0x73, 0x76, 0x12, 0x0d, 0xa2, 0xd2, 0x98,
+	0x31, 0xb3, 0x64, 0xb9, 0x39, 0x4a, 0xf6, 0x7b, 0x50, 0x34, 0x1d, 0x82, 0x03, 0x62, 0xf1, 0xa2,
+	0x2d, 0x7e, 0xb5, 0x68, 0xba, 0x28, 0x9a, 0xc0, 0x96, 0xad, 0x79, 0xd9, 0x0a, 0x82, 0x35, 0x55,
+	0xb8, 0xff, 0x66, 0x41, 0xf9, 0x24, 0xf9, 0x7a, 0x7a, 0x1e, 0xe1, 0x28, 0xa4, 0xa1, 0x4b, 0xef,
+	0xb5, 0x50, 0xbc, 0xe6, 0xa4, 0xd0, 0x65, 0x29, 0x44, 0x85, 0xe4, 0x39, 0x17, 0xaa, 0x0f, 0x41,
+	0x49, 0x08, 0x0c, 0xca, 0xe6, 0xcf, 0xb9, 0x5c, 0x53, 0x4b, 0xaa, 0x34, 0x21, 0x86, 0xa8, 0x28,
+	0x68, 0x7a, 0x67, 0x58, 0xea, 0x03, 0x90, 0xbf, 0xb0, 0x3d, 0xc3, 0xf4, 0x07, 0x5e, 0x24, 0x6e,
+	0xa6, 0x8a, 0xf4, 0x6a, 0x89, 0x45, 0x10, 0x2d, 0x5f, 0xd8, 0xde, 0x23, 0xfa, 0x2f, 0xf5, 0x88,
+	0x3b, 0x61, 0x84, 0xc7, 0x66, 0xb9, 0x69, 0x8f, 0x13, 0x62, 0x88, 0x8a, 0x82, 0xe6, 0xe6, 0x3f,
+	0x07, 0xc0, 0xb5, 0xc3, 0x50, 0xd8, 0x2e, 0x32, 0xdb, 0x1b, 0x49, 0xfb, 0x27, 0x32, 0x88, 0xf2,
+	0x94, 0xe0, 0x56, 0x67, 0xa0, 0x94, 0x5c, 0xfc, 0xe1, 0xc0, 0xd5, 0x96, 0xbe, 0xad, 0x75, 0x27,
+	0xc0, 0x20, 0x2a, 0x8e, 0xe9, 0xe7, 0x03, 0x57, 0x7d, 0x04, 0xae, 0x25, 0x72, 0x1e, 0x27, 0x7f,
+	0x1c, 0xad, 0x8d, 0x86, 0xfa, 0xcd, 0x69, 0x00, 0x11, 0xec, 0xca, 0x98, 0xc3, 0x22, 0x96, 0x6a,
+	0xfe, 0x3f, 0x05, 0x54, 0xd8, 0xfb, 0x05, 0x47, 0x7e, 0x20, 0x15, 0x5f, 0x3d, 0x04, 0xab, 0xe7,
+	0x31, 0xdf, 0xc0, 0x96, 0x15, 0x90, 0x30, 0x14, 0x33, 0xbc, 0x3e, 0x1a, 0xea, 0x9a, 0x28, 0xfe,
+	0xb4, 0x0a, 0x44, 0xe5, 0x31, 0xaf, 0xc1, 0x59, 0xa9, 0xee, 0x5f, 0x98, 0xa3, 0xfb, 0x8f, 0xc1,
+	0x62, 0x48, 0xfb, 0x50, 0xac, 0x7e, 0x98, 0xfe, 0x50, 0x98, 0xee, 0xd8, 0x66, 0x45, 0xb4, 0xbf,
+	0x58, 0x33, 0xcc, 0x1c, 0x22, 0x0e, 0x93, 0x9c, 0xfc, 0x27, 0x7f, 0x56, 0xc0, 0x6a, 0xea, 0xf3,
+	0x58, 0xbd, 0x07, 0x60, 0x63, 0x7f, 0x1f, 0xed, 0xed, 0x37, 0xda, 0x87, 0x4f, 0x8f, 0x8d, 0xa3,
+	0xbd, 0xf6, 0xc1, 0xd3, 0x96, 0xf1, 0x72, 0xef, 0x70, 0xff, 0xa0, 0xbd, 0xd7, 0x32, 0x8e, 0xf6,
+	0x5a, 0x87, 0x8d, 0xe3, 0x72, 0x46, 0xbd, 0x0f, 0xb6, 0x67, 0xe8, 0xb5, 0xd1, 0xe1, 0xd1, 0xd1,
+	0xde, 0x84, 0x7e, 0xe3, 0xb8, 0xac, 0xa8, 0x77, 0xc0, 0xc6, 0x0c, 0x6d, 0x0e, 0x66, 0x1c, 0x35,
+	0x5a, 0xe5, 0x85, 0xb5, 0xdc, 0xab, 0x77, 0xd5, 0x4c, 0x73, 0xe7, 0xc3, 0xe7, 0xaa, 0xf2, 0xf1,
+	0x73, 0x55, 0xf9, 0xd7, 0xe7, 0xaa, 0xf2, 0xfa, 0x4b, 0x35, 0xf3, 0xf1, 0x4b, 0x35, 0xf3, 0x8f,
+	0x2f, 0xd5, 0xcc, 0x6f, 0xb5, 0xe4, 0x77, 0xa0, 0xcb, 0xf8, 0x97, 0x20, 0xd6, 0x3b, 0x9d, 0x25,
+	0xb6, 0x02, 0x7e, 0xf6, 0xff, 0x01, 0x00, 0x6e, 0x62, 0xdc, 0x27, 0x2a, 0x12, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -359,6 +780,27 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
 		return false
 	}
+	if this.MaxPriceAge != that1.MaxPriceAge {
+		return false
+	}
+	if this.HistoricStampPeriod != that1.HistoricStampPeriod {
+		return false
+	}
+	if this.MaximumPriceStamps != that1.MaximumPriceStamps {
+		return false
+	}
+	if this.QuoteDenom != that1.QuoteDenom {
+		return false
+	}
+	if this.PerformanceWindow != that1.PerformanceWindow {
+		return false
+	}
+	if this.SlashGracePeriod != that1.SlashGracePeriod {
+		return false
+	}
+	if this.SlashWarningOnly != that1.SlashWarningOnly {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -381,6 +823,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SlashWarningOnly {
+		i--
+		if m.SlashWarningOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.SlashGracePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SlashGracePeriod))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.PerformanceWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PerformanceWindow))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MaximumPriceStamps != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaximumPriceStamps))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.HistoricStampPeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.HistoricStampPeriod))
+		i--
+		dAtA[i] = 0x50
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.MinValidPerWindow.Size()
 		i -= size
@@ -473,6 +960,43 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DeviationLookback, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DeviationLookback):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if m.MaxDeviation != nil {
+		{
+			size := m.MaxDeviation.Size()
+			i -= size
+			if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RewardBand != nil {
+		{
+			size := m.RewardBand.Size()
+			i -= size
+			if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Aggregation != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Aggregation))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -609,59 +1133,363 @@ func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExchangeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriod))
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdateTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	l = m.VoteThreshold.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.RewardBand.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.RewardDistributionWindow != 0 {
-		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.LastUpdateHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastUpdateHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Whitelist) > 0 {
-		for _, e := range m.Whitelist {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = m.SlashFraction.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.SlashWindow != 0 {
-		n += 1 + sovOracle(uint64(m.SlashWindow))
-	}
-	l = m.MinValidPerWindow.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Denom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *PriceStamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceStamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceStamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintOracle(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClearedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClearedTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintOracle(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	if m.BlockHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ReferenceRate.Size()
+		i -= size
+		if _, err := m.ReferenceRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PerformanceStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerformanceStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerformanceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeviationCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DeviationCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.DeviationSum.Size()
+		i -= size
+		if _, err := m.DeviationSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MissCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MissCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AbstainCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AbstainCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WinCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PeriodsVoted != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PeriodsVoted))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VotePeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardBand.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	}
+	if len(m.Whitelist) > 0 {
+		for _, e := range m.Whitelist {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashWindow != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWindow))
+	}
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.HistoricStampPeriod != 0 {
+		n += 1 + sovOracle(uint64(m.HistoricStampPeriod))
+	}
+	if m.MaximumPriceStamps != 0 {
+		n += 1 + sovOracle(uint64(m.MaximumPriceStamps))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.PerformanceWindow != 0 {
+		n += 1 + sovOracle(uint64(m.PerformanceWindow))
+	}
+	if m.SlashGracePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.SlashGracePeriod))
+	}
+	if m.SlashWarningOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *Denom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if m.Aggregation != 0 {
+		n += 1 + sovOracle(uint64(m.Aggregation))
+	}
+	if m.RewardBand != nil {
+		l = m.RewardBand.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MaxDeviation != nil {
+		l = m.MaxDeviation.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DeviationLookback)
+	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
 
@@ -697,35 +1525,1033 @@ func (m *AggregateExchangeRateVote) Size() (n int) {
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	return n
-}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeRateTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.LastUpdateHeight != 0 {
+		n += 1 + sovOracle(uint64(m.LastUpdateHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdateTime)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceStamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovOracle(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.ReferenceRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovOracle(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ClearedTime)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PerformanceStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriods != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriods))
+	}
+	if m.PeriodsVoted != 0 {
+		n += 1 + sovOracle(uint64(m.PeriodsVoted))
+	}
+	if m.WinCount != 0 {
+		n += 1 + sovOracle(uint64(m.WinCount))
+	}
+	if m.AbstainCount != 0 {
+		n += 1 + sovOracle(uint64(m.AbstainCount))
+	}
+	if m.MissCount != 0 {
+		n += 1 + sovOracle(uint64(m.MissCount))
+	}
+	l = m.DeviationSum.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.DeviationCount != 0 {
+		n += 1 + sovOracle(uint64(m.DeviationCount))
+	}
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovOracle(uint64(m.BlockHeight))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+			}
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, Denom{})
+			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricStampPeriod", wireType)
+			}
+			m.HistoricStampPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricStampPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPriceStamps", wireType)
+			}
+			m.MaximumPriceStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPriceStamps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceWindow", wireType)
+			}
+			m.PerformanceWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashGracePeriod", wireType)
+			}
+			m.SlashGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWarningOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SlashWarningOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+			}
+			m.Aggregation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Aggregation |= AggregationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RewardBand = &v
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxDeviation = &v
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationLookback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DeviationLookback, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
+			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ExchangeRateTuple) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
-
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -748,17 +2574,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.VotePeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -768,14 +2594,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -803,13 +2642,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -837,15 +2726,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateHeight", wireType)
 			}
-			m.RewardDistributionWindow = 0
+			m.LastUpdateHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -855,14 +2744,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				m.LastUpdateHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -889,14 +2778,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Whitelist = append(m.Whitelist, Denom{})
-			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceStamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceStamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -924,15 +2862,47 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.SlashWindow = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -942,16 +2912,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -961,23 +2931,22 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1002,7 +2971,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denom) Unmarshal(dAtA []byte) error {
+func (m *PriceHalt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1025,15 +2994,15 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceHalt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceHalt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1061,61 +3030,11 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1143,11 +3062,13 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1175,13 +3096,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.ReferenceRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.SubmitBlock = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1191,11 +3114,44 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmitBlock |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ClearedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1217,7 +3173,7 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *PerformanceStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1240,17 +3196,17 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: PerformanceStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PerformanceStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
 			}
-			var msglen int
+			m.VotePeriods = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1260,29 +3216,90 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.VotePeriods |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodsVoted", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			m.PeriodsVoted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodsVoted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinCount", wireType)
 			}
-			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
-			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.WinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			m.AbstainCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbstainCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+			}
+			m.MissCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationSum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1310,8 +3327,29 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.DeviationSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationCount", wireType)
+			}
+			m.DeviationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1333,7 +3371,7 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1356,15 +3394,15 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1392,13 +3430,32 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1408,23 +3465,22 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
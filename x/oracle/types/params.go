@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -19,6 +20,9 @@ var (
 	KeySlashFraction            = []byte("SlashFraction")
 	KeySlashWindow              = []byte("SlashWindow")
 	KeyMinValidPerWindow        = []byte("MinValidPerWindow")
+	KeyMaxPriceAge              = []byte("MaxPriceAge")
+	KeyHistoricStampPeriod      = []byte("HistoricStampPeriod")
+	KeyMaximumPriceStamps       = []byte("MaximumPriceStamps")
 )
 
 // Default parameter values
@@ -26,8 +30,19 @@ const (
 	DefaultVotePeriod               = uint64(14)       // 30 seconds
 	DefaultSlashWindow              = uint64(274000)   // window for a week
 	DefaultRewardDistributionWindow = uint64(14250000) // window for a year
+	DefaultMaxPriceAge              = 5 * time.Minute  // 10 vote periods
+	DefaultHistoricStampPeriod      = DefaultVotePeriod
+	DefaultMaximumPriceStamps       = uint64(720) // 6 hours of stamps
+	DefaultQuoteDenom               = "USD"
+	DefaultPerformanceWindow        = uint64(40320) // window for a day
+	DefaultSlashGracePeriod         = uint64(40320) // grace period of a day
+	DefaultSlashWarningOnly         = false
 )
 
+// MaxMaximumPriceStamps bounds the historic price stamps kept for each denom,
+// as they are read by the circuit breaker on every tally
+const MaxMaximumPriceStamps = uint64(10000)
+
 // Default parameter values
 var (
 	DefaultVoteThreshold     = sdk.NewDecWithPrec(50, 2) // 50%
@@ -50,6 +65,13 @@ func DefaultParams() Params {
 		SlashFraction:            DefaultSlashFraction,
 		SlashWindow:              DefaultSlashWindow,
 		MinValidPerWindow:        DefaultMinValidPerWindow,
+		MaxPriceAge:              DefaultMaxPriceAge,
+		HistoricStampPeriod:      DefaultHistoricStampPeriod,
+		MaximumPriceStamps:       DefaultMaximumPriceStamps,
+		QuoteDenom:               DefaultQuoteDenom,
+		PerformanceWindow:        DefaultPerformanceWindow,
+		SlashGracePeriod:         DefaultSlashGracePeriod,
+		SlashWarningOnly:         DefaultSlashWarningOnly,
 	}
 }
 
@@ -59,7 +81,8 @@ func ParamKeyTable() paramstypes.KeyTable {
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
-// pairs of oracle module's parameters.
+// pairs of oracle module's parameters. Only the parameters of the legacy params
+// subspace are listed, as they are read by the store migration.
 func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 	return paramstypes.ParamSetPairs{
 		paramstypes.NewParamSetPair(KeyVotePeriod, &p.VotePeriod, validateVotePeriod),
@@ -70,6 +93,9 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
+		paramstypes.NewParamSetPair(KeyMaxPriceAge, &p.MaxPriceAge, validateMaxPriceAge),
+		paramstypes.NewParamSetPair(KeyHistoricStampPeriod, &p.HistoricStampPeriod, validateHistoricStampPeriod),
+		paramstypes.NewParamSetPair(KeyMaximumPriceStamps, &p.MaximumPriceStamps, validateMaximumPriceStamps),
 	}
 }
 
@@ -79,6 +105,42 @@ func (p Params) String() string {
 	return string(out)
 }
 
+// DenomRewardBand returns the reward band of a denom, which is the RewardBand
+// parameter unless the whitelisted denom sets its own
+func (p Params) DenomRewardBand(denom string) sdk.Dec {
+	for _, d := range p.Whitelist {
+		if d.Name == denom && d.RewardBand != nil {
+			return *d.RewardBand
+		}
+	}
+
+	return p.RewardBand
+}
+
+// DenomAggregation returns the aggregation method of a denom, which is the
+// weighted median for the denoms that are not whitelisted
+func (p Params) DenomAggregation(denom string) AggregationMethod {
+	for _, d := range p.Whitelist {
+		if d.Name == denom {
+			return d.Aggregation
+		}
+	}
+
+	return AGGREGATION_METHOD_WEIGHTED_MEDIAN
+}
+
+// DenomMaxDeviation returns the maximum deviation and the deviation lookback
+// of a denom, and whether the denom has a circuit breaker at all
+func (p Params) DenomMaxDeviation(denom string) (sdk.Dec, time.Duration, bool) {
+	for _, d := range p.Whitelist {
+		if d.Name == denom && d.MaxDeviation != nil {
+			return *d.MaxDeviation, d.DeviationLookback, true
+		}
+	}
+
+	return sdk.ZeroDec(), 0, false
+}
+
 // Validate performs basic validation on oracle parameters.
 func (p Params) Validate() error {
 	if p.VotePeriod == 0 {
@@ -108,11 +170,31 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.MaxPriceAge < 0 {
+		return fmt.Errorf("oracle parameter MaxPriceAge must be positive")
+	}
+
+	if p.HistoricStampPeriod < p.VotePeriod || p.HistoricStampPeriod%p.VotePeriod != 0 {
+		return fmt.Errorf("oracle parameter HistoricStampPeriod must be a multiple of VotePeriod")
+	}
+
+	if p.MaximumPriceStamps > MaxMaximumPriceStamps {
+		return fmt.Errorf("oracle parameter MaximumPriceStamps must be at most %d", MaxMaximumPriceStamps)
+	}
+
 	for _, denom := range p.Whitelist {
-		if len(denom.Name) == 0 {
-			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+		if err := denom.Validate(); err != nil {
+			return err
 		}
 	}
+
+	if err := sdk.ValidateDenom(p.QuoteDenom); err != nil {
+		return fmt.Errorf("oracle parameter QuoteDenom is invalid: %w", err)
+	}
+
+	if p.PerformanceWindow < p.VotePeriod {
+		return fmt.Errorf("oracle parameter PerformanceWindow must be greater than or equal with VotePeriod")
+	}
 	return nil
 }
 
@@ -183,8 +265,8 @@ func validateWhitelist(i interface{}) error {
 	}
 
 	for _, d := range v {
-		if len(d.Name) == 0 {
-			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+		if err := d.Validate(); err != nil {
+			return err
 		}
 	}
 
@@ -237,3 +319,42 @@ func validateMinValidPerWindow(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxPriceAge(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("max price age must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateHistoricStampPeriod(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("historic stamp period must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaximumPriceStamps(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxMaximumPriceStamps {
+		return fmt.Errorf("maximum price stamps must be at most %d: %d", MaxMaximumPriceStamps, v)
+	}
+
+	return nil
+}
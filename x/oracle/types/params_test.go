@@ -3,6 +3,7 @@ package types_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -57,9 +58,99 @@ func TestParamsEqual(t *testing.T) {
 	err = p7.Validate()
 	require.Error(t, err)
 
+	// negative max price age
+	p8 := types.DefaultParams()
+	p8.MaxPriceAge = -time.Second
+	err = p8.Validate()
+	require.Error(t, err)
+
+	// historic stamp period not a multiple of the vote period
+	p9 := types.DefaultParams()
+	p9.HistoricStampPeriod = p9.VotePeriod + 1
+	err = p9.Validate()
+	require.Error(t, err)
+
+	p10 := types.DefaultParams()
+	p10.HistoricStampPeriod = p10.VotePeriod * 4
+	err = p10.Validate()
+	require.NoError(t, err)
+
 	p11 := types.DefaultParams()
 	require.NotNil(t, p11.ParamSetPairs())
 	require.NotNil(t, p11.String())
+
+	// invalid quote denom
+	p12 := types.DefaultParams()
+	p12.QuoteDenom = ""
+	err = p12.Validate()
+	require.Error(t, err)
+	// invalid denom reward band
+	rewardBand := sdk.NewDecWithPrec(11, 1)
+	p13 := types.DefaultParams()
+	p13.Whitelist = types.DenomList{{Name: types.TestDenomD, RewardBand: &rewardBand}}
+	err = p13.Validate()
+	require.Error(t, err)
+
+	// invalid denom aggregation method
+	p14 := types.DefaultParams()
+	p14.Whitelist = types.DenomList{{Name: types.TestDenomD, Aggregation: types.AggregationMethod(10)}}
+	err = p14.Validate()
+	require.Error(t, err)
+
+	// invalid denom max deviation
+	maxDeviation := sdk.ZeroDec()
+	p15 := types.DefaultParams()
+	p15.Whitelist = types.DenomList{{Name: types.TestDenomD, MaxDeviation: &maxDeviation}}
+	err = p15.Validate()
+	require.Error(t, err)
+
+	// invalid denom deviation lookback
+	p16 := types.DefaultParams()
+	p16.Whitelist = types.DenomList{{Name: types.TestDenomD, DeviationLookback: -time.Second}}
+	err = p16.Validate()
+	require.Error(t, err)
+
+	// performance window shorter than the vote period
+	p17 := types.DefaultParams()
+	p17.PerformanceWindow = p17.VotePeriod - 1
+	err = p17.Validate()
+	require.Error(t, err)
+
+	// too many price stamps to read per denom
+	p18 := types.DefaultParams()
+	p18.MaximumPriceStamps = types.MaxMaximumPriceStamps + 1
+	err = p18.Validate()
+	require.Error(t, err)
+}
+
+func TestDenomConfig(t *testing.T) {
+	rewardBand := sdk.NewDecWithPrec(1, 1)
+	params := types.DefaultParams()
+	params.Whitelist = types.DenomList{
+		{Name: types.TestDenomC},
+		{Name: types.TestDenomD, Aggregation: types.AGGREGATION_METHOD_MEDIAN_MAD, RewardBand: &rewardBand},
+	}
+	require.NoError(t, params.Validate())
+
+	require.Equal(t, params.RewardBand, params.DenomRewardBand(types.TestDenomC))
+	require.Equal(t, rewardBand, params.DenomRewardBand(types.TestDenomD))
+	require.Equal(t, params.RewardBand, params.DenomRewardBand(types.TestDenomE))
+
+	require.Equal(t, types.AGGREGATION_METHOD_WEIGHTED_MEDIAN, params.DenomAggregation(types.TestDenomC))
+	require.Equal(t, types.AGGREGATION_METHOD_MEDIAN_MAD, params.DenomAggregation(types.TestDenomD))
+	require.Equal(t, types.AGGREGATION_METHOD_WEIGHTED_MEDIAN, params.DenomAggregation(types.TestDenomE))
+
+	maxDeviation := sdk.NewDecWithPrec(2, 1)
+	params.Whitelist = append(params.Whitelist, types.Denom{Name: types.TestDenomE, MaxDeviation: &maxDeviation, DeviationLookback: time.Hour})
+	require.NoError(t, params.Validate())
+
+	deviation, lookback, enabled := params.DenomMaxDeviation(types.TestDenomE)
+	require.True(t, enabled)
+	require.Equal(t, maxDeviation, deviation)
+	require.Equal(t, time.Hour, lookback)
+
+	_, _, enabled = params.DenomMaxDeviation(types.TestDenomD)
+	require.False(t, enabled)
 }
 
 func TestValidate(t *testing.T) {
@@ -69,7 +160,8 @@ func TestValidate(t *testing.T) {
 		switch {
 		case bytes.Compare(types.KeyVotePeriod, pair.Key) == 0 ||
 			bytes.Compare(types.KeyRewardDistributionWindow, pair.Key) == 0 ||
-			bytes.Compare(types.KeySlashWindow, pair.Key) == 0:
+			bytes.Compare(types.KeySlashWindow, pair.Key) == 0 ||
+			bytes.Compare(types.KeyHistoricStampPeriod, pair.Key) == 0:
 			require.NoError(t, pair.ValidatorFn(uint64(1)))
 			require.Error(t, pair.ValidatorFn("invalid"))
 			require.Error(t, pair.ValidatorFn(uint64(0)))
@@ -91,6 +183,16 @@ func TestValidate(t *testing.T) {
 			require.Error(t, pair.ValidatorFn(types.DenomList{
 				{Name: ""},
 			}))
+		case bytes.Compare(types.KeyMaxPriceAge, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(time.Duration(0)))
+			require.NoError(t, pair.ValidatorFn(time.Minute))
+			require.Error(t, pair.ValidatorFn("invalid"))
+			require.Error(t, pair.ValidatorFn(-time.Second))
+		case bytes.Compare(types.KeyMaximumPriceStamps, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(uint64(0)))
+			require.NoError(t, pair.ValidatorFn(types.MaxMaximumPriceStamps))
+			require.Error(t, pair.ValidatorFn(types.MaxMaximumPriceStamps+1))
+			require.Error(t, pair.ValidatorFn("invalid"))
 		}
 	}
 }
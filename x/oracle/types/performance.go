@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPerformanceStats creates an empty PerformanceStats instance
+func NewPerformanceStats() PerformanceStats {
+	return PerformanceStats{
+		DeviationSum: sdk.ZeroDec(),
+	}
+}
+
+// Add returns the sum of both performance stats
+func (ps PerformanceStats) Add(other PerformanceStats) PerformanceStats {
+	return PerformanceStats{
+		VotePeriods:    ps.VotePeriods + other.VotePeriods,
+		PeriodsVoted:   ps.PeriodsVoted + other.PeriodsVoted,
+		WinCount:       ps.WinCount + other.WinCount,
+		AbstainCount:   ps.AbstainCount + other.AbstainCount,
+		MissCount:      ps.MissCount + other.MissCount,
+		DeviationSum:   ps.DeviationSum.Add(other.DeviationSum),
+		DeviationCount: ps.DeviationCount + other.DeviationCount,
+	}
+}
+
+// AverageDeviation returns the average relative deviation of the votes from
+// the tallied exchange rates, or zero when no vote was tallied
+func (ps PerformanceStats) AverageDeviation() sdk.Dec {
+	if ps.DeviationCount == 0 {
+		return sdk.ZeroDec()
+	}
+
+	return ps.DeviationSum.QuoInt64(int64(ps.DeviationCount))
+}
+
+// NewValidatorPerformance creates a ValidatorPerformance instance
+func NewValidatorPerformance(validator sdk.ValAddress, blockHeight int64, stats PerformanceStats) ValidatorPerformance {
+	return ValidatorPerformance{
+		ValidatorAddress: validator.String(),
+		BlockHeight:      blockHeight,
+		Stats:            stats,
+	}
+}
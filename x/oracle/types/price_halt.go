@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPriceHalt creates a PriceHalt instance
+func NewPriceHalt(denom string, exchangeRate, referenceRate sdk.Dec, blockHeight int64) PriceHalt {
+	return PriceHalt{
+		Denom:         denom,
+		ExchangeRate:  exchangeRate,
+		ReferenceRate: referenceRate,
+		BlockHeight:   blockHeight,
+	}
+}
+
+// IsCleared returns whether the halt was cleared by governance
+func (ph PriceHalt) IsCleared() bool {
+	return !ph.ClearedTime.IsZero()
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -264,6 +266,379 @@ func (m *MsgDelegateFeedConsentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDelegateFeedConsentResponse proto.InternalMessageInfo
 
+// MsgUpdateParams defines a Msg for updating the oracle module parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the oracle parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e373fda939fa2a18, []int{6}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e373fda939fa2a18, []int{7}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgAddWhitelistDenom defines a Msg for adding a denom to the oracle
+// whitelist.
+type MsgAddWhitelistDenom struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the denom to vote the exchange rate of
+	Denom Denom `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom"`
+}
+
+func (m *MsgAddWhitelistDenom) Reset()         { *m = MsgAddWhitelistDenom{} }
+func (m *MsgAddWhitelistDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgAddWhitelistDenom) ProtoMessage()    {}
+func (*MsgAddWhitelistDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e373fda939fa2a18, []int{8}
+}
+func (m *MsgAddWhitelistDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddWhitelistDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddWhitelistDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddWhitelistDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddWhitelistDenom.Merge(m, src)
+}
+func (m *MsgAddWhitelistDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddWhitelistDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddWhitelistDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddWhitelistDenom proto.InternalMessageInfo
+
+func (m *MsgAddWhitelistDenom) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddWhitelistDenom) GetDenom() Denom {
+	if m != nil {
+		return m.Denom
+	}
+	return Denom{}
+}
+
+// MsgAddWhitelistDenomResponse defines the Msg/AddWhitelistDenom response type.
+type MsgAddWhitelistDenomResponse struct {
+}
+
+func (m *MsgAddWhitelistDenomResponse) Reset()         { *m = MsgAddWhitelistDenomResponse{} }
+func (m *MsgAddWhitelistDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddWhitelistDenomResponse) ProtoMessage()    {}
+func (*MsgAddWhitelistDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e373fda939fa2a18, []int{9}
+}
+func (m *MsgAddWhitelistDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddWhitelistDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddWhitelistDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddWhitelistDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddWhitelistDenomResponse.Merge(m, src)
+}
+func (m *MsgAddWhitelistDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddWhitelistDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddWhitelistDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddWhitelistDenomResponse proto.InternalMessageInfo
+
+// MsgRemoveWhitelistDenom defines a Msg for removing a denom from the oracle
+// whitelist, along with its exchange rate, historic prices and votes.
+type MsgRemoveWhitelistDenom struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the name of the denom to remove
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveWhitelistDenom) Reset()         { *m = MsgRemoveWhitelistDenom{} }
+func (m *MsgRemoveWhitelistDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveWhitelistDenom) ProtoMessage()    {}
+func (*MsgRemoveWhitelistDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e373fda939fa2a18, []int{10}
+}
+func (m *MsgRemoveWhitelistDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveWhitelistDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveWhitelistDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveWhitelistDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveWhitelistDenom.Merge(m, src)
+}
+func (m *MsgRemoveWhitelistDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveWhitelistDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveWhitelistDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveWhitelistDenom proto.InternalMessageInfo
+
+func (m *MsgRemoveWhitelistDenom) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveWhitelistDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgRemoveWhitelistDenomResponse defines the Msg/RemoveWhitelistDenom
+// response type.
+type MsgRemoveWhitelistDenomResponse struct {
+}
+
+func (m *MsgRemoveWhitelistDenomResponse) Reset()         { *m = MsgRemoveWhitelistDenomResponse{} }
+func (m *MsgRemoveWhitelistDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveWhitelistDenomResponse) ProtoMessage()    {}
+func (*MsgRemoveWhitelistDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e373fda939fa2a18, []int{11}
+}
+func (m *MsgRemoveWhitelistDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveWhitelistDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveWhitelistDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveWhitelistDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveWhitelistDenomResponse.Merge(m, src)
+}
+func (m *MsgRemoveWhitelistDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveWhitelistDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveWhitelistDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveWhitelistDenomResponse proto.InternalMessageInfo
+
+// MsgClearPriceHalt defines a Msg for clearing the halt of the price of a
+// denom, so that its exchange rate is updated again.
+type MsgClearPriceHalt struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the name of the halted denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgClearPriceHalt) Reset()         { *m = MsgClearPriceHalt{} }
+func (m *MsgClearPriceHalt) String() string { return proto.CompactTextString(m) }
+func (*MsgClearPriceHalt) ProtoMessage()    {}
+func (*MsgClearPriceHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e373fda939fa2a18, []int{12}
+}
+func (m *MsgClearPriceHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearPriceHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearPriceHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClearPriceHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearPriceHalt.Merge(m, src)
+}
+func (m *MsgClearPriceHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearPriceHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearPriceHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClearPriceHalt proto.InternalMessageInfo
+
+func (m *MsgClearPriceHalt) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgClearPriceHalt) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgClearPriceHaltResponse defines the Msg/ClearPriceHalt response type.
+type MsgClearPriceHaltResponse struct {
+}
+
+func (m *MsgClearPriceHaltResponse) Reset()         { *m = MsgClearPriceHaltResponse{} }
+func (m *MsgClearPriceHaltResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClearPriceHaltResponse) ProtoMessage()    {}
+func (*MsgClearPriceHaltResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e373fda939fa2a18, []int{13}
+}
+func (m *MsgClearPriceHaltResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearPriceHaltResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearPriceHaltResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClearPriceHaltResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearPriceHaltResponse.Merge(m, src)
+}
+func (m *MsgClearPriceHaltResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearPriceHaltResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearPriceHaltResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClearPriceHaltResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "sidechain.oracle.MsgAggregateExchangeRatePrevote")
 	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "sidechain.oracle.MsgAggregateExchangeRatePrevoteResponse")
@@ -271,42 +646,68 @@ func init() {
 	proto.RegisterType((*MsgAggregateExchangeRateVoteResponse)(nil), "sidechain.oracle.MsgAggregateExchangeRateVoteResponse")
 	proto.RegisterType((*MsgDelegateFeedConsent)(nil), "sidechain.oracle.MsgDelegateFeedConsent")
 	proto.RegisterType((*MsgDelegateFeedConsentResponse)(nil), "sidechain.oracle.MsgDelegateFeedConsentResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "sidechain.oracle.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sidechain.oracle.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAddWhitelistDenom)(nil), "sidechain.oracle.MsgAddWhitelistDenom")
+	proto.RegisterType((*MsgAddWhitelistDenomResponse)(nil), "sidechain.oracle.MsgAddWhitelistDenomResponse")
+	proto.RegisterType((*MsgRemoveWhitelistDenom)(nil), "sidechain.oracle.MsgRemoveWhitelistDenom")
+	proto.RegisterType((*MsgRemoveWhitelistDenomResponse)(nil), "sidechain.oracle.MsgRemoveWhitelistDenomResponse")
+	proto.RegisterType((*MsgClearPriceHalt)(nil), "sidechain.oracle.MsgClearPriceHalt")
+	proto.RegisterType((*MsgClearPriceHaltResponse)(nil), "sidechain.oracle.MsgClearPriceHaltResponse")
 }
 
 func init() { proto.RegisterFile("sidechain/oracle/tx.proto", fileDescriptor_e373fda939fa2a18) }
 
 var fileDescriptor_e373fda939fa2a18 = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xbf, 0x6e, 0x13, 0x41,
-	0x10, 0xc6, 0x6f, 0x71, 0x14, 0x25, 0x83, 0x42, 0xc2, 0x25, 0x20, 0xdb, 0x8a, 0xee, 0xa2, 0x05,
-	0x41, 0xd2, 0x9c, 0xc1, 0x48, 0x48, 0xa4, 0x82, 0xf0, 0xa7, 0xb3, 0x84, 0xb6, 0xa0, 0xa0, 0x41,
-	0x8b, 0x6f, 0x58, 0x5b, 0x3a, 0xbc, 0x66, 0x77, 0x15, 0x39, 0x35, 0x14, 0x14, 0x20, 0xf1, 0x08,
-	0x79, 0x03, 0x5e, 0x83, 0x32, 0x25, 0xd5, 0x09, 0xd9, 0x0d, 0x15, 0xc5, 0x3d, 0x01, 0xba, 0xdd,
-	0xbb, 0x8b, 0x21, 0x4e, 0x62, 0x77, 0xa7, 0xfd, 0x7e, 0xb3, 0xf3, 0xcd, 0x77, 0xa3, 0x85, 0x86,
-	0xee, 0xc7, 0xd8, 0xed, 0xf1, 0xfe, 0xa0, 0x25, 0x15, 0xef, 0x26, 0xd8, 0x32, 0xa3, 0x68, 0xa8,
-	0xa4, 0x91, 0xfe, 0x46, 0x25, 0x45, 0x4e, 0x6a, 0x6e, 0x09, 0x29, 0xa4, 0x15, 0x5b, 0xf9, 0x97,
-	0xe3, 0xe8, 0x77, 0x02, 0x61, 0x47, 0x8b, 0x27, 0x42, 0x28, 0x14, 0xdc, 0xe0, 0xf3, 0x51, 0xb7,
-	0xc7, 0x07, 0x02, 0x19, 0x37, 0xf8, 0x52, 0xe1, 0xa1, 0x34, 0xe8, 0xdf, 0x82, 0xa5, 0x1e, 0xd7,
-	0xbd, 0x3a, 0xd9, 0x21, 0xbb, 0xab, 0x07, 0xeb, 0x59, 0x1a, 0x5e, 0x3d, 0xe2, 0xef, 0x93, 0x7d,
-	0x9a, 0x9f, 0x52, 0x66, 0x45, 0x7f, 0x0f, 0x96, 0xdf, 0x21, 0xc6, 0xa8, 0xea, 0x57, 0x2c, 0x76,
-	0x3d, 0x4b, 0xc3, 0x35, 0x87, 0xb9, 0x73, 0xca, 0x0a, 0xc0, 0x6f, 0xc3, 0xea, 0x21, 0x4f, 0xfa,
-	0x31, 0x37, 0x52, 0xd5, 0x6b, 0x96, 0xde, 0xca, 0xd2, 0x70, 0xc3, 0xd1, 0x95, 0x44, 0xd9, 0x29,
-	0xb6, 0xbf, 0xf2, 0xf9, 0x38, 0xf4, 0x7e, 0x1f, 0x87, 0x1e, 0xdd, 0x83, 0xbb, 0x97, 0x18, 0x66,
-	0xa8, 0x87, 0x72, 0xa0, 0x91, 0xfe, 0x21, 0xb0, 0x7d, 0x1e, 0xfb, 0xaa, 0x98, 0x4c, 0xf3, 0xc4,
-	0x9c, 0x9d, 0x2c, 0x3f, 0xa5, 0xcc, 0x8a, 0xfe, 0x63, 0xb8, 0x86, 0x45, 0xe1, 0x1b, 0xc5, 0x0d,
-	0xea, 0x62, 0xc2, 0x46, 0x96, 0x86, 0x37, 0x1c, 0xfe, 0xaf, 0x4e, 0xd9, 0x1a, 0x4e, 0x75, 0xd2,
-	0x53, 0xd9, 0xd4, 0x16, 0xca, 0x66, 0x69, 0xd1, 0x6c, 0xee, 0xc0, 0xed, 0x8b, 0xe6, 0xad, 0x82,
-	0xf9, 0x44, 0xe0, 0x66, 0x47, 0x8b, 0x67, 0x98, 0x58, 0xee, 0x05, 0x62, 0xfc, 0x34, 0x17, 0x06,
-	0xc6, 0x6f, 0xc1, 0x8a, 0x1c, 0xa2, 0xb2, 0xfd, 0x5d, 0x2c, 0x9b, 0x59, 0x1a, 0xae, 0xbb, 0xfe,
-	0xa5, 0x42, 0x59, 0x05, 0xe5, 0x05, 0x71, 0x71, 0x4f, 0x11, 0xcc, 0x54, 0x41, 0xa9, 0x50, 0x56,
-	0x41, 0x53, 0x76, 0x77, 0x20, 0x98, 0xed, 0xa2, 0x34, 0xda, 0xfe, 0x5a, 0x83, 0x5a, 0x47, 0x0b,
-	0xff, 0x0b, 0x81, 0xed, 0x0b, 0x77, 0xf4, 0x7e, 0xf4, 0xff, 0xc2, 0x47, 0x97, 0x6c, 0x49, 0xf3,
-	0xd1, 0xc2, 0x25, 0xa5, 0x2d, 0xff, 0x23, 0x81, 0xc6, 0xf9, 0x5b, 0x15, 0xcd, 0x7f, 0x71, 0xce,
-	0x37, 0x1f, 0x2e, 0xc6, 0x57, 0x2e, 0x3e, 0xc0, 0xe6, 0xac, 0x3f, 0xb8, 0x3b, 0xf3, 0xba, 0x19,
-	0x64, 0xf3, 0xde, 0xbc, 0x64, 0xd9, 0xf2, 0xa0, 0xfd, 0x63, 0x1c, 0x90, 0x93, 0x71, 0x40, 0x7e,
-	0x8d, 0x03, 0xf2, 0x6d, 0x12, 0x78, 0x27, 0x93, 0xc0, 0xfb, 0x39, 0x09, 0xbc, 0xd7, 0xf5, 0xd3,
-	0xb7, 0x68, 0x54, 0xbd, 0x46, 0x47, 0x43, 0xd4, 0x6f, 0x97, 0xed, 0x4b, 0xf3, 0xe0, 0x6f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x5a, 0x45, 0x48, 0xf6, 0xae, 0x04, 0x00, 0x00,
+	// 757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc7, 0xe3, 0xb6, 0x37, 0x6a, 0x4e, 0x6f, 0xbf, 0xdc, 0xdc, 0xdb, 0xc4, 0xb7, 0xd7, 0x6e,
+	0x5d, 0x54, 0x5a, 0x10, 0x09, 0x4d, 0xa5, 0x4a, 0x74, 0x45, 0x3f, 0x40, 0x6c, 0x22, 0x55, 0x46,
+	0x80, 0x84, 0x90, 0xaa, 0x69, 0x7c, 0x70, 0x2c, 0x92, 0x38, 0xf5, 0x0c, 0xa1, 0xdd, 0x02, 0x0b,
+	0x16, 0x2c, 0x40, 0xbc, 0x40, 0xdf, 0x00, 0x84, 0x78, 0x88, 0x2e, 0x2b, 0x56, 0xac, 0x22, 0xd4,
+	0x2e, 0x60, 0xc5, 0x22, 0x4f, 0x80, 0xec, 0x71, 0x26, 0x69, 0xec, 0xb4, 0x09, 0x82, 0x55, 0xe2,
+	0xf9, 0xff, 0xe6, 0x9c, 0xdf, 0x8c, 0x35, 0x23, 0x43, 0x9a, 0xda, 0x26, 0x16, 0x8a, 0xc4, 0xae,
+	0x64, 0x1d, 0x97, 0x14, 0x4a, 0x98, 0x65, 0xfb, 0x99, 0xaa, 0xeb, 0x30, 0x47, 0x9e, 0x10, 0x51,
+	0x86, 0x47, 0xca, 0x74, 0xc1, 0xa1, 0x65, 0x87, 0x66, 0xcb, 0xd4, 0xca, 0xd6, 0x96, 0xbd, 0x1f,
+	0x8e, 0x2a, 0x69, 0x1e, 0xec, 0xf8, 0x4f, 0x59, 0xfe, 0x10, 0x44, 0x49, 0xcb, 0xb1, 0x1c, 0x3e,
+	0xee, 0xfd, 0x0b, 0x46, 0xff, 0x0f, 0xb5, 0xe5, 0x3f, 0x3c, 0xd6, 0xdf, 0x4b, 0xa0, 0xe5, 0xa9,
+	0xb5, 0x6e, 0x59, 0x2e, 0x5a, 0x84, 0xe1, 0xad, 0xfd, 0x42, 0x91, 0x54, 0x2c, 0x34, 0x08, 0xc3,
+	0x6d, 0x17, 0x6b, 0x0e, 0x43, 0x79, 0x1e, 0x86, 0x8a, 0x84, 0x16, 0x53, 0xd2, 0xac, 0xb4, 0x98,
+	0xd8, 0x18, 0x6f, 0xd4, 0xb5, 0x91, 0x03, 0x52, 0x2e, 0xad, 0xe9, 0xde, 0xa8, 0x6e, 0xf8, 0xa1,
+	0xbc, 0x04, 0xf1, 0xc7, 0x88, 0x26, 0xba, 0xa9, 0x01, 0x1f, 0x9b, 0x6c, 0xd4, 0xb5, 0x51, 0x8e,
+	0xf1, 0x71, 0xdd, 0x08, 0x00, 0x39, 0x07, 0x89, 0x1a, 0x29, 0xd9, 0x26, 0x61, 0x8e, 0x9b, 0x1a,
+	0xf4, 0xe9, 0x64, 0xa3, 0xae, 0x4d, 0x70, 0x5a, 0x44, 0xba, 0xd1, 0xc2, 0xd6, 0x86, 0x5f, 0x1d,
+	0x6a, 0xb1, 0xef, 0x87, 0x5a, 0x4c, 0x5f, 0x82, 0xcb, 0x17, 0x08, 0x1b, 0x48, 0xab, 0x4e, 0x85,
+	0xa2, 0xfe, 0x43, 0x82, 0x99, 0x6e, 0xec, 0xfd, 0x60, 0x65, 0x94, 0x94, 0x58, 0x78, 0x65, 0xde,
+	0xa8, 0x6e, 0xf8, 0xa1, 0x7c, 0x13, 0xc6, 0x30, 0x98, 0xb8, 0xe3, 0x12, 0x86, 0x34, 0x58, 0x61,
+	0xba, 0x51, 0xd7, 0xfe, 0xe1, 0xf8, 0xd9, 0x5c, 0x37, 0x46, 0xb1, 0xad, 0x13, 0x6d, 0xdb, 0x9b,
+	0xc1, 0xbe, 0xf6, 0x66, 0xa8, 0xdf, 0xbd, 0x59, 0x80, 0x4b, 0xe7, 0xad, 0x57, 0x6c, 0xcc, 0x4b,
+	0x09, 0xfe, 0xcd, 0x53, 0x6b, 0x0b, 0x4b, 0x3e, 0x77, 0x1b, 0xd1, 0xdc, 0xf4, 0x82, 0x0a, 0x93,
+	0xb3, 0x30, 0xec, 0x54, 0xd1, 0xf5, 0xfb, 0xf3, 0x6d, 0x99, 0x6a, 0xd4, 0xb5, 0x71, 0xde, 0xbf,
+	0x99, 0xe8, 0x86, 0x80, 0xbc, 0x09, 0x66, 0x50, 0x27, 0x35, 0xd0, 0x39, 0xa1, 0x99, 0xe8, 0x86,
+	0x80, 0xda, 0x74, 0x67, 0x41, 0x8d, 0xb6, 0x10, 0xa2, 0x6f, 0x25, 0x18, 0xcf, 0x53, 0xeb, 0x5e,
+	0xd5, 0xf4, 0x5e, 0x2f, 0x71, 0x49, 0x99, 0xca, 0xab, 0x90, 0x20, 0x4f, 0x59, 0xd1, 0x71, 0x6d,
+	0x76, 0x10, 0x28, 0xa6, 0x3e, 0x7f, 0xba, 0x96, 0x0c, 0x0e, 0xc3, 0xba, 0x69, 0xba, 0x48, 0xe9,
+	0x5d, 0xe6, 0xda, 0x15, 0xcb, 0x68, 0xa1, 0xf2, 0x2a, 0xc4, 0xab, 0x7e, 0x05, 0x5f, 0x73, 0x24,
+	0x97, 0xca, 0x74, 0x1e, 0xbb, 0x0c, 0xef, 0xb0, 0x31, 0x74, 0x54, 0xd7, 0x62, 0x46, 0x40, 0xaf,
+	0x8d, 0x3d, 0xff, 0xf6, 0xe1, 0x4a, 0xab, 0x8e, 0x9e, 0x86, 0xe9, 0x0e, 0x25, 0xa1, 0xfb, 0x4e,
+	0x82, 0xa4, 0xf7, 0x02, 0x4c, 0xf3, 0x41, 0xd1, 0x66, 0x58, 0xb2, 0x29, 0xdb, 0xc2, 0x8a, 0x53,
+	0xfe, 0x65, 0xe7, 0x15, 0xf8, 0xcb, 0xf4, 0x0a, 0x04, 0xca, 0xd3, 0x61, 0x65, 0xbf, 0x7e, 0x60,
+	0xcc, 0xd9, 0x90, 0xb0, 0x0a, 0x33, 0x51, 0x52, 0xc2, 0xfa, 0x99, 0xbf, 0x20, 0x03, 0xcb, 0x4e,
+	0x0d, 0x7f, 0x93, 0x77, 0xb2, 0xdd, 0x3b, 0xd1, 0x4d, 0x6c, 0x0e, 0xb4, 0x2e, 0x8d, 0x85, 0xdb,
+	0x1e, 0x4c, 0xe6, 0xa9, 0xb5, 0x59, 0x42, 0xe2, 0x6e, 0xbb, 0x76, 0x01, 0xef, 0x78, 0x27, 0xf2,
+	0xcf, 0x5a, 0xfd, 0x07, 0xe9, 0x50, 0xcb, 0xa6, 0x4f, 0xee, 0x63, 0x1c, 0x06, 0xf3, 0xd4, 0x92,
+	0x5f, 0x4b, 0x30, 0x73, 0xee, 0xa5, 0xb9, 0x1c, 0x7e, 0x55, 0x17, 0x5c, 0x5b, 0xca, 0x8d, 0xbe,
+	0xa7, 0x34, 0xb5, 0xe4, 0x17, 0x12, 0xa4, 0xbb, 0x5f, 0x73, 0x99, 0xde, 0x0b, 0x7b, 0xbc, 0xb2,
+	0xda, 0x1f, 0x2f, 0x2c, 0xf6, 0x60, 0x2a, 0xea, 0x4a, 0x59, 0x8c, 0x2c, 0x17, 0x41, 0x2a, 0xd7,
+	0x7b, 0x25, 0x45, 0xcb, 0x47, 0xf0, 0xf7, 0x99, 0xcb, 0x61, 0x2e, 0xb2, 0x42, 0x3b, 0xa2, 0x2c,
+	0x5d, 0x88, 0x88, 0xea, 0x4f, 0x60, 0x32, 0x7c, 0x96, 0x17, 0xa2, 0x77, 0xa7, 0x93, 0x53, 0x32,
+	0xbd, 0x71, 0xa2, 0x19, 0x83, 0x64, 0xe4, 0x19, 0x8c, 0xf6, 0x8d, 0x42, 0x95, 0xe5, 0x9e, 0x51,
+	0xd1, 0x75, 0x17, 0xc6, 0x3a, 0x4e, 0xd7, 0x7c, 0x64, 0x91, 0xb3, 0x90, 0x72, 0xb5, 0x07, 0xa8,
+	0xd9, 0x63, 0x23, 0x77, 0x74, 0xa2, 0x4a, 0xc7, 0x27, 0xaa, 0xf4, 0xf5, 0x44, 0x95, 0xde, 0x9c,
+	0xaa, 0xb1, 0xe3, 0x53, 0x35, 0xf6, 0xe5, 0x54, 0x8d, 0x3d, 0x4c, 0xb5, 0xbe, 0x4e, 0xf6, 0xc5,
+	0x67, 0xd1, 0x41, 0x15, 0xe9, 0x6e, 0xdc, 0xff, 0x3e, 0x59, 0xf9, 0x39, 0x00, 0x74, 0x1d, 0xd7,
+	0x1a, 0x37, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -329,6 +730,18 @@ type MsgClient interface {
 	AggregateExchangeRateVote(ctx context.Context, in *MsgAggregateExchangeRateVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteResponse, error)
 	// DelegateFeedConsent defines a method for setting the feeder delegation
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
+	// UpdateParams defines a governance operation for updating the oracle
+	// module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AddWhitelistDenom defines a governance operation for adding a denom to
+	// the oracle whitelist.
+	AddWhitelistDenom(ctx context.Context, in *MsgAddWhitelistDenom, opts ...grpc.CallOption) (*MsgAddWhitelistDenomResponse, error)
+	// RemoveWhitelistDenom defines a governance operation for removing a denom
+	// from the oracle whitelist.
+	RemoveWhitelistDenom(ctx context.Context, in *MsgRemoveWhitelistDenom, opts ...grpc.CallOption) (*MsgRemoveWhitelistDenomResponse, error)
+	// ClearPriceHalt defines a governance operation for clearing the halt of
+	// the price of a denom by the circuit breaker.
+	ClearPriceHalt(ctx context.Context, in *MsgClearPriceHalt, opts ...grpc.CallOption) (*MsgClearPriceHaltResponse, error)
 }
 
 type msgClient struct {
@@ -366,6 +779,42 @@ func (c *msgClient) DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeed
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.oracle.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddWhitelistDenom(ctx context.Context, in *MsgAddWhitelistDenom, opts ...grpc.CallOption) (*MsgAddWhitelistDenomResponse, error) {
+	out := new(MsgAddWhitelistDenomResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.oracle.Msg/AddWhitelistDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveWhitelistDenom(ctx context.Context, in *MsgRemoveWhitelistDenom, opts ...grpc.CallOption) (*MsgRemoveWhitelistDenomResponse, error) {
+	out := new(MsgRemoveWhitelistDenomResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.oracle.Msg/RemoveWhitelistDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClearPriceHalt(ctx context.Context, in *MsgClearPriceHalt, opts ...grpc.CallOption) (*MsgClearPriceHaltResponse, error) {
+	out := new(MsgClearPriceHaltResponse)
+	err := c.cc.Invoke(ctx, "/sidechain.oracle.Msg/ClearPriceHalt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AggregateExchangeRatePrevote defines a method for submitting
@@ -376,6 +825,18 @@ type MsgServer interface {
 	AggregateExchangeRateVote(context.Context, *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error)
 	// DelegateFeedConsent defines a method for setting the feeder delegation
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
+	// UpdateParams defines a governance operation for updating the oracle
+	// module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AddWhitelistDenom defines a governance operation for adding a denom to
+	// the oracle whitelist.
+	AddWhitelistDenom(context.Context, *MsgAddWhitelistDenom) (*MsgAddWhitelistDenomResponse, error)
+	// RemoveWhitelistDenom defines a governance operation for removing a denom
+	// from the oracle whitelist.
+	RemoveWhitelistDenom(context.Context, *MsgRemoveWhitelistDenom) (*MsgRemoveWhitelistDenomResponse, error)
+	// ClearPriceHalt defines a governance operation for clearing the halt of
+	// the price of a denom by the circuit breaker.
+	ClearPriceHalt(context.Context, *MsgClearPriceHalt) (*MsgClearPriceHaltResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -391,6 +852,18 @@ func (*UnimplementedMsgServer) AggregateExchangeRateVote(ctx context.Context, re
 func (*UnimplementedMsgServer) DelegateFeedConsent(ctx context.Context, req *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateFeedConsent not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) AddWhitelistDenom(ctx context.Context, req *MsgAddWhitelistDenom) (*MsgAddWhitelistDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWhitelistDenom not implemented")
+}
+func (*UnimplementedMsgServer) RemoveWhitelistDenom(ctx context.Context, req *MsgRemoveWhitelistDenom) (*MsgRemoveWhitelistDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWhitelistDenom not implemented")
+}
+func (*UnimplementedMsgServer) ClearPriceHalt(ctx context.Context, req *MsgClearPriceHalt) (*MsgClearPriceHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearPriceHalt not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -450,35 +923,123 @@ func _Msg_DelegateFeedConsent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sidechain.oracle.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AggregateExchangeRatePrevote",
-			Handler:    _Msg_AggregateExchangeRatePrevote_Handler,
-		},
-		{
-			MethodName: "AggregateExchangeRateVote",
-			Handler:    _Msg_AggregateExchangeRateVote_Handler,
-		},
-		{
-			MethodName: "DelegateFeedConsent",
-			Handler:    _Msg_DelegateFeedConsent_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sidechain/oracle/tx.proto",
-}
-
-func (m *MsgAggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.oracle.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddWhitelistDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddWhitelistDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddWhitelistDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.oracle.Msg/AddWhitelistDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddWhitelistDenom(ctx, req.(*MsgAddWhitelistDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveWhitelistDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveWhitelistDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveWhitelistDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.oracle.Msg/RemoveWhitelistDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveWhitelistDenom(ctx, req.(*MsgRemoveWhitelistDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClearPriceHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearPriceHalt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClearPriceHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sidechain.oracle.Msg/ClearPriceHalt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearPriceHalt(ctx, req.(*MsgClearPriceHalt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sidechain.oracle.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AggregateExchangeRatePrevote",
+			Handler:    _Msg_AggregateExchangeRatePrevote_Handler,
+		},
+		{
+			MethodName: "AggregateExchangeRateVote",
+			Handler:    _Msg_AggregateExchangeRateVote_Handler,
+		},
+		{
+			MethodName: "DelegateFeedConsent",
+			Handler:    _Msg_DelegateFeedConsent_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddWhitelistDenom",
+			Handler:    _Msg_AddWhitelistDenom_Handler,
+		},
+		{
+			MethodName: "RemoveWhitelistDenom",
+			Handler:    _Msg_RemoveWhitelistDenom_Handler,
+		},
+		{
+			MethodName: "ClearPriceHalt",
+			Handler:    _Msg_ClearPriceHalt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sidechain/oracle/tx.proto",
+}
+
+func (m *MsgAggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *MsgAggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
@@ -672,114 +1233,998 @@ func (m *MsgDelegateFeedConsentResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAggregateExchangeRatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Feeder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAggregateExchangeRatePrevoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAggregateExchangeRateVote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddWhitelistDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddWhitelistDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddWhitelistDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Salt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.ExchangeRates)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddWhitelistDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Feeder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddWhitelistDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddWhitelistDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveWhitelistDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveWhitelistDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveWhitelistDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAggregateExchangeRateVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveWhitelistDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveWhitelistDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveWhitelistDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateFeedConsent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClearPriceHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearPriceHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearPriceHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearPriceHaltResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearPriceHaltResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearPriceHaltResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAggregateExchangeRateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExchangeRates)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAggregateExchangeRateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateFeedConsent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.Delegate)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgDelegateFeedConsentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddWhitelistDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Denom.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddWhitelistDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveWhitelistDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveWhitelistDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClearPriceHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClearPriceHaltResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateFeedConsent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateFeedConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgDelegateFeedConsentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -802,47 +2247,65 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -870,13 +2333,13 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeder = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -886,23 +2349,24 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -925,7 +2389,7 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -948,10 +2412,10 @@ func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -975,7 +2439,7 @@ func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *MsgAddWhitelistDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -998,15 +2462,15 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddWhitelistDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddWhitelistDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1034,13 +2498,13 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1050,27 +2514,128 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = string(dAtA[iNdEx:postIndex])
+			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddWhitelistDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddWhitelistDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddWhitelistDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveWhitelistDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveWhitelistDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveWhitelistDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1098,11 +2663,11 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeder = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1130,7 +2695,7 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1153,7 +2718,7 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveWhitelistDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1176,10 +2741,10 @@ func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveWhitelistDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveWhitelistDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1203,7 +2768,7 @@ func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
+func (m *MsgClearPriceHalt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1226,15 +2791,15 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClearPriceHalt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClearPriceHalt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1262,11 +2827,11 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1294,7 +2859,7 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1317,7 +2882,7 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClearPriceHaltResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1340,10 +2905,10 @@ func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClearPriceHaltResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClearPriceHaltResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: